@@ -7,6 +7,7 @@ package blockchain
 import (
 	"testing"
 
+	"github.com/33cn/chain33/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -18,3 +19,23 @@ func TestCalcHeightToBlockHeaderKey(t *testing.T) {
 	key = calcHeightToBlockHeaderKey(10)
 	require.Equal(t, key, []byte("HH:000000000010"))
 }
+
+func TestCheckBlockBaseFee(t *testing.T) {
+	chain, mock33 := createBlockChain(t)
+	defer mock33.Close()
+	cfg := chain.client.GetConfig()
+
+	curheight := chain.GetBlockHeight()
+	last, err := chain.GetBlock(curheight)
+	require.Nil(t, err)
+	require.Equal(t, cfg.GetMinTxFeeRate(), last.Block.BaseFee)
+	header, err := chain.blockStore.GetBlockHeaderByHeight(curheight)
+	require.Nil(t, err)
+	require.Equal(t, last.Block.BaseFee, header.BaseFee)
+
+	block := &types.Block{Height: curheight + 1, ParentHash: last.Block.Hash(cfg)}
+	block.BaseFee = types.CalcBaseFeeByBlock(cfg, last.Block)
+	require.Nil(t, chain.checkBlockBaseFee(block))
+	block.BaseFee++
+	require.Equal(t, types.ErrBaseFee, chain.checkBlockBaseFee(block))
+}
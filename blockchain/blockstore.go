@@ -49,6 +49,8 @@ var (
 	MaxSerialChunkNum  = []byte("MaxSilChunkNum:")
 	MaxDeletedChunkNum = []byte("MaxDeletedChunkNum:")
 	storeLog           = chainlog.New("submodule", "store")
+	// 快照同步的检查点高度
+	snapshotHeightKey = []byte("SnapshotHeight")
 )
 
 //GetLocalDBKeyList 获取本地键值列表
@@ -161,7 +163,9 @@ type BlockStore struct {
 	client         queue.Client
 	height         int64
 	lastBlock      *types.Block
+	lastHeader     *types.Header //轻节点模式下只保存区块头
 	lastheaderlock sync.Mutex
+	snapshotHeight int64 //快照同步的检查点高度，检查点之前的区块不在本地
 	saveSequence   bool
 	isParaChain    bool
 	isSaveBlockKVs bool
@@ -183,11 +187,12 @@ func NewBlockStore(chain *BlockChain, db dbm.DB, client queue.Client) *BlockStor
 		}
 	}
 	blockStore := &BlockStore{
-		height:     height,
-		db:         db,
-		client:     client,
-		chain:      chain,
-		blockCache: chain.blockCache,
+		height:         height,
+		snapshotHeight: loadSnapshotHeight(db),
+		db:             db,
+		client:         client,
+		chain:          chain,
+		blockCache:     chain.blockCache,
 	}
 	if chain != nil {
 		blockStore.saveSequence = chain.isRecordBlockSequence
@@ -199,6 +204,13 @@ func NewBlockStore(chain *BlockChain, db dbm.DB, client queue.Client) *BlockStor
 		if cfg.IsEnable("quickIndex") {
 			blockStore.saveQuickIndexFlag()
 		}
+	} else if chain.lightMode {
+		header, err := blockStore.GetBlockHeaderByHeight(height)
+		if err != nil {
+			chainlog.Error("init::GetBlockHeaderByHeight::database may be crash")
+			panic(err)
+		}
+		blockStore.lastHeader = header
 	} else {
 		blockdetail, err := blockStore.LoadBlock(height, nil)
 		if err != nil {
@@ -302,6 +314,11 @@ func (bs *BlockStore) Set(key, value []byte) error {
 	return bs.db.Set(key, value)
 }
 
+// DelSync store通用接口
+func (bs *BlockStore) DelSync(key []byte) error {
+	return bs.db.DeleteSync(key)
+}
+
 // GetKey store通用接口， Get 已经被使用
 func (bs *BlockStore) GetKey(key []byte) ([]byte, error) {
 	value, err := bs.db.Get(key)
@@ -452,6 +469,9 @@ func (bs *BlockStore) LastHeader() *types.Header {
 	bs.lastheaderlock.Lock()
 	defer bs.lastheaderlock.Unlock()
 
+	if bs.lastHeader != nil {
+		return types.Clone(bs.lastHeader).(*types.Header)
+	}
 	// 通过lastBlock获取lastheader
 	var blockheader = types.Header{}
 	if bs.lastBlock != nil {
@@ -463,6 +483,7 @@ func (bs *BlockStore) LastHeader() *types.Header {
 		blockheader.BlockTime = bs.lastBlock.BlockTime
 		blockheader.Signature = bs.lastBlock.Signature
 		blockheader.Difficulty = bs.lastBlock.Difficulty
+		blockheader.BaseFee = bs.lastBlock.BaseFee
 
 		blockheader.Hash = bs.lastBlock.Hash(bs.client.GetConfig())
 		blockheader.TxCount = int64(len(bs.lastBlock.Txs))
@@ -596,6 +617,96 @@ func (bs *BlockStore) SaveBlock(storeBatch dbm.Batch, blockdetail *types.BlockDe
 	return lastSequence, nil
 }
 
+//SaveLightHeader 轻节点保存区块头以及height和hash的对应关系，并更新最新的区块高度
+func (bs *BlockStore) SaveLightHeader(header *types.Header) error {
+	kvs, err := saveHeaderTable(bs.db, header)
+	if err != nil {
+		storeLog.Error("SaveLightHeader:saveHeaderTable", "height", header.Height, "err", err)
+		return err
+	}
+	newbatch := bs.NewBatch(true)
+	for _, kv := range kvs {
+		newbatch.Set(kv.GetKey(), kv.GetValue())
+	}
+	newbatch.Set(blockLastHeight, types.Encode(&types.Int64{Data: header.Height}))
+	newbatch.Set(calcHeightToHashKey(header.Height), header.Hash)
+	err = newbatch.Write()
+	if err != nil {
+		return err
+	}
+	bs.lastheaderlock.Lock()
+	bs.lastHeader = header
+	bs.lastheaderlock.Unlock()
+	bs.UpdateHeight2(header.Height)
+	return nil
+}
+
+//SaveSnapshotBlock 快照同步时保存检查点区块并更新最新的区块高度，检查点之前的区块不在本地，
+//区块的总难度从检查点区块开始累计，只用于检查点之后分叉链的比较
+func (bs *BlockStore) SaveSnapshotBlock(blockdetail *types.BlockDetail) error {
+	block := blockdetail.GetBlock()
+	newbatch := bs.NewBatch(true)
+	_, err := bs.SaveBlock(newbatch, blockdetail, -1)
+	if err != nil {
+		return err
+	}
+	err = bs.SaveTdByBlockHash(newbatch, block.Hash(bs.client.GetConfig()), difficulty.CalcWork(block.Difficulty))
+	if err != nil {
+		return err
+	}
+	newbatch.Set(snapshotHeightKey, types.Encode(&types.Int64{Data: block.Height}))
+	err = newbatch.Write()
+	if err != nil {
+		return err
+	}
+	atomic.StoreInt64(&bs.snapshotHeight, block.Height)
+	bs.UpdateHeight2(block.Height)
+	bs.UpdateLastBlock2(block)
+	return nil
+}
+
+//SnapshotHeight 快照同步的检查点高度，没有进行过快照同步时返回-1
+func (bs *BlockStore) SnapshotHeight() int64 {
+	return atomic.LoadInt64(&bs.snapshotHeight)
+}
+
+//firstHeight 本地保存的最低区块高度，快照同步的节点从检查点开始保存区块
+func (bs *BlockStore) firstHeight() int64 {
+	height := bs.SnapshotHeight()
+	if height < 0 {
+		return 0
+	}
+	return height
+}
+
+func loadSnapshotHeight(db dbm.DB) int64 {
+	value, err := db.Get(snapshotHeightKey)
+	if err != nil {
+		return -1
+	}
+	height, err := decodeHeight(value)
+	if err != nil {
+		return -1
+	}
+	return height
+}
+
+//DelLightHeader 轻节点回退最新的区块头，和DelBlock一样只删除height和hash的对应关系，区块头数据保留在db中
+func (bs *BlockStore) DelLightHeader(header, parent *types.Header) error {
+	newbatch := bs.NewBatch(true)
+	newbatch.Set(blockLastHeight, types.Encode(&types.Int64{Data: header.Height - 1}))
+	newbatch.Delete(calcHeightToHashKey(header.Height))
+	err := newbatch.Write()
+	if err != nil {
+		return err
+	}
+	bs.lastheaderlock.Lock()
+	bs.lastHeader = parent
+	bs.lastheaderlock.Unlock()
+	bs.UpdateHeight2(header.Height - 1)
+	return nil
+}
+
 //BlockdetailToBlockBody get block detail
 func (bs *BlockStore) BlockdetailToBlockBody(blockdetail *types.BlockDetail) *types.BlockBody {
 	cfg := bs.client.GetConfig()
@@ -1410,6 +1521,7 @@ func (bs *BlockStore) saveBlockForTable(storeBatch dbm.Batch, blockdetail *types
 	blockheader.BlockTime = blockdetail.Block.BlockTime
 	blockheader.Signature = blockdetail.Block.Signature
 	blockheader.Difficulty = blockdetail.Block.Difficulty
+	blockheader.BaseFee = blockdetail.Block.BaseFee
 	blockheader.Hash = hash
 	blockheader.TxCount = int64(len(blockdetail.Block.Txs))
 
@@ -1479,6 +1591,7 @@ func (bs *BlockStore) loadBlockByIndex(indexName string, prefix []byte, primaryK
 	block.BlockTime = blockheader.BlockTime
 	block.Signature = blockheader.Signature
 	block.Difficulty = blockheader.Difficulty
+	block.BaseFee = blockheader.BaseFee
 	block.Txs = blockbody.Txs
 	block.MainHeight = blockbody.MainHeight
 	block.MainHash = blockbody.MainHash
@@ -1560,6 +1673,7 @@ func (bs *BlockStore) loadBlockByHashOld(hash []byte) (*types.BlockDetail, error
 	block.BlockTime = blockheader.BlockTime
 	block.Signature = blockheader.Signature
 	block.Difficulty = blockheader.Difficulty
+	block.BaseFee = blockheader.BaseFee
 	block.Txs = blockbody.Txs
 	block.MainHeight = blockbody.MainHeight
 	block.MainHash = blockbody.MainHash
@@ -924,6 +924,9 @@ func (chain *BlockChain) ProcAddBlockHeadersMsg(headers *types.Headers, pid stri
 	}
 	count := len(headers.Items)
 	synlog.Debug("ProcAddBlockHeadersMsg", "count", count, "pid", pid)
+	if chain.lightMode {
+		return chain.ProcLightHeaders(headers.Items, pid)
+	}
 	if count == 1 {
 		return chain.ProcBlockHeader(headers, pid)
 	}
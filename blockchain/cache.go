@@ -164,7 +164,8 @@ func (tc *txHashCache) Add(block *types.Block) {
 	tc.currBlockHeight = block.GetHeight()
 	tc.addTxList(block.Txs)
 	delHeight := tc.currBlockHeight - tc.upperTxHeightRange - tc.lowerTxHeightRange
-	if delHeight >= 0 {
+	//快照同步的节点没有检查点之前的区块
+	if delHeight >= tc.chain.blockStore.firstHeight() {
 		delBlock, err := tc.chain.GetBlock(delHeight)
 		if err != nil {
 			//获取区块出错，将导致缓存异常
@@ -185,7 +186,7 @@ func (tc *txHashCache) Del(height int64) {
 	tc.currBlockHeight = height - 1
 	//窗口向左移动一个高度，需要添加窗口中第一个区块内交易哈希
 	addHeight := height - tc.upperTxHeightRange - tc.lowerTxHeightRange
-	if addHeight >= 0 {
+	if addHeight >= tc.chain.blockStore.firstHeight() {
 		addBlock, err := tc.chain.GetBlock(addHeight)
 		if err != nil {
 			//获取区块出错，将导致缓存异常
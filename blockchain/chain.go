@@ -112,6 +112,8 @@ type BlockChain struct {
 	enablePushSubscribe   bool //是否允许推送订阅
 	isParaChain           bool //是否是平行链。平行链需要记录Sequence信息
 	isStrongConsistency   bool
+	lightMode             bool  //轻节点模式，只同步区块头
+	lightForkBackward     int32 //轻节点的分叉点低于请求的起始高度，下次从更低的高度开始请求区块头
 	//lock
 	synBlocklock     sync.Mutex
 	peerMaxBlklock   sync.Mutex
@@ -121,6 +123,7 @@ type BlockChain struct {
 	bestpeerlock     sync.Mutex
 	downLoadlock     sync.Mutex
 	downLoadModeLock sync.Mutex
+	lightlock        sync.Mutex
 	isNtpClockSync   bool //ntp时间是否同步
 
 	//cfg
@@ -223,6 +226,14 @@ func (chain *BlockChain) initConfig(cfg *types.Chain33Config) {
 	chain.isRecordBlockSequence = mcfg.IsRecordBlockSequence
 	chain.enablePushSubscribe = mcfg.EnablePushSubscribe
 	chain.isParaChain = mcfg.IsParaChain
+	chain.lightMode = mcfg.LightMode
+	if chain.lightMode && chain.isParaChain {
+		panic("blockchain light mode not support para chain")
+	}
+	//轻节点只能依赖共识注册的校验函数验证区块头，没有注册时不能启动
+	if chain.lightMode && !hasLightHeaderChecker(cfg) {
+		panic("blockchain light mode not support consensus " + cfg.GetModuleConfig().Consensus.Name)
+	}
 	cfg.S("quickIndex", mcfg.EnableTxQuickIndex)
 	cfg.S("reduceLocaldb", mcfg.EnableReduceLocaldb)
 
@@ -316,9 +327,13 @@ func (chain *BlockChain) InitBlockChain() {
 		chain.blockStore.CreateSequences(100000)
 	}
 
-	//先缓存最新的128个block信息到cache中
+	//先缓存最新的128个block信息到cache中，轻节点没有区块数据不做缓存
 	curheight := chain.GetBlockHeight()
-	chain.InitCache(curheight)
+	if chain.lightMode {
+		chain.txHeightCache = &noneCache{}
+	} else {
+		chain.InitCache(curheight)
+	}
 
 	//获取数据库中最新的10240个区块加载到index和bestview链中
 	beg := types.Now()
@@ -338,15 +353,23 @@ func (chain *BlockChain) InitBlockChain() {
 	}
 	cfg := chain.client.GetConfig()
 	cfg.S("dbversion", curdbver)
-	if !chain.cfg.IsParaChain && chain.cfg.RollbackBlock <= 0 {
-		// 定时检测/同步block
-		go chain.SynRoutine()
+	if chain.lightMode {
+		// 轻节点只定时同步区块头
+		go chain.LightSynRoutine()
+	} else if !chain.cfg.IsParaChain && chain.cfg.RollbackBlock <= 0 {
+		if chain.needSnapshotSync() {
+			// 先从peer同步检查点的状态快照，完成后再开始区块同步
+			go chain.SnapshotSynRoutine()
+		} else {
+			// 定时检测/同步block
+			go chain.SynRoutine()
 
-		// 定时处理futureblock
-		go chain.UpdateRoutine()
+			// 定时处理futureblock
+			go chain.UpdateRoutine()
+		}
 	}
 
-	if !chain.cfg.DisableShard {
+	if !chain.cfg.DisableShard && !chain.lightMode {
 		chain.tickerwg.Add(2)
 		go chain.chunkDeleteRoutine()
 		go chain.chunkGenerateRoutine()
@@ -514,10 +537,11 @@ func (chain *BlockChain) InitCache(currHeight int64) {
 	if currHeight < 0 {
 		return
 	}
-
+	//快照同步的节点没有检查点之前的区块
+	first := chain.blockStore.firstHeight()
 	for i := currHeight - chain.cfg.DefCacheSize; i <= currHeight; i++ {
-		if i < 0 {
-			i = 0
+		if i < first {
+			i = first
 		}
 		block, err := chain.GetBlock(i)
 		if err != nil {
@@ -527,8 +551,8 @@ func (chain *BlockChain) InitCache(currHeight int64) {
 	}
 
 	for i := currHeight - types.HighAllowPackHeight - types.LowAllowPackHeight + 1; i <= currHeight; i++ {
-		if i < 0 {
-			i = 0
+		if i < first {
+			i = first
 		}
 		block, err := chain.GetBlock(i)
 		if err != nil {
@@ -559,6 +583,9 @@ func (chain *BlockChain) InitIndexAndBestView() {
 	} else {
 		height = 0
 	}
+	if first := chain.blockStore.firstHeight(); height < first {
+		height = first
+	}
 	for ; height <= curheight; height++ {
 		header, err := chain.blockStore.GetBlockHeaderByHeight(height)
 		if header == nil {
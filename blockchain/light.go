@@ -0,0 +1,298 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/difficulty"
+	"github.com/33cn/chain33/common/merkle"
+	"github.com/33cn/chain33/types"
+)
+
+//轻节点模式：
+//只从peer同步区块头，校验区块hash、父区块hash以及共识注册的区块头规则后保存到本地，
+//查询交易时从peer获取交易详情和merkle证明，用本地保存的区块头中的TxHash验证交易确实被打包，
+//交易回执不在区块头中，轻节点无法验证回执的正确性
+
+// LightHeaderChecker 轻节点区块头的共识校验，比如难度、出块签名等，parent为nil时header是创世区块头
+type LightHeaderChecker func(cfg *types.Chain33Config, parent, header *types.Header) error
+
+var lightHeaderCheckers = make(map[string]LightHeaderChecker)
+
+// RegisterLightHeaderChecker 共识模块注册轻节点的区块头校验函数，name为共识名称
+func RegisterLightHeaderChecker(name string, checker LightHeaderChecker) {
+	if checker == nil {
+		panic("RegisterLightHeaderChecker: checker is nil")
+	}
+	if _, dup := lightHeaderCheckers[name]; dup {
+		panic("RegisterLightHeaderChecker: register twice for consensus " + name)
+	}
+	lightHeaderCheckers[name] = checker
+}
+
+func hasLightHeaderChecker(cfg *types.Chain33Config) bool {
+	_, ok := lightHeaderCheckers[cfg.GetModuleConfig().Consensus.Name]
+	return ok
+}
+
+//LightSynRoutine 轻节点定时获取peerlist，并从最高的peer同步区块头
+func (chain *BlockChain) LightSynRoutine() {
+	fetchPeerListTicker := time.NewTicker(time.Duration(fetchPeerListSeconds) * time.Second)
+	defer fetchPeerListTicker.Stop()
+
+	headerSynTicker := time.NewTicker(chain.blockSynInterVal * time.Second)
+	defer headerSynTicker.Stop()
+
+	//轻节点没有区块数据，不做最优链的检测
+	atomic.StoreInt32(&chain.firstcheckbestchain, 1)
+
+	for {
+		select {
+		case <-chain.quit:
+			return
+		case <-fetchPeerListTicker.C:
+			chain.tickerwg.Add(1)
+			go chain.FetchPeerList()
+		case <-headerSynTicker.C:
+			go chain.SynLightHeaders()
+		}
+	}
+}
+
+//SynLightHeaders 从最高的peer请求区块头，从本地最新高度开始请求，用于发现本地最新区块头是否已经分叉，
+//分叉点低于本地最新高度时从最新高度往前BackBlockNum个区块头开始请求
+func (chain *BlockChain) SynLightHeaders() {
+	maxpeer := chain.GetMaxPeerInfo()
+	if maxpeer == nil {
+		return
+	}
+	curheight := chain.GetBlockHeight()
+	if maxpeer.Height <= curheight {
+		return
+	}
+	start := curheight
+	if atomic.CompareAndSwapInt32(&chain.lightForkBackward, 1, 0) {
+		start = curheight - BackBlockNum + 1
+	}
+	if start < 0 {
+		start = 0
+	}
+	end := start + chain.MaxFetchBlockNum - 1
+	if end > maxpeer.Height {
+		end = maxpeer.Height
+	}
+	err := chain.FetchBlockHeaders(start, end, maxpeer.Name)
+	if err != nil {
+		synlog.Error("SynLightHeaders", "start", start, "end", end, "pid", maxpeer.Name, "err", err)
+	}
+}
+
+//ProcLightHeaders 轻节点按高度依次校验并保存peer发送过来的区块头，
+//peer的区块头和本地同高度的区块头hash不同时，从该高度开始peer的分支校验通过并且总难度大于本地被替换的区块头才回退
+func (chain *BlockChain) ProcLightHeaders(headers []*types.Header, pid string) error {
+	chain.lightlock.Lock()
+	defer chain.lightlock.Unlock()
+
+	cfg := chain.client.GetConfig()
+	for i, header := range headers {
+		if header == nil {
+			return types.ErrInvalidParam
+		}
+		curheight := chain.GetBlockHeight()
+		if header.Height > curheight+1 {
+			return types.ErrBlockHeightNoMatch
+		}
+		if header.Height <= curheight {
+			local, err := chain.blockStore.GetBlockHeaderByHeight(header.Height)
+			if err != nil {
+				return err
+			}
+			if bytes.Equal(header.Hash, local.Hash) {
+				continue
+			}
+			if chain.neverRollback || header.Height == 0 {
+				return types.ErrBlockHashNoMatch
+			}
+			return chain.switchLightHeaders(cfg, headers[i:], pid)
+		}
+		var tip *types.Header
+		if curheight >= 0 {
+			tip = chain.blockStore.LastHeader()
+		}
+		if err := checkLightHeader(cfg, tip, header); err != nil {
+			synlog.Error("ProcLightHeaders", "height", header.Height, "pid", pid, "err", err)
+			return err
+		}
+		if err := chain.blockStore.SaveLightHeader(header); err != nil {
+			return err
+		}
+		synlog.Debug("ProcLightHeaders", "height", header.Height, "hash", common.ToHex(header.Hash), "pid", pid)
+	}
+	return nil
+}
+
+//switchLightHeaders 从分叉点的父区块头开始校验peer的整个分支，
+//分支的总难度大于本地从分叉点到最新高度的区块头的总难度时，才逐个回退本地的区块头并保存分支
+func (chain *BlockChain) switchLightHeaders(cfg *types.Chain33Config, branch []*types.Header, pid string) error {
+	forkHeight := branch[0].Height
+	parent, err := chain.blockStore.GetBlockHeaderByHeight(forkHeight - 1)
+	if err != nil {
+		return err
+	}
+	//分支的第一个区块头没有连接到本地，分叉点更低，下次从更低的高度请求
+	if !bytes.Equal(branch[0].ParentHash, parent.Hash) {
+		atomic.StoreInt32(&chain.lightForkBackward, 1)
+		synlog.Debug("switchLightHeaders fork point is lower", "height", forkHeight, "pid", pid)
+		return types.ErrParentHash
+	}
+	curheight := chain.GetBlockHeight()
+	replaced := make([]*types.Header, 0, curheight-forkHeight+1)
+	localWork := big.NewInt(0)
+	for height := forkHeight; height <= curheight; height++ {
+		header, err := chain.blockStore.GetBlockHeaderByHeight(height)
+		if err != nil {
+			return err
+		}
+		localWork.Add(localWork, difficulty.CalcWork(header.Difficulty))
+		replaced = append(replaced, header)
+	}
+	prev := parent
+	work := big.NewInt(0)
+	for _, header := range branch {
+		if header == nil {
+			return types.ErrInvalidParam
+		}
+		if err = checkLightHeader(cfg, prev, header); err != nil {
+			synlog.Error("switchLightHeaders", "height", header.Height, "pid", pid, "err", err)
+			return err
+		}
+		work.Add(work, difficulty.CalcWork(header.Difficulty))
+		prev = header
+	}
+	if work.Cmp(localWork) <= 0 {
+		synlog.Debug("switchLightHeaders branch not heavier", "forkHeight", forkHeight, "height", curheight, "count", len(branch), "pid", pid)
+		return types.ErrBlockHashNoMatch
+	}
+	for i := len(replaced) - 1; i >= 0; i-- {
+		prev = parent
+		if i > 0 {
+			prev = replaced[i-1]
+		}
+		if err = chain.blockStore.DelLightHeader(replaced[i], prev); err != nil {
+			return err
+		}
+		synlog.Info("ProcLightHeaders rollback", "height", replaced[i].Height, "hash", common.ToHex(replaced[i].Hash), "pid", pid)
+	}
+	for _, header := range branch {
+		if err = chain.blockStore.SaveLightHeader(header); err != nil {
+			return err
+		}
+		synlog.Debug("ProcLightHeaders", "height", header.Height, "hash", common.ToHex(header.Hash), "pid", pid)
+	}
+	return nil
+}
+
+//checkLightHeader 校验区块头的hash以及和父区块头的连接关系，然后调用共识注册的校验函数，共识没有注册校验函数时拒绝区块头
+func checkLightHeader(cfg *types.Chain33Config, parent, header *types.Header) error {
+	if !bytes.Equal(header.CalcHash(cfg), header.Hash) {
+		return types.ErrBlockHashNoMatch
+	}
+	if parent == nil {
+		if header.Height != 0 {
+			return types.ErrBlockHeightNoMatch
+		}
+	} else {
+		if header.Height != parent.Height+1 {
+			return types.ErrBlockHeightNoMatch
+		}
+		if !bytes.Equal(header.ParentHash, parent.Hash) {
+			return types.ErrParentHash
+		}
+	}
+	checker, ok := lightHeaderCheckers[cfg.GetModuleConfig().Consensus.Name]
+	if !ok {
+		return types.ErrNoLightHeaderChecker
+	}
+	return checker(cfg, parent, header)
+}
+
+//queryLightTx 轻节点通过p2p从peer获取交易详情，并用本地的区块头验证交易的merkle证明
+func (chain *BlockChain) queryLightTx(txhash []byte) (*types.TransactionDetail, error) {
+	msg := chain.client.NewMessage("p2p", types.EventFetchTxProof, &types.ReqHash{Hash: txhash})
+	err := chain.client.Send(msg, true)
+	if err != nil {
+		chainlog.Error("queryLightTx", "client.Send err:", err)
+		return nil, err
+	}
+	resp, err := chain.client.WaitTimeout(msg, time.Minute)
+	if err != nil {
+		chainlog.Error("queryLightTx", "client.Wait err:", err)
+		return nil, err
+	}
+	if err = resp.Err(); err != nil {
+		return nil, err
+	}
+	detail, ok := resp.GetData().(*types.TransactionDetail)
+	if !ok || detail.GetTx() == nil {
+		return nil, types.ErrTxNotExist
+	}
+	tx := detail.GetTx()
+	if !bytes.Equal(tx.Hash(), txhash) && !bytes.Equal(tx.GetEthTxHash(), txhash) {
+		return nil, types.ErrInvalidTxProof
+	}
+	header, err := chain.blockStore.GetBlockHeaderByHeight(detail.GetHeight())
+	if err != nil {
+		chainlog.Debug("queryLightTx", "height", detail.GetHeight(), "err", err)
+		return nil, err
+	}
+	cfg := chain.client.GetConfig()
+	if err = verifyTxProof(cfg, header, detail); err != nil {
+		chainlog.Error("queryLightTx", "txhash", common.ToHex(txhash), "height", header.Height, "err", err)
+		return nil, err
+	}
+	detail.Blocktime = header.BlockTime
+	detail.FullHash = nil
+	if cfg.IsFork(header.Height, "ForkRootHash") {
+		detail.FullHash = tx.FullHash()
+	}
+	return detail, nil
+}
+
+//verifyTxProof 验证交易详情中的merkle证明和区块头的TxHash一致，
+//ForkRootHash之前是交易hash的单层merkle证明，之后是交易FullHash的多层证明
+func verifyTxProof(cfg *types.Chain33Config, header *types.Header, detail *types.TransactionDetail) error {
+	tx := detail.GetTx()
+	if tx == nil || header == nil {
+		return types.ErrInvalidParam
+	}
+	var root []byte
+	if !cfg.IsFork(header.Height, "ForkRootHash") {
+		root = merkle.GetMerkleRootFromBranch(detail.GetProofs(), tx.Hash(), uint32(detail.GetIndex()))
+	} else {
+		if len(detail.GetTxProofs()) == 0 {
+			return types.ErrInvalidTxProof
+		}
+		root = tx.FullHash()
+		for i, proof := range detail.GetTxProofs() {
+			if proof == nil {
+				return types.ErrInvalidTxProof
+			}
+			root = merkle.GetMerkleRootFromBranch(proof.GetProofs(), root, proof.GetIndex())
+			//第一层证明到子链的roothash
+			if i == 0 && len(proof.GetRootHash()) != 0 && !bytes.Equal(root, proof.GetRootHash()) {
+				return types.ErrInvalidTxProof
+			}
+		}
+	}
+	if !bytes.Equal(root, header.TxHash) {
+		return types.ErrInvalidTxProof
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+package blockchain
+
+import (
+	"errors"
+	"testing"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLightHeaders(t *testing.T) {
+	chain, mock33 := createBlockChain(t)
+	defer mock33.Close()
+	cfg := chain.client.GetConfig()
+
+	curheight := chain.GetBlockHeight()
+	headers, err := chain.ProcGetHeadersMsg(&types.ReqBlocks{Start: 0, End: curheight})
+	require.Nil(t, err)
+
+	//共识没有注册区块头校验函数时不能启动轻节点，测试中替换已经注册的校验函数
+	name := cfg.GetModuleConfig().Consensus.Name
+	if checker, ok := lightHeaderCheckers[name]; ok {
+		delete(lightHeaderCheckers, name)
+		defer func() { lightHeaderCheckers[name] = checker }()
+	}
+	mcfg := cfg.GetModuleConfig().BlockChain
+	mcfg.LightMode = true
+	require.Panics(t, func() { New(cfg) })
+	mcfg.LightMode = false
+	passCheck := func(cfg *types.Chain33Config, parent, header *types.Header) error {
+		return nil
+	}
+
+	light := New(cfg)
+	light.lightMode = true
+	light.client = chain.client
+	db := dbm.NewDB("blockchain", "memdb", "", 100)
+	light.blockStore = NewBlockStore(light, db, chain.client)
+	require.Equal(t, int64(-1), light.GetBlockHeight())
+
+	//没有注册校验函数时拒绝区块头
+	require.Equal(t, types.ErrNoLightHeaderChecker, light.ProcLightHeaders(headers.Items, "pid"))
+	require.Equal(t, int64(-1), light.GetBlockHeight())
+	RegisterLightHeaderChecker(name, passCheck)
+
+	//必须从创世区块头开始连续同步
+	require.Equal(t, types.ErrBlockHeightNoMatch, light.ProcLightHeaders(headers.Items[1:], "pid"))
+	require.Nil(t, light.ProcLightHeaders(headers.Items, "pid"))
+	require.Equal(t, curheight, light.GetBlockHeight())
+	last := light.blockStore.LastHeader()
+	require.Equal(t, headers.Items[curheight].Hash, last.Hash)
+	//重复的区块头直接忽略
+	require.Nil(t, light.ProcLightHeaders(headers.Items[curheight-1:], "pid"))
+
+	//重启后从区块头恢复最新高度
+	store := NewBlockStore(light, db, chain.client)
+	require.Equal(t, curheight, store.Height())
+	require.Equal(t, last.Hash, store.LastHeader().Hash)
+
+	//篡改区块头
+	fake := types.Clone(headers.Items[curheight]).(*types.Header)
+	fake.Height++
+	fake.ParentHash = last.Hash
+	require.Equal(t, types.ErrBlockHashNoMatch, light.ProcLightHeaders([]*types.Header{fake}, "pid"))
+	fake.Hash = fake.CalcHash(cfg)
+	require.Nil(t, checkLightHeader(cfg, last, fake))
+	other := types.Clone(last).(*types.Header)
+	other.Hash = headers.Items[curheight-1].Hash
+	require.Equal(t, types.ErrParentHash, checkLightHeader(cfg, other, fake))
+	require.Equal(t, types.ErrBlockHeightNoMatch, checkLightHeader(cfg, headers.Items[curheight-1], fake))
+
+	//共识注册的区块头校验
+	delete(lightHeaderCheckers, name)
+	errCheck := errors.New("errCheck")
+	RegisterLightHeaderChecker(name, func(cfg *types.Chain33Config, parent, header *types.Header) error {
+		if header.Height > curheight {
+			return errCheck
+		}
+		return nil
+	})
+	require.Equal(t, errCheck, light.ProcLightHeaders([]*types.Header{fake}, "pid"))
+	delete(lightHeaderCheckers, name)
+	RegisterLightHeaderChecker(name, passCheck)
+
+	//同高度的分叉区块头，分支校验通过并且总难度更大时才回退本地最新区块头
+	fork := types.Clone(last).(*types.Header)
+	fork.BlockTime++
+	fork.Hash = fork.CalcHash(cfg)
+	forkNext := types.Clone(fake).(*types.Header)
+	forkNext.ParentHash = fork.Hash
+	forkNext.Hash = forkNext.CalcHash(cfg)
+	light.neverRollback = true
+	require.Equal(t, types.ErrBlockHashNoMatch, light.ProcLightHeaders([]*types.Header{fork, forkNext}, "pid"))
+	light.neverRollback = false
+	//总难度相同的分支不回退
+	require.Equal(t, types.ErrBlockHashNoMatch, light.ProcLightHeaders([]*types.Header{fork}, "pid"))
+	require.Equal(t, last.Hash, light.blockStore.LastHeader().Hash)
+	//分支中的区块头校验失败不回退
+	badNext := types.Clone(forkNext).(*types.Header)
+	badNext.ParentHash = last.Hash
+	badNext.Hash = badNext.CalcHash(cfg)
+	require.Equal(t, types.ErrParentHash, light.ProcLightHeaders([]*types.Header{fork, badNext}, "pid"))
+	require.Equal(t, last.Hash, light.blockStore.LastHeader().Hash)
+
+	require.Nil(t, light.ProcLightHeaders([]*types.Header{fork, forkNext}, "pid"))
+	require.Equal(t, curheight+1, light.GetBlockHeight())
+	require.Equal(t, forkNext.Hash, light.blockStore.LastHeader().Hash)
+	hash, err := light.blockStore.GetBlockHashByHeight(curheight)
+	require.Nil(t, err)
+	require.Equal(t, fork.Hash, hash)
+
+	//分叉点低于本地最新高度，比较从分叉点开始的总难度，回退多个区块头
+	branch := []*types.Header{headers.Items[curheight-2]}
+	for i := 0; i < 5; i++ {
+		header := types.Clone(headers.Items[curheight-1]).(*types.Header)
+		header.Height += int64(i)
+		header.BlockTime += 10
+		header.ParentHash = branch[len(branch)-1].Hash
+		header.Hash = header.CalcHash(cfg)
+		branch = append(branch, header)
+	}
+	branch = branch[1:]
+	//分支的第一个区块头没有连接到本地，下次从更低的高度请求
+	require.Equal(t, types.ErrParentHash, light.ProcLightHeaders(branch[1:], "pid"))
+	require.Equal(t, int32(1), light.lightForkBackward)
+	//本地从分叉点开始有3个区块头，分支的总难度需要更大
+	require.Equal(t, types.ErrBlockHashNoMatch, light.ProcLightHeaders(branch[:3], "pid"))
+	require.Equal(t, forkNext.Hash, light.blockStore.LastHeader().Hash)
+	require.Nil(t, light.ProcLightHeaders(append(headers.Items[curheight-3:curheight-1], branch[:4]...), "pid"))
+	require.Equal(t, curheight+2, light.GetBlockHeight())
+	require.Equal(t, branch[3].Hash, light.blockStore.LastHeader().Hash)
+	for _, header := range branch[:4] {
+		hash, err = light.blockStore.GetBlockHashByHeight(header.Height)
+		require.Nil(t, err)
+		require.Equal(t, header.Hash, hash)
+	}
+}
+
+func TestVerifyTxProof(t *testing.T) {
+	chain, mock33 := createBlockChain(t)
+	defer mock33.Close()
+	cfg := chain.client.GetConfig()
+
+	block, err := chain.GetBlock(chain.GetBlockHeight())
+	require.Nil(t, err)
+	require.NotEqual(t, 0, len(block.Block.Txs))
+	tx := block.Block.Txs[len(block.Block.Txs)-1]
+	detail, err := chain.ProcQueryTxMsg(tx.Hash())
+	require.Nil(t, err)
+	header, err := chain.blockStore.GetBlockHeaderByHeight(detail.Height)
+	require.Nil(t, err)
+	require.Nil(t, verifyTxProof(cfg, header, detail))
+
+	//区块头不匹配
+	parent, err := chain.blockStore.GetBlockHeaderByHeight(detail.Height - 1)
+	require.Nil(t, err)
+	parent.Height = header.Height
+	require.Equal(t, types.ErrInvalidTxProof, verifyTxProof(cfg, parent, detail))
+
+	//篡改交易
+	fake := types.Clone(detail).(*types.TransactionDetail)
+	fake.Tx.Fee++
+	require.Equal(t, types.ErrInvalidTxProof, verifyTxProof(cfg, header, fake))
+	fake = types.Clone(detail).(*types.TransactionDetail)
+	if len(fake.TxProofs) > 0 {
+		fake.TxProofs[0].Index++
+		fake.TxProofs[0].Proofs = append(fake.TxProofs[0].Proofs, make([]byte, 32))
+		require.Equal(t, types.ErrInvalidTxProof, verifyTxProof(cfg, header, fake))
+		fake.TxProofs = nil
+	} else {
+		fake.Index++
+		fake.Proofs = append(fake.Proofs, make([]byte, 32))
+	}
+	require.Equal(t, types.ErrInvalidTxProof, verifyTxProof(cfg, header, fake))
+}
@@ -9,6 +9,20 @@ type CommonStore struct {
 	mock.Mock
 }
 
+// DelSync provides a mock function with given fields: key
+func (_m *CommonStore) DelSync(key []byte) error {
+	ret := _m.Called(key)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]byte) error); ok {
+		r0 = rf(key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetKey provides a mock function with given fields: key
 func (_m *CommonStore) GetKey(key []byte) ([]byte, error) {
 	ret := _m.Called(key)
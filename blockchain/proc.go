@@ -84,6 +84,14 @@ func (chain *BlockChain) ProcRecvMsg() {
 			go chain.processMsg(msg, reqnum, chain.listPush)
 		case types.EventGetPushLastNum:
 			go chain.processMsg(msg, reqnum, chain.getPushLastNum)
+		case types.EventRemovePush:
+			go chain.processMsg(msg, reqnum, chain.removePush)
+		case types.EventPausePush:
+			go chain.processMsg(msg, reqnum, chain.pausePush)
+		case types.EventGetPushStats:
+			go chain.processMsg(msg, reqnum, chain.getPushStats)
+		case types.EventReadPushOutbox:
+			go chain.processMsg(msg, reqnum, chain.readPushOutbox)
 		case types.EventGetLastBlockMainSequence:
 			go chain.processMsg(msg, reqnum, chain.GetLastBlockMainSequence)
 		case types.EventGetMainSeqByHash:
@@ -145,6 +153,26 @@ func (chain *BlockChain) getPushLastNum(msg *queue.Message) {
 	msg.Reply(chain.client.NewMessage("rpc", types.EventGetPushLastNum, lastNum))
 }
 
+func (chain *BlockChain) getPushStats(msg *queue.Message) {
+	stats, err := chain.ProcGetPushStats((msg.Data).(*types.ReqString).GetData())
+	if err != nil {
+		chainlog.Error("getPushStats", "err", err.Error())
+		msg.Reply(chain.client.NewMessage("rpc", types.EventGetPushStats, err))
+		return
+	}
+	msg.Reply(chain.client.NewMessage("rpc", types.EventGetPushStats, stats))
+}
+
+func (chain *BlockChain) readPushOutbox(msg *queue.Message) {
+	records, err := chain.ProcReadPushOutbox((msg.Data).(*types.ReqPushOutbox))
+	if err != nil {
+		chainlog.Error("readPushOutbox", "err", err.Error())
+		msg.Reply(chain.client.NewMessage("rpc", types.EventReadPushOutbox, err))
+		return
+	}
+	msg.Reply(chain.client.NewMessage("rpc", types.EventReadPushOutbox, records))
+}
+
 func (chain *BlockChain) queryTx(msg *queue.Message) {
 	txhash := (msg.Data).(*types.ReqHash)
 	txDetail, err := chain.ProcQueryTxMsg(txhash.Hash)
@@ -285,7 +313,16 @@ func (chain *BlockChain) broadcastAddBlock(msg *queue.Message) {
 		msg.Reply(chain.client.NewMessage("", types.EventReply, &reply))
 		return
 	}
-	_, err := chain.ProcAddBlockMsg(true, &types.BlockDetail{Block: blockwithpid.Block}, blockwithpid.Pid)
+	var err error
+	if chain.lightMode {
+		//轻节点只保存广播区块的区块头，不连续的区块头等待同步
+		if castheight <= curheight+1 {
+			header := blockwithpid.Block.GetHeader(chain.client.GetConfig())
+			err = chain.ProcLightHeaders([]*types.Header{header}, blockwithpid.Pid)
+		}
+	} else {
+		_, err = chain.ProcAddBlockMsg(true, &types.BlockDetail{Block: blockwithpid.Block}, blockwithpid.Pid)
+	}
 	if err != nil {
 		chainlog.Error("EventBroadcastAddBlock", "height", castheight, "err", err.Error())
 		reply.IsOk = false
@@ -726,6 +763,32 @@ func (chain *BlockChain) subscribePush(msg *queue.Message) {
 	msg.Reply(chain.client.NewMessage("rpc", types.EventReplySubscribePush, reply))
 }
 
+func (chain *BlockChain) removePush(msg *queue.Message) {
+	reply := &types.ReplySubscribePush{
+		IsOk: true,
+		Msg:  "Succeed",
+	}
+	err := chain.procRemovePush((msg.Data).(*types.ReqPushSubscribeAuth))
+	if err != nil {
+		reply.IsOk = false
+		reply.Msg = err.Error()
+	}
+	msg.Reply(chain.client.NewMessage("rpc", types.EventReplySubscribePush, reply))
+}
+
+func (chain *BlockChain) pausePush(msg *queue.Message) {
+	reply := &types.ReplySubscribePush{
+		IsOk: true,
+		Msg:  "Succeed",
+	}
+	err := chain.procPausePush((msg.Data).(*types.ReqPushSubscribeAuth))
+	if err != nil {
+		reply.IsOk = false
+		reply.Msg = err.Error()
+	}
+	msg.Reply(chain.client.NewMessage("rpc", types.EventReplySubscribePush, reply))
+}
+
 func (chain *BlockChain) highestBlockNum(msg *queue.Message) {
 	var replyBlockHeight types.ReplyBlockHeight
 	replyBlockHeight.Height = chain.GetPeerMaxBlkHeight()
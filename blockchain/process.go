@@ -285,7 +285,10 @@ func (chain *BlockChain) connectBlock(node *blockNode, blockdetail *types.BlockD
 	block := blockdetail.Block
 	prevStateHash := chain.bestChain.Tip().statehash
 	errReturn := (node.pid != "self")
-	blockdetail, _, err = execBlock(chain.client, prevStateHash, block, errReturn, sync)
+	err = chain.checkBlockBaseFee(block)
+	if err == nil {
+		blockdetail, _, err = execBlock(chain.client, prevStateHash, block, errReturn, sync)
+	}
 	handleErrBlk := func(err error) {
 		//记录执行出错的block信息,需要过滤掉一些特殊的错误，不计入故障中，尝试再次执行
 		//快速下载时执行失败的区块不需要记录错误信息，并删除index中此区块的信息尝试通过普通模式再次下载执行
@@ -625,6 +628,23 @@ func (chain *BlockChain) ProcessDelParaChainBlock(broadcast bool, blockdetail *t
 	return nil, true, false, nil
 }
 
+//checkBlockBaseFee 检查区块的基础手续费和根据父区块计算的是否一致
+func (chain *BlockChain) checkBlockBaseFee(block *types.Block) error {
+	if block.GetHeight() == 0 {
+		return nil
+	}
+	parent, err := chain.blockStore.GetBlockHeaderByHash(block.GetParentHash())
+	if err != nil {
+		return err
+	}
+	baseFee := types.CalcBaseFee(chain.client.GetConfig(), parent)
+	if block.GetBaseFee() != baseFee {
+		chainlog.Error("checkBlockBaseFee", "height", block.GetHeight(), "baseFee", block.GetBaseFee(), "expect", baseFee)
+		return types.ErrBaseFee
+	}
+	return nil
+}
+
 // IsRecordFaultErr 检测此错误是否要记录到故障错误中
 func IsRecordFaultErr(err error) bool {
 	return err != types.ErrFutureBlock && !api.IsGrpcError(err) && !api.IsQueueError(err)
@@ -3,10 +3,15 @@ package blockchain
 import (
 	"bytes"
 	"compress/gzip"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"io"
 	"io/ioutil"
 	"net"
 	"net/http"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -14,8 +19,10 @@ import (
 
 	"github.com/33cn/chain33/common"
 	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/metrics"
 	"github.com/33cn/chain33/queue"
 	"github.com/33cn/chain33/types"
+	go_metrics "github.com/rcrowley/go-metrics"
 )
 
 const (
@@ -28,9 +35,29 @@ const (
 	subscribeStatusActive    = int32(1)
 	subscribeStatusNotActive = int32(2)
 	postFail2Sleep           = int32(60) //一次发送失败，sleep的次数
-	chanBufCap               = int(10)
 	encodeJSON               = "jrpc"
 	encodeGrpc               = "grpc"
+	maxPushFilterItems       = 128
+	pushWorkerNum            = 32
+	pushSeqCacheSize         = 256
+	pushBatchCacheSize       = 512
+	pushDispatchInterval     = time.Second
+	pushRespMaxSize          = 64
+)
+
+//推送请求的超时时间，包括连接、发送数据以及读取回复
+var pushPostTimeout = 30 * time.Second
+
+//推送数据签名相关的http头，仅在订阅时设置了secret的情况下才会携带
+const (
+	//PushHeaderName 订阅名称
+	PushHeaderName = "X-Chain33-Push-Name"
+	//PushHeaderSeq 本次推送的最后一个sequence
+	PushHeaderSeq = "X-Chain33-Push-Seq"
+	//PushHeaderTimestamp 推送时间戳，单位秒
+	PushHeaderTimestamp = "X-Chain33-Push-Timestamp"
+	//PushHeaderSignature HMAC-SHA256签名，hex编码
+	PushHeaderSignature = "X-Chain33-Push-Signature"
 )
 
 // PushType ...
@@ -64,6 +91,7 @@ func (p PushType) String() string {
 type CommonStore interface {
 	SetSync(key, value []byte) error
 	Set(key, value []byte) error
+	DelSync(key []byte) error
 	GetKey(key []byte) ([]byte, error)
 	PrefixCount(prefix []byte) int64
 	List(prefix []byte) ([][]byte, error)
@@ -89,17 +117,33 @@ type PostService interface {
 	PostData(subscribe *types.PushSubscribeReq, postdata []byte, seq int64) (err error)
 }
 
-//当前的实现是为每个订阅者单独启动一个协程goroute，然后单独为每个subscriber分别过滤指定类型的交易，
-//进行归类，这种方式集成了区块推送方式的处理机制，但是对于订阅者数量大的情况，势必会浪费cpu的开销，
-//数据库的读取开销不会额外增加明星，因为会有cach
-//TODO：后续需要考虑将区块推送和交易执行回执推送进行重构，提高并行推送效率
+//推送服务由一个调度协程和固定数量的工作协程组成，而不是为每个订阅者单独启动一个协程:
+//1. 调度协程在收到新的sequence通知或者定时器触发时，为所有落后于最新sequence的订阅者生成推送任务
+//2. 每个订阅者同一时刻最多只有一个推送批次在处理，接收方处理慢时只会增大该订阅者的落后数量(lag)，
+//不会阻塞其他订阅者，也不会无限制地积压数据，推送请求有超时时间并且限制读取回复的长度，避免工作协程被接收方长时间占用
+//3. 区块数据按sequence缓存，推送数据按推送分组缓存，同一分组的订阅者共用一份编码后的数据
 //pushNotify push Notify
 type pushNotify struct {
 	subscribe      *types.PushSubscribeReq
-	seqUpdateChan  chan int64
-	closechan      chan struct{}
+	group          string
 	status         int32
 	postFail2Sleep int32
+	inFlight       int32
+	//处理推送批次时持有，停止或者替换推送任务时用于等待正在处理的批次结束
+	lock sync.Mutex
+	//以下字段只在处理推送批次的工作协程中修改，同一时刻只有一个工作协程在处理
+	lastProcessedSeq  int64
+	continueFailCount int32
+	stats             pushNotifyStats
+}
+
+//pushNotifyStats 订阅者的推送统计
+type pushNotifyStats struct {
+	pushCount    int64
+	failCount    int64
+	lastPushTime int64
+	lastPostCost int64
+	lastError    atomic.Value
 }
 
 //Push ...
@@ -112,6 +156,13 @@ type Push struct {
 	cfg            *types.Chain33Config
 	postFail2Sleep int32
 	postwg         *sync.WaitGroup
+	cache          *pushCache
+	jobs           chan *pushNotify
+	trigger        chan struct{}
+	quit           chan struct{}
+	closeOnce      sync.Once
+	lastSeq        int64
+	outbox         *PushOutbox
 }
 
 //PushClient ...
@@ -158,6 +209,15 @@ func buildRPCData(data []byte, subscribe *types.PushSubscribeReq) (*types.PushDa
 
 }
 
+//CalcPushSignature 计算推送数据的签名，接收方使用相同的方法对http body进行验签
+//签名内容为 timestamp + "." + seq + "." + body，其中body为gzip压缩后的原始请求数据
+func CalcPushSignature(secret string, timestamp, seq int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "." + strconv.FormatInt(seq, 10) + "."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
 //PostData ...
 func (pushClient *PushClient) PostData(subscribe *types.PushSubscribeReq, postdata []byte, seq int64) (err error) {
 	//post data in body
@@ -191,19 +251,28 @@ func (pushClient *PushClient) PostData(subscribe *types.PushSubscribeReq, postda
 		return err
 	}
 
-	req, err := http.NewRequest("POST", subscribe.URL, &buf)
+	reqBody := buf.Bytes()
+	req, err := http.NewRequest("POST", subscribe.URL, bytes.NewReader(reqBody))
 	if err != nil {
 		return err
 	}
 
 	req.Header.Set("Content-Type", "text/plain")
 	req.Header.Set("Content-Encoding", "gzip")
+	if subscribe.GetSecret() != "" {
+		timestamp := types.Now().Unix()
+		req.Header.Set(PushHeaderName, subscribe.Name)
+		req.Header.Set(PushHeaderSeq, strconv.FormatInt(seq, 10))
+		req.Header.Set(PushHeaderTimestamp, strconv.FormatInt(timestamp, 10))
+		req.Header.Set(PushHeaderSignature, CalcPushSignature(subscribe.Secret, timestamp, seq, reqBody))
+	}
 	resp, err := pushClient.client.Do(req)
 	if err != nil {
 		chainlog.Info("postData", "Do err", err)
 		return err
 	}
-	body, err := ioutil.ReadAll(resp.Body)
+	//接收方只需要回复ok，限制读取的长度
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, pushRespMaxSize))
 	if err != nil {
 		_ = resp.Body.Close()
 		return err
@@ -243,6 +312,35 @@ func (chain *BlockChain) procSubscribePush(subscribe *types.PushSubscribeReq) er
 	return chain.push.addSubscriber(subscribe)
 }
 
+//procRemovePush 删除推送订阅
+func (chain *BlockChain) procRemovePush(req *types.ReqPushSubscribeAuth) error {
+	if err := chain.checkPushEnable(req); err != nil {
+		return err
+	}
+	return chain.push.removeSubscriber(req)
+}
+
+//procPausePush 暂停推送订阅
+func (chain *BlockChain) procPausePush(req *types.ReqPushSubscribeAuth) error {
+	if err := chain.checkPushEnable(req); err != nil {
+		return err
+	}
+	return chain.push.pauseSubscriber(req)
+}
+
+func (chain *BlockChain) checkPushEnable(req *types.ReqPushSubscribeAuth) error {
+	if !chain.enablePushSubscribe {
+		return types.ErrPushNotSupport
+	}
+	if !chain.isRecordBlockSequence {
+		return types.ErrRecordBlockSequence
+	}
+	if req == nil || req.GetName() == "" {
+		return types.ErrInvalidParam
+	}
+	return nil
+}
+
 //ProcListPush 列出所有已经设置的推送订阅
 func (chain *BlockChain) ProcListPush() (*types.PushSubscribes, error) {
 	if !chain.isRecordBlockSequence {
@@ -263,6 +361,8 @@ func (chain *BlockChain) ProcListPush() (*types.PushSubscribes, error) {
 		if err != nil {
 			return nil, err
 		}
+		//密钥不对外展示
+		onePush.Push.Secret = ""
 		listSeqCBs.Pushes = append(listSeqCBs.Pushes, onePush.Push)
 	}
 	return &listSeqCBs, nil
@@ -293,12 +393,36 @@ func (chain *BlockChain) ProcGetLastPushSeq(name string) (int64, error) {
 	return n, nil
 }
 
-func newpush(commonStore CommonStore, seqStore SequenceStore, qclient queue.Client) *Push {
-	tasks := make(map[string]*pushNotify)
+//ProcReadPushOutbox 读取outbox中的推送记录
+func (chain *BlockChain) ProcReadPushOutbox(req *types.ReqPushOutbox) (*types.PushOutboxRecords, error) {
+	if !chain.isRecordBlockSequence {
+		return nil, types.ErrRecordBlockSequence
+	}
+	if !chain.enablePushSubscribe {
+		return nil, types.ErrPushNotSupport
+	}
+	if req == nil || req.GetName() == "" || req.GetOffset() < 0 {
+		return nil, types.ErrInvalidParam
+	}
+	return chain.push.readOutbox(req)
+}
 
-	pushClient := &PushClient{
-		qclient: qclient,
-		client: &http.Client{Transport: &http.Transport{
+//ProcGetPushStats 获取推送服务的运行统计
+func (chain *BlockChain) ProcGetPushStats(name string) (*types.PushStats, error) {
+	if !chain.isRecordBlockSequence {
+		return nil, types.ErrRecordBlockSequence
+	}
+	if !chain.enablePushSubscribe {
+		return nil, types.ErrPushNotSupport
+	}
+	return chain.push.getPushStats(name)
+}
+
+//newPushHTTPClient 每次推送请求的总耗时不超过pushPostTimeout，接收方处理慢时不会一直占用工作协程
+func newPushHTTPClient() *http.Client {
+	return &http.Client{
+		Timeout: pushPostTimeout,
+		Transport: &http.Transport{
 			Dial: (&net.Dialer{
 				Timeout:   30 * time.Second,
 				KeepAlive: 30 * time.Second,
@@ -306,7 +430,16 @@ func newpush(commonStore CommonStore, seqStore SequenceStore, qclient queue.Clie
 			TLSHandshakeTimeout:   10 * time.Second,
 			ResponseHeaderTimeout: 10 * time.Second,
 			ExpectContinueTimeout: 1 * time.Second,
-		}},
+		},
+	}
+}
+
+func newpush(commonStore CommonStore, seqStore SequenceStore, qclient queue.Client) *Push {
+	tasks := make(map[string]*pushNotify)
+
+	pushClient := &PushClient{
+		qclient: qclient,
+		client:  newPushHTTPClient(),
 	}
 	service := &Push{store: commonStore,
 		sequenceStore:  seqStore,
@@ -315,7 +448,22 @@ func newpush(commonStore CommonStore, seqStore SequenceStore, qclient queue.Clie
 		cfg:            qclient.GetConfig(),
 		postFail2Sleep: postFail2Sleep,
 		postwg:         &sync.WaitGroup{},
+		cache:          newPushCache(),
+		jobs:           make(chan *pushNotify, maxPushSubscriber),
+		trigger:        make(chan struct{}, 1),
+		quit:           make(chan struct{}),
+		lastSeq:        -1,
+	}
+	mcfg := service.cfg.GetModuleConfig().BlockChain
+	if mcfg.PushOutboxPath != "" {
+		outbox, err := NewPushOutbox(mcfg.PushOutboxPath, mcfg.PushOutboxSegmentSize*1024*1024, mcfg.PushOutboxMaxSegments)
+		if err != nil {
+			chainlog.Error("newpush", "NewPushOutbox err", err)
+		} else {
+			service.outbox = outbox
+		}
 	}
+	service.start()
 	service.init()
 
 	return service
@@ -346,7 +494,8 @@ func (push *Push) init() {
 
 	}
 	for _, subscribe := range subscribes {
-		if subscribe.GetEncode() != encodeGrpc { // grpc 不需要节点启动之后主动推送过去
+		// grpc 不需要节点启动之后主动推送过去, outbox方式的推送除外
+		if subscribe.GetEncode() != encodeGrpc || subscribe.GetSink() == pushSinkOutbox {
 			chainlog.Info("Push init", "Going to add Task to Push for Name", subscribe.Name)
 
 			push.addTask(subscribe)
@@ -357,12 +506,13 @@ func (push *Push) init() {
 
 // Close ...
 func (push *Push) Close() {
-	push.mu.Lock()
-	for _, task := range push.tasks {
-		close(task.closechan)
-	}
-	push.mu.Unlock()
+	push.closeOnce.Do(func() {
+		close(push.quit)
+	})
 	push.postwg.Wait()
+	if push.outbox != nil {
+		push.outbox.Close()
+	}
 }
 
 func (push *Push) addSubscriber(subscribe *types.PushSubscribeReq) error {
@@ -391,9 +541,13 @@ func (push *Push) addSubscriber(subscribe *types.PushSubscribeReq) error {
 	}
 	//如果该用户已经注册了订阅请求，则只是确认是否需用重新启动，否则就直接返回
 	if exist, subscribeInDB := push.hasSubscriberExist(subscribe); exist {
-		if subscribeInDB.URL != subscribe.URL || subscribeInDB.Type != subscribe.Type {
+		if subscribeInDB.URL != subscribe.URL || subscribeInDB.Type != subscribe.Type || subscribeInDB.Sink != subscribe.Sink {
 			return types.ErrNotAllowModifyPush
 		}
+		//设置了密钥的订阅，需要验证密钥后才能重新激活
+		if subscribeInDB.Secret != "" && !hmac.Equal([]byte(subscribeInDB.Secret), []byte(subscribe.Secret)) {
+			return types.ErrPushAuthFailed
+		}
 		//使用保存在数据库中的push配置，而不是最新的配置信息
 		if err := push.check2ResumePush(subscribeInDB); nil != err {
 			return err
@@ -455,7 +609,23 @@ func (push *Push) persisAndStart(subscribe *types.PushSubscribeReq) error {
 		return errors.New(types.ErrInvalidParam.Error() + ": Name or URL exceeds limit（len(Name)<128),len(URL)<1024")
 	}
 
-	if subscribe.GetURL() == "" && subscribe.GetEncode() != "grpc" {
+	if !checkPushFilter(subscribe.GetFilter()) {
+		storeLog.Error("Invalid para to persisAndStart due to too many filter items")
+		return errors.New(types.ErrInvalidParam.Error() + ": filter items exceeds limit(128)")
+	}
+
+	switch subscribe.GetSink() {
+	case "":
+	case pushSinkOutbox:
+		if push.outbox == nil {
+			storeLog.Error("persisAndStart", "err", types.ErrPushOutboxNotSupport)
+			return types.ErrPushOutboxNotSupport
+		}
+	default:
+		return errors.New(types.ErrInvalidParam.Error() + ": sink not supported")
+	}
+
+	if subscribe.GetURL() == "" && subscribe.GetEncode() != "grpc" && subscribe.GetSink() != pushSinkOutbox {
 		//非grpc通信必须要求配置url
 		storeLog.Info("persisAndStart", "url empty", subscribe.GetURL(), "encode:", subscribe.GetEncode())
 		return errors.New(types.ErrInvalidParam.Error() + ": URL must be configure")
@@ -472,6 +642,113 @@ func (push *Push) persisAndStart(subscribe *types.PushSubscribeReq) error {
 
 }
 
+//获取订阅信息，并验证密钥，未设置密钥的订阅只能通过rpc运维接口取消或者暂停
+func (push *Push) authSubscriber(req *types.ReqPushSubscribeAuth) (*types.PushWithStatus, error) {
+	value, err := push.store.GetKey(calcPushKey(req.Name))
+	if err != nil {
+		return nil, types.ErrPushNotSubscribed
+	}
+	var pushWithStatus types.PushWithStatus
+	err = types.Decode(value, &pushWithStatus)
+	if err != nil {
+		return nil, err
+	}
+	if req.Operator {
+		chainlog.Info("authSubscriber by operator", "name", req.Name)
+		return &pushWithStatus, nil
+	}
+	secret := pushWithStatus.GetPush().GetSecret()
+	if secret == "" || !hmac.Equal([]byte(secret), []byte(req.Secret)) {
+		chainlog.Error("authSubscriber", "name", req.Name, "err", types.ErrPushAuthFailed)
+		return nil, types.ErrPushAuthFailed
+	}
+	return &pushWithStatus, nil
+}
+
+//readOutbox 读取outbox，设置了密钥的订阅需要验证密钥
+func (push *Push) readOutbox(req *types.ReqPushOutbox) (*types.PushOutboxRecords, error) {
+	if push.outbox == nil {
+		return nil, types.ErrPushOutboxNotSupport
+	}
+	exist, subscribe := push.hasSubscriberExist(&types.PushSubscribeReq{Name: req.Name})
+	if !exist {
+		return nil, types.ErrPushNotSubscribed
+	}
+	if subscribe.GetSink() != pushSinkOutbox {
+		return nil, types.ErrPushOutboxNotSupport
+	}
+	if subscribe.GetSecret() != "" && !hmac.Equal([]byte(subscribe.GetSecret()), []byte(req.GetSecret())) {
+		return nil, types.ErrPushAuthFailed
+	}
+	return push.outbox.Read(req.Name, req.Offset, int(req.Count))
+}
+
+//停止推送任务，并等待正在处理的推送批次结束，避免之后再更新推送进度
+func (push *Push) stopTask(name string) {
+	push.mu.Lock()
+	keyStr := string(calcPushKey(name))
+	notify, ok := push.tasks[keyStr]
+	if ok {
+		delete(push.tasks, keyStr)
+		atomic.StoreInt32(&notify.status, notRunning)
+	}
+	push.mu.Unlock()
+	//处理推送批次时可能需要获取push.mu, 不能在持有push.mu时等待
+	if ok {
+		//持有任务锁时注销lag，避免正在处理的批次结束时重新注册
+		notify.lock.Lock()
+		go_metrics.Unregister(pushLagName(name))
+		notify.lock.Unlock()
+	}
+}
+
+func pushLagName(name string) string {
+	return metrics.Name("push_subscriber_lag", "name", name)
+}
+
+//updateLag 更新订阅者落后最新sequence的数量，推送任务停止后不再更新，需要持有任务锁
+func (push *Push) updateLag(in *pushNotify) {
+	if atomic.LoadInt32(&in.status) != running {
+		return
+	}
+	lag := atomic.LoadInt64(&push.lastSeq) - atomic.LoadInt64(&in.lastProcessedSeq)
+	go_metrics.GetOrRegisterGauge(pushLagName(in.subscribe.Name), nil).Update(lag)
+}
+
+//删除订阅，包括订阅信息和推送进度
+func (push *Push) removeSubscriber(req *types.ReqPushSubscribeAuth) error {
+	if _, err := push.authSubscriber(req); err != nil {
+		return err
+	}
+	push.stopTask(req.Name)
+	if err := push.store.DelSync(calcPushKey(req.Name)); err != nil {
+		return err
+	}
+	chainlog.Info("removeSubscriber", "name", req.Name)
+	if err := push.store.DelSync(calcLastPushSeqNumKey(req.Name)); err != nil {
+		return err
+	}
+	if push.outbox != nil {
+		return push.outbox.Remove(req.Name)
+	}
+	return nil
+}
+
+//暂停订阅，保留推送进度，重新调用AddPushSubscribe后从上次推送成功处继续推送
+func (push *Push) pauseSubscriber(req *types.ReqPushSubscribeAuth) error {
+	pushWithStatus, err := push.authSubscriber(req)
+	if err != nil {
+		return err
+	}
+	push.stopTask(req.Name)
+	if pushWithStatus.Status == subscribeStatusNotActive {
+		return nil
+	}
+	pushWithStatus.Status = subscribeStatusNotActive
+	chainlog.Info("pauseSubscriber", "name", req.Name)
+	return push.store.SetSync(calcPushKey(req.Name), types.Encode(pushWithStatus))
+}
+
 func (push *Push) setActive(subscribe *types.PushSubscribeReq) error {
 	key := calcPushKey(subscribe.Name)
 	value, err := push.store.GetKey(key)
@@ -508,13 +785,8 @@ func (push *Push) check2ResumePush(subscribe *types.PushSubscribeReq) error {
 	notify := push.tasks[keyStr]
 	//有可能因为连续发送失败已经导致将其从推送任务中删除了
 	if nil == notify {
-		push.tasks[keyStr] = &pushNotify{
-			subscribe:     subscribe,
-			seqUpdateChan: make(chan int64, chanBufCap),
-			closechan:     make(chan struct{}),
-			status:        notRunning,
-		}
-		push.runTask(push.tasks[keyStr])
+		push.tasks[keyStr] = push.newPushNotify(subscribe)
+		push.notify()
 		storeLog.Info("check2ResumePush new pushNotify created")
 		return nil
 	}
@@ -522,25 +794,33 @@ func (push *Push) check2ResumePush(subscribe *types.PushSubscribeReq) error {
 	if running == atomic.LoadInt32(&notify.status) {
 		storeLog.Info("Is already in state:running", "postFail2Sleep", atomic.LoadInt32(&notify.postFail2Sleep))
 		atomic.StoreInt32(&notify.postFail2Sleep, 0)
+		push.notify()
 		return nil
 	}
 	storeLog.Info("check2ResumePush to resume a push", "name", subscribe.Name)
 
-	push.runTask(push.tasks[keyStr])
+	//旧的推送批次可能还在处理中，需要等待其结束并更新推送进度后，再从推送进度处创建新的推送任务，避免重复推送
+	push.mu.Unlock()
+	notify.lock.Lock()
+	notify.lock.Unlock()
+	push.mu.Lock()
+	if current := push.tasks[keyStr]; current != nil && current != notify && running == atomic.LoadInt32(&current.status) {
+		push.notify()
+		return nil
+	}
+	push.tasks[keyStr] = push.newPushNotify(subscribe)
+	push.notify()
 	return nil
 }
 
-//每次add一个新push时,发送最新的seq
-func (push *Push) updateLastSeq(name string) {
-	last, err := push.sequenceStore.LoadBlockLastSequence()
-	if err != nil {
-		chainlog.Error("LoadBlockLastSequence", "err", err)
-		return
+func (push *Push) newPushNotify(subscribe *types.PushSubscribeReq) *pushNotify {
+	return &pushNotify{
+		subscribe:        subscribe,
+		group:            calcPushGroup(subscribe),
+		status:           running,
+		postFail2Sleep:   0,
+		lastProcessedSeq: push.getLastPushSeq(subscribe),
 	}
-
-	notify := push.tasks[string(calcPushKey(name))]
-	notify.seqUpdateChan <- last
-	chainlog.Debug("updateLastSeq", "last", last, "notify.seqUpdateChan", len(notify.seqUpdateChan))
 }
 
 // addTask 每个name 有一个task, 通知新增推送
@@ -548,193 +828,368 @@ func (push *Push) addTask(subscribe *types.PushSubscribeReq) {
 	push.mu.Lock()
 	defer push.mu.Unlock()
 	keyStr := string(calcPushKey(subscribe.Name))
-	push.tasks[keyStr] = &pushNotify{
-		subscribe:      subscribe,
-		seqUpdateChan:  make(chan int64, chanBufCap),
-		closechan:      make(chan struct{}),
-		status:         notRunning,
-		postFail2Sleep: 0,
-	}
-
-	push.runTask(push.tasks[keyStr])
+	push.tasks[keyStr] = push.newPushNotify(subscribe)
+	push.notify()
 }
 
-func trigeRun(run chan struct{}, sleep time.Duration, name string) {
-	chainlog.Info("trigeRun", name, "name", "sleep", sleep, "run len", len(run))
-	if sleep > 0 {
-		time.Sleep(sleep)
+//notify 触发一次调度，调度协程正忙时合并通知
+func (push *Push) notify() {
+	select {
+	case push.trigger <- struct{}{}:
+	default:
 	}
-	go func() {
-		run <- struct{}{}
-	}()
 }
 
-func (push *Push) runTask(input *pushNotify) {
-	//触发goroutine运行
-	push.updateLastSeq(input.subscribe.Name)
+//start 启动调度协程以及工作协程
+func (push *Push) start() {
+	push.postwg.Add(pushWorkerNum + 1)
+	for i := 0; i < pushWorkerNum; i++ {
+		go push.worker()
+	}
+	go push.dispatcher()
+}
 
-	go func(in *pushNotify) {
-		var lastesBlockSeq int64
-		var continueFailCount int32
-		var err error
+func (push *Push) dispatcher() {
+	defer push.postwg.Done()
+	ticker := time.NewTicker(pushDispatchInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-push.trigger:
+			push.dispatch(false)
+		case <-ticker.C:
+			push.dispatch(true)
+		case <-push.quit:
+			chainlog.Info("push dispatcher closed")
+			return
+		}
+	}
+}
 
-		subscribe := in.subscribe
-		lastProcessedseq := push.getLastPushSeq(subscribe)
-		atomic.StoreInt32(&in.status, running)
+//dispatch 为落后于最新sequence的订阅者生成推送任务，tick为true时对推送失败的订阅者进行倒计时
+func (push *Push) dispatch(tick bool) {
+	lastesBlockSeq, err := push.sequenceStore.LoadBlockLastSequence()
+	if err != nil {
+		chainlog.Error("LoadBlockLastSequence", "err", err)
+		return
+	}
+	atomic.StoreInt64(&push.lastSeq, lastesBlockSeq)
 
-		runChan := make(chan struct{}, 10)
-		pushMaxSeq := pushBlockMaxSeq
-		if PushType(subscribe.Type) == PushTxReceipt {
-			pushMaxSeq = pushTxReceiptMaxSeq
+	push.mu.Lock()
+	defer push.mu.Unlock()
+	for _, in := range push.tasks {
+		if running != atomic.LoadInt32(&in.status) {
+			continue
 		}
+		//首先判断是否存在发送失败的情况，如果存在，则每秒倒计时一次，倒计时结束后才重新推送
+		if atomic.LoadInt32(&in.postFail2Sleep) > 0 {
+			if !tick {
+				continue
+			}
+			if postFail2SleepNew := atomic.AddInt32(&in.postFail2Sleep, -1); postFail2SleepNew > 0 {
+				chainlog.Debug("wait another ticker for post fail", "postFail2Sleep", postFail2SleepNew, "name", in.subscribe.Name)
+				continue
+			}
+		}
+		lastProcessedseq := atomic.LoadInt64(&in.lastProcessedSeq)
+		if lastProcessedseq > 0 && lastProcessedseq >= lastesBlockSeq {
+			continue
+		}
+		//背压：每个订阅者同时只有一个推送批次在处理
+		if !atomic.CompareAndSwapInt32(&in.inFlight, 0, 1) {
+			continue
+		}
+		select {
+		case push.jobs <- in:
+		default:
+			atomic.StoreInt32(&in.inFlight, 0)
+		}
+	}
+}
 
-		chainlog.Debug("start push with info", "subscribe name", subscribe.Name, "Type", PushType(subscribe.Type).String())
-		for {
-			select {
-			case <-runChan:
-				if atomic.LoadInt32(&input.postFail2Sleep) > 0 {
-					if postFail2SleepNew := atomic.AddInt32(&input.postFail2Sleep, -1); postFail2SleepNew > 0 {
-						chainlog.Debug("wait another ticker for post fail", "postFail2Sleep", postFail2SleepNew, "name", in.subscribe.Name)
-						trigeRun(runChan, time.Second, subscribe.Name)
-						continue
-					}
-				}
-
-			case lastestSeq := <-in.seqUpdateChan:
-				chainlog.Debug("runTask recv:", "lastestSeq", lastestSeq, "subscribe name", subscribe.Name, "Type", PushType(subscribe.Type).String())
-				//首先判断是否存在发送失败的情况，如果存在，则进行进行sleep操作
-				if atomic.LoadInt32(&input.postFail2Sleep) > 0 {
-					if postFail2SleepNew := atomic.AddInt32(&input.postFail2Sleep, -1); postFail2SleepNew > 0 {
-						chainlog.Debug("wait another ticker for post fail", "postFail2Sleep", postFail2SleepNew, "name", in.subscribe.Name)
-						trigeRun(runChan, time.Second, subscribe.Name)
-						continue
-					}
-				}
-				//获取当前最新的sequence,这样就可以一次性发送多个区块的信息，而不需要每次从通知chan中获取最新sequence
-				if lastesBlockSeq, err = push.sequenceStore.LoadBlockLastSequence(); err != nil {
-					chainlog.Error("LoadBlockLastSequence", "err", err)
-					return
-				}
+func (push *Push) worker() {
+	defer push.postwg.Done()
+	for {
+		select {
+		case in := <-push.jobs:
+			more := push.processTask(in)
+			atomic.StoreInt32(&in.inFlight, 0)
+			// 在联盟链情况下, 无新增交易的情况下, 不会完成从新开始同步
+			// 在公链情况下, 需要有新区块才能触发推送,
+			// 所以这里在未同步到最新区块, 需要主动触发同步
+			if more {
+				push.notify()
+			}
+		case <-push.quit:
+			return
+		}
+	}
+}
 
-				//没有更新的区块，则不进行处理，同时等待一定的时间
-				if lastProcessedseq >= lastesBlockSeq {
-					continue
-				}
-				if lastProcessedseq <= 0 { //如果不配置startSeq 则默认从最新的seq开始
-					lastProcessedseq = lastesBlockSeq
-					continue
-				}
-				chainlog.Debug("another new block", "subscribe name", subscribe.Name, "Type", PushType(subscribe.Type).String(),
-					"last push sequence", lastProcessedseq, "lastest sequence", lastesBlockSeq,
-					"time second", time.Now().Second())
-				//确定一次推送的数量，如果需要更新的数量少于门限值，则一次只推送一个区块的交易数据
-				seqCount := pushMaxSeq
-				if seqCount > int(lastesBlockSeq-lastProcessedseq) {
-					seqCount = int(lastesBlockSeq - lastProcessedseq)
-				}
-				data, updateSeq, err := push.getPushData(subscribe, lastProcessedseq+1, seqCount, pushMaxSize)
-				if err != nil {
-					chainlog.Error("getPushData", "err", err, "seqCurrent", lastProcessedseq+1, "maxSeq", seqCount,
-						"Name", subscribe.Name, "pushType:", PushType(subscribe.Type).String())
-					continue
-				}
+//processTask 处理订阅者的一个推送批次，返回是否还有需要继续推送的数据
+func (push *Push) processTask(in *pushNotify) bool {
+	in.lock.Lock()
+	defer in.lock.Unlock()
+	//推送任务已经停止或者被替换
+	if running != atomic.LoadInt32(&in.status) {
+		return false
+	}
+	subscribe := in.subscribe
+	defer push.updateLag(in)
+	//获取当前最新的sequence,这样就可以一次性发送多个区块的信息，而不需要每次从通知chan中获取最新sequence
+	lastesBlockSeq := atomic.LoadInt64(&push.lastSeq)
+	lastProcessedseq := atomic.LoadInt64(&in.lastProcessedSeq)
+	//没有更新的区块，则不进行处理
+	if lastProcessedseq >= lastesBlockSeq {
+		return false
+	}
+	if lastProcessedseq <= 0 { //如果不配置startSeq 则默认从最新的seq开始
+		atomic.StoreInt64(&in.lastProcessedSeq, lastesBlockSeq)
+		return false
+	}
+	chainlog.Debug("another new block", "subscribe name", subscribe.Name, "Type", PushType(subscribe.Type).String(),
+		"last push sequence", lastProcessedseq, "lastest sequence", lastesBlockSeq)
+
+	pushMaxSeq := pushBlockMaxSeq
+	if PushType(subscribe.Type) == PushTxReceipt {
+		pushMaxSeq = pushTxReceiptMaxSeq
+	}
+	//确定一次推送的数量，如果需要更新的数量少于门限值，则一次只推送一个区块的交易数据
+	seqCount := pushMaxSeq
+	if seqCount > int(lastesBlockSeq-lastProcessedseq) {
+		seqCount = int(lastesBlockSeq - lastProcessedseq)
+	}
+	data, updateSeq, err := push.getSharedPushData(in, lastProcessedseq+1, seqCount, pushMaxSize)
+	if err != nil {
+		chainlog.Error("getPushData", "err", err, "seqCurrent", lastProcessedseq+1, "maxSeq", seqCount,
+			"Name", subscribe.Name, "pushType:", PushType(subscribe.Type).String())
+		in.stats.lastError.Store(err.Error())
+		return false
+	}
 
-				if data != nil {
-					err = push.postService.PostData(subscribe, data, updateSeq)
-					if err != nil {
-						continueFailCount++
-						chainlog.Error("postdata failed", "err", err, "lastProcessedseq", lastProcessedseq,
-							"Name", subscribe.Name, "pushType:", PushType(subscribe.Type).String(), "continueFailCount", continueFailCount)
-						if continueFailCount >= 3 {
-							atomic.StoreInt32(&in.status, notRunning)
-							chainlog.Error("postdata failed exceed 3 times", "Name", subscribe.Name, "in.status", atomic.LoadInt32(&in.status))
-
-							pushWithStatus := &types.PushWithStatus{
-								Push:   subscribe,
-								Status: subscribeStatusNotActive,
-							}
-
-							key := calcPushKey(subscribe.Name)
-							push.mu.Lock()
-							delete(push.tasks, string(key))
-							push.mu.Unlock()
-							//多次Post失败后，把这个subscriber设置为NoActive状态，停止这个task的运行
-							_ = push.store.SetSync(key, types.Encode(pushWithStatus))
-							push.postwg.Done()
-							return
-						}
-						//sleep 60s，每次1s，总计60次，在每次结束时，等待接收方重新进行请求推送
-						atomic.StoreInt32(&input.postFail2Sleep, push.postFail2Sleep)
-						trigeRun(runChan, time.Second, subscribe.Name)
-						continue
-					}
-					_ = push.setLastPushSeq(subscribe.Name, updateSeq)
-				}
-				continueFailCount = 0
-				lastProcessedseq = updateSeq
-				// 在联盟链情况下, 无新增交易的情况下, 不会完成从新开始同步
-				// 在公链情况下, 需要有新区块才能触发推送,
-				// 所以这里在未同步到最新区块, 需要主动触发同步
-				if lastProcessedseq < lastesBlockSeq {
-					push.mu.Lock()
-					if len(in.seqUpdateChan) == 0 {
-						in.seqUpdateChan <- lastesBlockSeq
-					}
-					push.mu.Unlock()
-				}
-			case <-in.closechan:
-				push.postwg.Done()
-				chainlog.Info("getPushData", "push task closed for subscribe", subscribe.Name)
-				return
+	if data != nil {
+		begin := types.Now()
+		err = push.getPostService(subscribe).PostData(subscribe, data, updateSeq)
+		if err != nil {
+			continueFailCount := atomic.AddInt32(&in.continueFailCount, 1)
+			atomic.AddInt64(&in.stats.failCount, 1)
+			in.stats.lastError.Store(err.Error())
+			chainlog.Error("postdata failed", "err", err, "lastProcessedseq", lastProcessedseq,
+				"Name", subscribe.Name, "pushType:", PushType(subscribe.Type).String(), "continueFailCount", continueFailCount)
+			if continueFailCount >= 3 {
+				push.deactivate(in)
+				return false
 			}
+			//sleep 60s，每次1s，总计60次，在每次结束时，等待接收方重新进行请求推送
+			atomic.StoreInt32(&in.postFail2Sleep, push.postFail2Sleep)
+			return false
 		}
+		_ = push.setLastPushSeq(subscribe.Name, updateSeq)
+		atomic.AddInt64(&in.stats.pushCount, 1)
+		atomic.StoreInt64(&in.stats.lastPushTime, types.Now().Unix())
+		atomic.StoreInt64(&in.stats.lastPostCost, int64(types.Since(begin)/time.Millisecond))
+	}
+	atomic.StoreInt32(&in.continueFailCount, 0)
+	atomic.StoreInt64(&in.lastProcessedSeq, updateSeq)
+	return updateSeq < lastesBlockSeq
+}
 
-	}(input)
-	push.postwg.Add(1)
+func (push *Push) getPostService(subscribe *types.PushSubscribeReq) PostService {
+	if subscribe.GetSink() == pushSinkOutbox && push.outbox != nil {
+		return push.outbox
+	}
+	return push.postService
+}
+
+//deactivate 多次Post失败后，把这个subscriber设置为NoActive状态，停止这个task的运行
+func (push *Push) deactivate(in *pushNotify) {
+	subscribe := in.subscribe
+	atomic.StoreInt32(&in.status, notRunning)
+	chainlog.Error("postdata failed exceed 3 times", "Name", subscribe.Name, "in.status", atomic.LoadInt32(&in.status))
+
+	pushWithStatus := &types.PushWithStatus{
+		Push:   subscribe,
+		Status: subscribeStatusNotActive,
+	}
+	key := calcPushKey(subscribe.Name)
+	push.mu.Lock()
+	//可能已经被取消或者暂停订阅
+	stillRunning := push.tasks[string(key)] == in
+	if stillRunning {
+		delete(push.tasks, string(key))
+		go_metrics.Unregister(pushLagName(subscribe.Name))
+	}
+	push.mu.Unlock()
+	if stillRunning {
+		_ = push.store.SetSync(key, types.Encode(pushWithStatus))
+	}
 }
 
 // UpdateSeq sequence 更新通知
 func (push *Push) UpdateSeq(seq int64) {
+	chainlog.Debug("new block UpdateSeq", "current sequence", seq)
+	push.notify()
+}
+
+//getPushStats 获取推送服务的运行统计，name为空时返回所有订阅者的统计
+func (push *Push) getPushStats(name string) (*types.PushStats, error) {
+	var values [][]byte
+	if name != "" {
+		value, err := push.store.GetKey(calcPushKey(name))
+		if err != nil {
+			return nil, types.ErrPushNotSubscribed
+		}
+		values = append(values, value)
+	} else {
+		var err error
+		values, err = push.store.List(pushPrefix)
+		if err != nil && err != dbm.ErrNotFoundInDb {
+			return nil, err
+		}
+	}
+
+	lastSeq := atomic.LoadInt64(&push.lastSeq)
+	stats := &types.PushStats{LastSequence: lastSeq}
+	push.cache.fillStats(stats)
 	push.mu.Lock()
 	defer push.mu.Unlock()
-	for _, notify := range push.tasks {
-		//再写入seq（一定不会block，因为加了lock，不存在两个同时写channel的情况）
-		if len(notify.seqUpdateChan) < chanBufCap {
-			chainlog.Info("new block Update Seq notified", "subscribe", notify.subscribe.Name, "current sequence", seq, "length", len(notify.seqUpdateChan), "status", atomic.LoadInt32(&notify.status))
-			notify.seqUpdateChan <- seq
+	for _, value := range values {
+		var pushWithStatus types.PushWithStatus
+		if err := types.Decode(value, &pushWithStatus); err != nil {
+			return nil, err
+		}
+		subscribe := pushWithStatus.GetPush()
+		stat := &types.PushSubscriberStats{
+			Name:   subscribe.GetName(),
+			Type:   subscribe.GetType(),
+			Encode: subscribe.GetEncode(),
+			Status: pushWithStatus.GetStatus(),
 		}
-		chainlog.Info("new block UpdateSeq", "subscribe", notify.subscribe.Name, "current sequence", seq, "length", len(notify.seqUpdateChan), "status", atomic.LoadInt32(&notify.status))
+		in, ok := push.tasks[string(calcPushKey(subscribe.GetName()))]
+		if !ok {
+			stat.LastPushSeq = push.getLastPushSeq(subscribe)
+		} else {
+			stat.Running = atomic.LoadInt32(&in.status) == running
+			stat.LastPushSeq = atomic.LoadInt64(&in.lastProcessedSeq)
+			stat.InFlight = atomic.LoadInt32(&in.inFlight) == 1
+			stat.PushCount = atomic.LoadInt64(&in.stats.pushCount)
+			stat.FailCount = atomic.LoadInt64(&in.stats.failCount)
+			stat.ContinueFailCount = atomic.LoadInt32(&in.continueFailCount)
+			stat.SleepSeconds = atomic.LoadInt32(&in.postFail2Sleep)
+			stat.LastPushTime = atomic.LoadInt64(&in.stats.lastPushTime)
+			stat.LastPostCost = atomic.LoadInt64(&in.stats.lastPostCost)
+			if lastErr, ok := in.stats.lastError.Load().(string); ok {
+				stat.LastError = lastErr
+			}
+		}
+		if stat.LastPushSeq >= 0 && lastSeq > stat.LastPushSeq {
+			stat.Lag = lastSeq - stat.LastPushSeq
+		}
+		stats.Subscribers = append(stats.Subscribers, stat)
+	}
+	return stats, nil
+}
+
+//pushFilter 订阅的过滤条件，nil表示不过滤
+type pushFilter struct {
+	contractAddrs map[string]bool
+	execers       map[string]bool
+	toAddrs       map[string]bool
+}
+
+func checkPushFilter(filter *types.PushFilter) bool {
+	return len(filter.GetContractAddrs()) <= maxPushFilterItems &&
+		len(filter.GetExecers()) <= maxPushFilterItems &&
+		len(filter.GetToAddrs()) <= maxPushFilterItems
+}
+
+func newPushFilter(filter *types.PushFilter) *pushFilter {
+	if len(filter.GetContractAddrs()) == 0 && len(filter.GetExecers()) == 0 && len(filter.GetToAddrs()) == 0 {
+		return nil
+	}
+	f := &pushFilter{
+		contractAddrs: make(map[string]bool),
+		execers:       make(map[string]bool),
+		toAddrs:       make(map[string]bool),
+	}
+	//地址统一转换成小写，兼容eth格式的地址
+	for _, addr := range filter.GetContractAddrs() {
+		f.contractAddrs[strings.ToLower(addr)] = true
+	}
+	for _, execer := range filter.GetExecers() {
+		f.execers[execer] = true
+	}
+	for _, addr := range filter.GetToAddrs() {
+		f.toAddrs[strings.ToLower(addr)] = true
+	}
+	return f
+}
+
+//match 交易满足任意一个过滤条件即可
+func (f *pushFilter) match(tx *types.Transaction) bool {
+	if f == nil {
+		return true
+	}
+	if f.execers[string(tx.Execer)] {
+		return true
 	}
+	if f.toAddrs[strings.ToLower(tx.GetTo())] {
+		return true
+	}
+	if len(f.contractAddrs) > 0 && strings.Contains(string(tx.Execer), "evm") {
+		var evmAction types.EVMContractAction4Chain33
+		if err := types.Decode(tx.Payload, &evmAction); err == nil {
+			return f.contractAddrs[strings.ToLower(evmAction.ContractAddr)]
+		}
+	}
+	return false
+}
+
+func (f *pushFilter) matchBlock(block *types.Block) bool {
+	if f == nil {
+		return true
+	}
+	for _, tx := range block.Txs {
+		if f.match(tx) {
+			return true
+		}
+	}
+	return false
+}
+
+//getSharedPushData 同一分组的订阅者在同一起始sequence上共用推送数据
+func (push *Push) getSharedPushData(in *pushNotify, startSeq int64, seqCount, maxSize int) ([]byte, int64, error) {
+	key := in.group + "|" + strconv.FormatInt(startSeq, 10) + "|" + strconv.Itoa(seqCount) + "|" + strconv.Itoa(maxSize)
+	return push.cache.loadBatch(key, func() ([]byte, int64, error) {
+		return push.getPushData(in.subscribe, startSeq, seqCount, maxSize)
+	})
 }
 
 func (push *Push) getPushData(subscribe *types.PushSubscribeReq, startSeq int64, seqCount, maxSize int) ([]byte, int64, error) {
+	filter := newPushFilter(subscribe.GetFilter())
 	switch PushType(subscribe.Type) {
 	case PushBlock:
-		return push.getBlockSeqs(subscribe.Encode, startSeq, seqCount, maxSize)
+		return push.getBlockSeqs(subscribe.Encode, filter, startSeq, seqCount, maxSize)
 	case PushBlockHeader:
 		return push.getHeaderSeqs(subscribe.Encode, startSeq, seqCount, maxSize)
 	case PushTxReceipt:
-		return push.getTxReceipts(subscribe, startSeq, seqCount, maxSize)
+		return push.getTxReceipts(subscribe, filter, startSeq, seqCount, maxSize)
 	case PushTxResult:
-		return push.getTxResults(subscribe.Encode, startSeq, seqCount)
+		return push.getTxResults(subscribe.Encode, filter, startSeq, seqCount)
 	case PushEVMEvent:
-		return push.getEVMEvent(subscribe, startSeq, seqCount, maxSize)
+		return push.getEVMEvent(subscribe, filter, startSeq, seqCount, maxSize)
 	default:
 		return nil, 0, errors.New("wrong subscribe type")
 	}
 }
 
-func (push *Push) getEVMEvent(subscribe *types.PushSubscribeReq, startSeq int64, seqCount, maxSize int) ([]byte, int64, error) {
+func (push *Push) getEVMEvent(subscribe *types.PushSubscribeReq, filter *pushFilter, startSeq int64, seqCount, maxSize int) ([]byte, int64, error) {
 	evmlogs := &types.EVMTxLogsInBlks{}
 	totalSize := 0
 	actualIterCount := 0
 	for i := startSeq; i < startSeq+int64(seqCount); i++ {
 		chainlog.Debug("getEVMEvent", "startSeq:", i)
-		seqdata, err := push.sequenceStore.GetBlockSequence(i)
-		if err != nil {
-			return nil, -1, err
-		}
-		detail, _, err := push.sequenceStore.LoadBlockBySequence(i)
+		seqdata, detail, _, err := push.loadBlockBySeq(i)
 		if err != nil {
 			return nil, -1, err
 		}
@@ -753,7 +1208,7 @@ func (push *Push) getEVMEvent(subscribe *types.PushSubscribeReq, startSeq int64,
 				chainlog.Error("getEVMEvent", "Failed to decode EVMContractAction for evm tx with hash:", common.ToHex(tx.Hash()))
 				continue
 			}
-			if subscribe.Contract[evmAction.ContractAddr] {
+			if subscribe.Contract[evmAction.ContractAddr] && filter.match(tx) {
 				chainlog.Debug("getEVMEvent", "txIndex:", txIndex)
 				//因为只有交易执行成功时，才会存证log信息，所以需要事先判断
 				if types.ExecOk != detail.Receipts[txIndex].Ty {
@@ -823,17 +1278,13 @@ func (push *Push) getEVMEvent(subscribe *types.PushSubscribeReq, startSeq int64,
 	return postdata, updateSeq, nil
 }
 
-func (push *Push) getTxReceipts(subscribe *types.PushSubscribeReq, startSeq int64, seqCount, maxSize int) ([]byte, int64, error) {
+func (push *Push) getTxReceipts(subscribe *types.PushSubscribeReq, filter *pushFilter, startSeq int64, seqCount, maxSize int) ([]byte, int64, error) {
 	txReceipts := &types.TxReceipts4Subscribe{}
 	totalSize := 0
 	actualIterCount := 0
 	for i := startSeq; i < startSeq+int64(seqCount); i++ {
 		chainlog.Info("getTxReceipts", "startSeq:", i)
-		seqdata, err := push.sequenceStore.GetBlockSequence(i)
-		if err != nil {
-			return nil, -1, err
-		}
-		detail, _, err := push.sequenceStore.LoadBlockBySequence(i)
+		seqdata, detail, _, err := push.loadBlockBySeq(i)
 		if err != nil {
 			return nil, -1, err
 		}
@@ -841,7 +1292,7 @@ func (push *Push) getTxReceipts(subscribe *types.PushSubscribeReq, startSeq int6
 		txReceiptsPerBlk := &types.TxReceipts4SubscribePerBlk{}
 		chainlog.Info("getTxReceipts", "height:", detail.Block.Height, "tx numbers:", len(detail.Block.Txs), "Receipts numbers:", len(detail.Receipts))
 		for txIndex, tx := range detail.Block.Txs {
-			if subscribe.Contract[string(tx.Execer)] {
+			if subscribe.Contract[string(tx.Execer)] && filter.match(tx) {
 				chainlog.Info("getTxReceipts", "txIndex:", txIndex)
 				txReceiptsPerBlk.Tx = append(txReceiptsPerBlk.Tx, tx)
 				txReceiptsPerBlk.ReceiptData = append(txReceiptsPerBlk.ReceiptData, detail.Receipts[txIndex])
@@ -888,19 +1339,34 @@ func (push *Push) getTxReceipts(subscribe *types.PushSubscribeReq, startSeq int6
 	return postdata, updateSeq, nil
 }
 
-func (push *Push) getBlockDataBySeq(seq int64) (*types.BlockSeq, int, error) {
-	seqdata, err := push.sequenceStore.GetBlockSequence(seq)
+//loadBlockBySeq 从缓存中获取sequence对应的区块，缓存的数据被所有订阅者共享，不能修改
+func (push *Push) loadBlockBySeq(seq int64) (*types.BlockSequence, *types.BlockDetail, int, error) {
+	data, err := push.cache.loadSeq(seq, false, func() (*pushSeqData, error) {
+		seqdata, err := push.sequenceStore.GetBlockSequence(seq)
+		if err != nil {
+			return nil, err
+		}
+		detail, blockSize, err := push.sequenceStore.LoadBlockBySequence(seq)
+		if err != nil {
+			return nil, err
+		}
+		return &pushSeqData{sequence: seqdata, detail: detail, size: blockSize}, nil
+	})
 	if err != nil {
-		return nil, 0, err
+		return nil, nil, 0, err
 	}
-	detail, blockSize, err := push.sequenceStore.LoadBlockBySequence(seq)
+	return data.sequence, data.detail, data.size, nil
+}
+
+func (push *Push) getBlockDataBySeq(seq int64) (*types.BlockSeq, int, error) {
+	seqdata, detail, blockSize, err := push.loadBlockBySeq(seq)
 	if err != nil {
 		return nil, 0, err
 	}
 	return &types.BlockSeq{Num: seq, Seq: seqdata, Detail: detail}, blockSize, nil
 }
 
-func (push *Push) getTxResults(encode string, seq int64, seqCount int) ([]byte, int64, error) {
+func (push *Push) getTxResults(encode string, filter *pushFilter, seq int64, seqCount int) ([]byte, int64, error) {
 	var txResultSeqs types.TxResultSeqs
 	for i := seq; i < seq+int64(seqCount); i++ {
 		blockSeq, _, err := push.getBlockDataBySeq(i)
@@ -908,21 +1374,32 @@ func (push *Push) getTxResults(encode string, seq int64, seqCount int) ([]byte,
 			return nil, -1, err
 		}
 		txResults := types.TxResultPerBlock{
-			Items:      make([]*types.TxHashWithReceiptType, len(blockSeq.Detail.Receipts)),
+			Items:      make([]*types.TxHashWithReceiptType, 0, len(blockSeq.Detail.Receipts)),
 			Height:     blockSeq.Detail.Block.Height,
 			BlockHash:  blockSeq.Detail.Block.Hash(push.cfg),
 			ParentHash: blockSeq.Detail.Block.ParentHash,
 			AddDelType: int32(blockSeq.Seq.Type),
 			SeqNum:     blockSeq.Num,
 		}
-		for i := range txResults.Items {
-			txResults.Items[i] = &types.TxHashWithReceiptType{
-				Hash: blockSeq.Detail.Block.Txs[i].Hash(),
-				Ty:   blockSeq.Detail.Receipts[i].Ty,
+		for i, tx := range blockSeq.Detail.Block.Txs {
+			if !filter.match(tx) {
+				continue
 			}
+			txResults.Items = append(txResults.Items, &types.TxHashWithReceiptType{
+				Hash: tx.Hash(),
+				Ty:   blockSeq.Detail.Receipts[i].Ty,
+			})
+		}
+		//设置了过滤条件时，不推送没有匹配交易的区块
+		if filter != nil && len(txResults.Items) == 0 {
+			continue
 		}
 		txResultSeqs.Items = append(txResultSeqs.Items, &txResults)
 	}
+	updateSeq := seq + int64(seqCount) - 1
+	if len(txResultSeqs.Items) == 0 {
+		return nil, updateSeq, nil
+	}
 
 	var postdata []byte
 	var err error
@@ -934,25 +1411,34 @@ func (push *Push) getTxResults(encode string, seq int64, seqCount int) ([]byte,
 	} else {
 		postdata = types.Encode(&txResultSeqs)
 	}
-	return postdata, txResultSeqs.Items[0].SeqNum + int64(len(txResultSeqs.Items)) - 1, nil
+	return postdata, updateSeq, nil
 }
 
-func (push *Push) getBlockSeqs(encode string, seq int64, seqCount, maxSize int) ([]byte, int64, error) {
+func (push *Push) getBlockSeqs(encode string, filter *pushFilter, seq int64, seqCount, maxSize int) ([]byte, int64, error) {
 	seqs := &types.BlockSeqs{}
 	totalSize := 0
+	updateSeq := seq - 1
 	for i := 0; i < seqCount; i++ {
-		seq, size, err := push.getBlockDataBySeq(seq + int64(i))
+		blockSeq, size, err := push.getBlockDataBySeq(seq + int64(i))
 		if err != nil {
 			return nil, -1, err
 		}
+		//设置了过滤条件时，跳过没有匹配交易的区块
+		if !filter.matchBlock(blockSeq.Detail.Block) {
+			updateSeq = blockSeq.Num
+			continue
+		}
 		if totalSize == 0 || totalSize+size < maxSize {
-			seqs.Seqs = append(seqs.Seqs, seq)
+			seqs.Seqs = append(seqs.Seqs, blockSeq)
 			totalSize += size
+			updateSeq = blockSeq.Num
 		} else {
 			break
 		}
 	}
-	updateSeq := seqs.Seqs[0].Num + int64(len(seqs.Seqs)) - 1
+	if len(seqs.Seqs) == 0 {
+		return nil, updateSeq, nil
+	}
 
 	var postdata []byte
 	var err error
@@ -999,15 +1485,21 @@ func (push *Push) getHeaderSeqs(encode string, seq int64, seqCount, maxSize int)
 }
 
 func (push *Push) getHeaderDataBySeq(seq int64) (*types.HeaderSeq, int, error) {
-	seqdata, err := push.sequenceStore.GetBlockSequence(seq)
-	if err != nil {
-		return nil, 0, err
-	}
-	header, err := push.sequenceStore.GetBlockHeaderByHash(seqdata.Hash)
+	data, err := push.cache.loadSeq(seq, true, func() (*pushSeqData, error) {
+		seqdata, err := push.sequenceStore.GetBlockSequence(seq)
+		if err != nil {
+			return nil, err
+		}
+		header, err := push.sequenceStore.GetBlockHeaderByHash(seqdata.Hash)
+		if err != nil {
+			return nil, err
+		}
+		return &pushSeqData{sequence: seqdata, header: header, size: header.Size()}, nil
+	})
 	if err != nil {
 		return nil, 0, err
 	}
-	return &types.HeaderSeq{Num: seq, Seq: seqdata, Header: header}, header.Size(), nil
+	return &types.HeaderSeq{Num: seq, Seq: data.sequence, Header: data.header}, data.size, nil
 }
 
 // GetLastPushSeq Seq的合法值从0开始的，所以没有获取到或者获取失败都应该返回-1
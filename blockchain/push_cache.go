@@ -0,0 +1,143 @@
+package blockchain
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/33cn/chain33/types"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+//pushCache 推送服务共享的数据缓存，包括两部分：
+//1. 按sequence缓存从数据库读出并解码后的区块数据，所有订阅者共用，每个sequence只读取和解码一次
+//2. 按推送分组缓存编码后的推送数据，同一分组(推送类型、编码方式、合约以及过滤条件都相同)的订阅者
+//在同一个起始sequence上共用一份推送数据，只需要组装和编码一次
+//sequence对应的区块数据是不会变化的，所以缓存不需要失效处理
+type pushCache struct {
+	seqs    *lru.Cache
+	batches *lru.Cache
+	mu      sync.Mutex
+
+	seqHit    int64
+	seqMiss   int64
+	batchHit  int64
+	batchMiss int64
+}
+
+type pushSeqKey struct {
+	seq    int64
+	header bool
+}
+
+type pushSeqData struct {
+	sequence *types.BlockSequence
+	detail   *types.BlockDetail
+	header   *types.Header
+	size     int
+}
+
+//pushBatch 一次推送的数据，相同key的并发请求只会计算一次
+type pushBatch struct {
+	once      sync.Once
+	data      []byte
+	updateSeq int64
+	err       error
+}
+
+func newPushCache() *pushCache {
+	seqs, err := lru.New(pushSeqCacheSize)
+	if err != nil {
+		panic(err)
+	}
+	batches, err := lru.New(pushBatchCacheSize)
+	if err != nil {
+		panic(err)
+	}
+	return &pushCache{seqs: seqs, batches: batches}
+}
+
+//loadSeq 获取sequence对应的区块数据，header为true时只加载区块头
+func (cache *pushCache) loadSeq(seq int64, header bool, load func() (*pushSeqData, error)) (*pushSeqData, error) {
+	key := pushSeqKey{seq: seq, header: header}
+	if value, ok := cache.seqs.Get(key); ok {
+		atomic.AddInt64(&cache.seqHit, 1)
+		return value.(*pushSeqData), nil
+	}
+	atomic.AddInt64(&cache.seqMiss, 1)
+	data, err := load()
+	if err != nil {
+		return nil, err
+	}
+	cache.seqs.Add(key, data)
+	return data, nil
+}
+
+//loadBatch 获取同一分组在同一起始sequence上的推送数据，没有时通过load生成
+func (cache *pushCache) loadBatch(key string, load func() ([]byte, int64, error)) ([]byte, int64, error) {
+	cache.mu.Lock()
+	var batch *pushBatch
+	if value, ok := cache.batches.Get(key); ok {
+		batch = value.(*pushBatch)
+		atomic.AddInt64(&cache.batchHit, 1)
+	} else {
+		batch = &pushBatch{}
+		cache.batches.Add(key, batch)
+		atomic.AddInt64(&cache.batchMiss, 1)
+	}
+	cache.mu.Unlock()
+
+	batch.once.Do(func() {
+		batch.data, batch.updateSeq, batch.err = load()
+	})
+	//出错的数据不进行缓存，下次重新获取
+	if batch.err != nil {
+		cache.mu.Lock()
+		if value, ok := cache.batches.Peek(key); ok && value.(*pushBatch) == batch {
+			cache.batches.Remove(key)
+		}
+		cache.mu.Unlock()
+	}
+	return batch.data, batch.updateSeq, batch.err
+}
+
+func (cache *pushCache) fillStats(stats *types.PushStats) {
+	stats.SeqCacheHit = atomic.LoadInt64(&cache.seqHit)
+	stats.SeqCacheMiss = atomic.LoadInt64(&cache.seqMiss)
+	stats.BatchCacheHit = atomic.LoadInt64(&cache.batchHit)
+	stats.BatchCacheMiss = atomic.LoadInt64(&cache.batchMiss)
+}
+
+//calcPushGroup 计算订阅者的推送分组，分组相同的订阅者推送的数据完全一致
+func calcPushGroup(subscribe *types.PushSubscribeReq) string {
+	var contracts []string
+	for contract, ok := range subscribe.GetContract() {
+		if ok {
+			contracts = append(contracts, contract)
+		}
+	}
+	filter := subscribe.GetFilter()
+	parts := []string{
+		strconv.Itoa(int(subscribe.GetType())),
+		subscribe.GetEncode(),
+		sortedJoin(contracts, false),
+		sortedJoin(filter.GetExecers(), false),
+		sortedJoin(filter.GetContractAddrs(), true),
+		sortedJoin(filter.GetToAddrs(), true),
+	}
+	return strings.Join(parts, "|")
+}
+
+func sortedJoin(items []string, lower bool) string {
+	sorted := make([]string, 0, len(items))
+	for _, item := range items {
+		if lower {
+			item = strings.ToLower(item)
+		}
+		sorted = append(sorted, item)
+	}
+	sort.Strings(sorted)
+	return strings.Join(sorted, ",")
+}
@@ -0,0 +1,412 @@
+package blockchain
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/33cn/chain33/types"
+)
+
+const (
+	pushSinkOutbox              = "outbox"
+	outboxSegmentSuffix         = ".log"
+	outboxRecordHeaderSize      = 8
+	defaultOutboxSegmentSize    = 64 * 1024 * 1024
+	pushOutboxReadMaxCount      = 100
+	pushOutboxReadMaxSize       = 16 * 1024 * 1024
+	outboxSegmentNameWidth      = 20
+	outboxSegmentFilePermission = 0644
+)
+
+var errOutboxCorrupted = errors.New("outbox record corrupted")
+
+//PushOutbox 基于本地文件的推送outbox，实现了PostService接口
+//每个订阅者对应一个topic目录，目录下为只追加写的segment文件，文件名为segment中第一条记录的offset，
+//单条记录的格式为: 4字节长度 + 4字节crc32 + PushOutboxRecord编码后的数据
+//记录写入时同步刷盘，订阅者离线期间推送数据不会丢失，重新上线后从上次读取的offset继续读取即可
+type PushOutbox struct {
+	dir         string
+	segmentSize int64
+	maxSegments int
+	mu          sync.Mutex
+	topics      map[string]*outboxTopic
+}
+
+type outboxTopic struct {
+	dir        string
+	mu         sync.RWMutex
+	segments   []*outboxSegment
+	nextOffset int64
+	lastSeq    int64
+}
+
+type outboxSegment struct {
+	base int64
+	path string
+	//每条记录在文件中的起始位置
+	positions []int64
+	size      int64
+	//只有最新的segment会打开写文件
+	file *os.File
+}
+
+//NewPushOutbox 创建outbox，segmentSize单位为字节，maxSegments为每个topic最多保留的segment个数，为0时不删除
+func NewPushOutbox(dir string, segmentSize int64, maxSegments int) (*PushOutbox, error) {
+	if segmentSize <= 0 {
+		segmentSize = defaultOutboxSegmentSize
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &PushOutbox{
+		dir:         dir,
+		segmentSize: segmentSize,
+		maxSegments: maxSegments,
+		topics:      make(map[string]*outboxTopic),
+	}, nil
+}
+
+//PostData 将推送数据写入订阅者的outbox，seq不大于已经写入的最后一个seq时认为是重复推送，直接忽略
+func (outbox *PushOutbox) PostData(subscribe *types.PushSubscribeReq, postdata []byte, seq int64) error {
+	topic, err := outbox.getTopic(subscribe.GetName())
+	if err != nil {
+		return err
+	}
+	record := &types.PushOutboxRecord{
+		Seq:       seq,
+		Name:      subscribe.GetName(),
+		Type:      subscribe.GetType(),
+		Encode:    subscribe.GetEncode(),
+		Data:      postdata,
+		Timestamp: types.Now().Unix(),
+	}
+	return topic.append(record, outbox.segmentSize, outbox.maxSegments)
+}
+
+//Read 从offset开始读取最多count条记录，offset已经被删除时从最早的记录开始读取
+func (outbox *PushOutbox) Read(name string, offset int64, count int) (*types.PushOutboxRecords, error) {
+	if count <= 0 || count > pushOutboxReadMaxCount {
+		count = pushOutboxReadMaxCount
+	}
+	topic, err := outbox.getTopic(name)
+	if err != nil {
+		return nil, err
+	}
+	return topic.read(offset, count, pushOutboxReadMaxSize)
+}
+
+//Remove 删除订阅者的outbox
+func (outbox *PushOutbox) Remove(name string) error {
+	outbox.mu.Lock()
+	defer outbox.mu.Unlock()
+	if topic, ok := outbox.topics[name]; ok {
+		topic.close()
+		delete(outbox.topics, name)
+	}
+	return os.RemoveAll(outbox.topicDir(name))
+}
+
+//Close 关闭outbox
+func (outbox *PushOutbox) Close() {
+	outbox.mu.Lock()
+	defer outbox.mu.Unlock()
+	for _, topic := range outbox.topics {
+		topic.close()
+	}
+	outbox.topics = make(map[string]*outboxTopic)
+}
+
+//订阅名称中可能包含路径分隔符等字符，目录名使用名称的hex编码
+func (outbox *PushOutbox) topicDir(name string) string {
+	return filepath.Join(outbox.dir, hex.EncodeToString([]byte(name)))
+}
+
+func (outbox *PushOutbox) getTopic(name string) (*outboxTopic, error) {
+	outbox.mu.Lock()
+	defer outbox.mu.Unlock()
+	if topic, ok := outbox.topics[name]; ok {
+		return topic, nil
+	}
+	topic, err := openOutboxTopic(outbox.topicDir(name))
+	if err != nil {
+		chainlog.Error("PushOutbox open topic", "name", name, "err", err)
+		return nil, err
+	}
+	outbox.topics[name] = topic
+	return topic, nil
+}
+
+func openOutboxTopic(dir string) (*outboxTopic, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	topic := &outboxTopic{dir: dir, lastSeq: -1}
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), outboxSegmentSuffix) {
+			continue
+		}
+		base, err := strconv.ParseInt(strings.TrimSuffix(file.Name(), outboxSegmentSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		topic.segments = append(topic.segments, &outboxSegment{base: base, path: filepath.Join(dir, file.Name())})
+	}
+	sort.Slice(topic.segments, func(i, j int) bool { return topic.segments[i].base < topic.segments[j].base })
+
+	for i, segment := range topic.segments {
+		isLast := i == len(topic.segments)-1
+		if err := segment.load(isLast); err != nil {
+			return nil, err
+		}
+	}
+	if len(topic.segments) > 0 {
+		last := topic.segments[len(topic.segments)-1]
+		topic.nextOffset = last.base + int64(len(last.positions))
+		if err := last.openWriter(); err != nil {
+			return nil, err
+		}
+	}
+	//最后一条记录的seq用于过滤重复推送
+	for i := len(topic.segments) - 1; i >= 0; i-- {
+		segment := topic.segments[i]
+		if len(segment.positions) == 0 {
+			continue
+		}
+		record, err := segment.readRecord(nil, len(segment.positions)-1)
+		if err != nil {
+			return nil, err
+		}
+		topic.lastSeq = record.Seq
+		break
+	}
+	return topic, nil
+}
+
+func (topic *outboxTopic) append(record *types.PushOutboxRecord, segmentSize int64, maxSegments int) error {
+	topic.mu.Lock()
+	defer topic.mu.Unlock()
+	if record.Seq <= topic.lastSeq {
+		chainlog.Debug("PushOutbox ignore duplicate record", "name", record.Name, "seq", record.Seq, "lastSeq", topic.lastSeq)
+		return nil
+	}
+	record.Offset = topic.nextOffset
+	data := types.Encode(record)
+	buf := make([]byte, outboxRecordHeaderSize+len(data))
+	binary.BigEndian.PutUint32(buf[0:4], uint32(len(data)))
+	binary.BigEndian.PutUint32(buf[4:8], crc32.ChecksumIEEE(data))
+	copy(buf[outboxRecordHeaderSize:], data)
+
+	active := topic.active()
+	if active == nil || (active.size+int64(len(buf)) > segmentSize && len(active.positions) > 0) {
+		var err error
+		if active, err = topic.roll(); err != nil {
+			return err
+		}
+		topic.retain(maxSegments)
+	}
+	if _, err := active.file.Write(buf); err != nil {
+		//写入失败时截断可能写入的部分数据，保证记录的完整性
+		_ = active.file.Truncate(active.size)
+		return err
+	}
+	if err := active.file.Sync(); err != nil {
+		return err
+	}
+	active.positions = append(active.positions, active.size)
+	active.size += int64(len(buf))
+	topic.nextOffset++
+	topic.lastSeq = record.Seq
+	return nil
+}
+
+func (topic *outboxTopic) read(offset int64, count, maxSize int) (*types.PushOutboxRecords, error) {
+	topic.mu.RLock()
+	defer topic.mu.RUnlock()
+	records := &types.PushOutboxRecords{NextOffset: offset}
+	if len(topic.segments) == 0 || offset >= topic.nextOffset {
+		return records, nil
+	}
+	if offset < topic.segments[0].base {
+		offset = topic.segments[0].base
+	}
+	index := sort.Search(len(topic.segments), func(i int) bool { return topic.segments[i].base > offset }) - 1
+	totalSize := 0
+	for ; index < len(topic.segments); index++ {
+		segment := topic.segments[index]
+		//segment中间的记录损坏时，跳过损坏的部分
+		if offset < segment.base {
+			offset = segment.base
+		}
+		file, err := os.Open(segment.path)
+		if err != nil {
+			return nil, err
+		}
+		for i := int(offset - segment.base); i < len(segment.positions); i++ {
+			record, err := segment.readRecord(file, i)
+			if err != nil {
+				_ = file.Close()
+				return nil, err
+			}
+			records.Records = append(records.Records, record)
+			totalSize += len(record.Data)
+			offset++
+			if len(records.Records) >= count || totalSize >= maxSize {
+				_ = file.Close()
+				records.NextOffset = offset
+				return records, nil
+			}
+		}
+		_ = file.Close()
+	}
+	records.NextOffset = offset
+	return records, nil
+}
+
+func (topic *outboxTopic) active() *outboxSegment {
+	if len(topic.segments) == 0 {
+		return nil
+	}
+	return topic.segments[len(topic.segments)-1]
+}
+
+//roll 关闭当前的segment，并以nextOffset为起始创建新的segment
+func (topic *outboxTopic) roll() (*outboxSegment, error) {
+	if active := topic.active(); active != nil {
+		active.closeWriter()
+	}
+	name := fmt.Sprintf("%0*d%s", outboxSegmentNameWidth, topic.nextOffset, outboxSegmentSuffix)
+	segment := &outboxSegment{base: topic.nextOffset, path: filepath.Join(topic.dir, name)}
+	if err := segment.openWriter(); err != nil {
+		return nil, err
+	}
+	topic.segments = append(topic.segments, segment)
+	return segment, nil
+}
+
+//retain 删除超过保留个数的最老的segment
+func (topic *outboxTopic) retain(maxSegments int) {
+	if maxSegments <= 0 {
+		return
+	}
+	for len(topic.segments) > maxSegments {
+		oldest := topic.segments[0]
+		if err := os.Remove(oldest.path); err != nil {
+			chainlog.Error("PushOutbox remove segment", "path", oldest.path, "err", err)
+			return
+		}
+		topic.segments = topic.segments[1:]
+	}
+}
+
+func (topic *outboxTopic) close() {
+	topic.mu.Lock()
+	defer topic.mu.Unlock()
+	if active := topic.active(); active != nil {
+		active.closeWriter()
+	}
+}
+
+//load 扫描segment文件建立记录索引，最新的segment末尾不完整的记录(写入过程中宕机)会被截断
+func (segment *outboxSegment) load(truncate bool) error {
+	file, err := os.Open(segment.path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	var pos int64
+	header := make([]byte, outboxRecordHeaderSize)
+	for pos < info.Size() {
+		if _, err = file.ReadAt(header, pos); err != nil {
+			break
+		}
+		length := int64(binary.BigEndian.Uint32(header[0:4]))
+		if pos+outboxRecordHeaderSize+length > info.Size() {
+			err = io.ErrUnexpectedEOF
+			break
+		}
+		data := make([]byte, length)
+		if _, err = file.ReadAt(data, pos+outboxRecordHeaderSize); err != nil {
+			break
+		}
+		if crc32.ChecksumIEEE(data) != binary.BigEndian.Uint32(header[4:8]) {
+			err = errOutboxCorrupted
+			break
+		}
+		segment.positions = append(segment.positions, pos)
+		pos += outboxRecordHeaderSize + length
+	}
+	segment.size = pos
+	if pos == info.Size() {
+		return nil
+	}
+	if err == nil || err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	chainlog.Error("PushOutbox load segment", "path", segment.path, "records", len(segment.positions), "err", err)
+	if truncate {
+		return os.Truncate(segment.path, pos)
+	}
+	return nil
+}
+
+func (segment *outboxSegment) readRecord(file *os.File, index int) (*types.PushOutboxRecord, error) {
+	if file == nil {
+		var err error
+		if file, err = os.Open(segment.path); err != nil {
+			return nil, err
+		}
+		defer file.Close()
+	}
+	pos := segment.positions[index]
+	header := make([]byte, outboxRecordHeaderSize)
+	if _, err := file.ReadAt(header, pos); err != nil {
+		return nil, err
+	}
+	data := make([]byte, binary.BigEndian.Uint32(header[0:4]))
+	if _, err := file.ReadAt(data, pos+outboxRecordHeaderSize); err != nil {
+		return nil, err
+	}
+	if crc32.ChecksumIEEE(data) != binary.BigEndian.Uint32(header[4:8]) {
+		return nil, errOutboxCorrupted
+	}
+	var record types.PushOutboxRecord
+	if err := types.Decode(data, &record); err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+func (segment *outboxSegment) openWriter() error {
+	file, err := os.OpenFile(segment.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, outboxSegmentFilePermission)
+	if err != nil {
+		return err
+	}
+	segment.file = file
+	return nil
+}
+
+func (segment *outboxSegment) closeWriter() {
+	if segment.file != nil {
+		_ = segment.file.Close()
+		segment.file = nil
+	}
+}
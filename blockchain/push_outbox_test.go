@@ -0,0 +1,130 @@
+package blockchain
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	bcMocks "github.com/33cn/chain33/blockchain/mocks"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPushOutbox(t *testing.T) {
+	dir, err := ioutil.TempDir("", "outbox")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	//每个segment最多容纳两条记录
+	outbox, err := NewPushOutbox(dir, 300, 0)
+	require.Nil(t, err)
+	subscribe := &types.PushSubscribeReq{Name: "push/../test", Type: int32(PushBlock), Encode: encodeGrpc}
+	data := make([]byte, 100)
+	for seq := int64(1); seq <= 5; seq++ {
+		require.Nil(t, outbox.PostData(subscribe, data, seq*10))
+	}
+	//重复推送的数据被忽略
+	require.Nil(t, outbox.PostData(subscribe, data, 50))
+
+	records, err := outbox.Read(subscribe.Name, 0, 0)
+	require.Nil(t, err)
+	require.Equal(t, 5, len(records.Records))
+	require.Equal(t, int64(5), records.NextOffset)
+	for i, record := range records.Records {
+		require.Equal(t, int64(i), record.Offset)
+		require.Equal(t, int64(i+1)*10, record.Seq)
+		require.Equal(t, subscribe.Name, record.Name)
+	}
+	records, err = outbox.Read(subscribe.Name, 3, 1)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(records.Records))
+	require.Equal(t, int64(40), records.Records[0].Seq)
+	require.Equal(t, int64(4), records.NextOffset)
+	records, err = outbox.Read(subscribe.Name, 5, 10)
+	require.Nil(t, err)
+	require.Equal(t, 0, len(records.Records))
+	require.Equal(t, int64(5), records.NextOffset)
+
+	topicDir := outbox.topicDir(subscribe.Name)
+	require.Equal(t, dir, filepath.Dir(topicDir))
+	files, err := ioutil.ReadDir(topicDir)
+	require.Nil(t, err)
+	require.Equal(t, 3, len(files))
+
+	//模拟写入过程中宕机，重新打开时截断末尾不完整的记录
+	outbox.Close()
+	last := filepath.Join(topicDir, files[len(files)-1].Name())
+	file, err := os.OpenFile(last, os.O_WRONLY|os.O_APPEND, 0644)
+	require.Nil(t, err)
+	_, err = file.Write([]byte{0, 0, 1, 0, 1, 2})
+	require.Nil(t, err)
+	require.Nil(t, file.Close())
+
+	outbox, err = NewPushOutbox(dir, 300, 2)
+	require.Nil(t, err)
+	require.Nil(t, outbox.PostData(subscribe, data, 50))
+	require.Nil(t, outbox.PostData(subscribe, data, 60))
+	require.Nil(t, outbox.PostData(subscribe, data, 70))
+	records, err = outbox.Read(subscribe.Name, 0, 10)
+	require.Nil(t, err)
+	//超过保留个数的segment被删除，从最早保留的记录开始读取
+	require.Equal(t, 3, len(records.Records))
+	require.Equal(t, int64(4), records.Records[0].Offset)
+	require.Equal(t, int64(70), records.Records[2].Seq)
+	require.Equal(t, int64(7), records.NextOffset)
+
+	require.Nil(t, outbox.Remove(subscribe.Name))
+	_, err = os.Stat(topicDir)
+	require.True(t, os.IsNotExist(err))
+	outbox.Close()
+}
+
+func Test_PushOutboxSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "outbox")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	chain, mock33 := createBlockChain(t)
+	defer mock33.Close()
+	ps := &bcMocks.PostService{}
+	ps.On("PostData", mock.Anything, mock.Anything, mock.Anything).Return(types.ErrPushSeqPostData)
+	chain.push.postService = ps
+
+	subscribe := &types.PushSubscribeReq{
+		Name:   "push-outbox",
+		Type:   int32(PushBlockHeader),
+		Encode: encodeJSON,
+		Sink:   pushSinkOutbox,
+		Secret: "123456",
+	}
+	err = chain.push.addSubscriber(subscribe)
+	require.Equal(t, types.ErrPushOutboxNotSupport, err)
+	_, err = chain.ProcReadPushOutbox(&types.ReqPushOutbox{Name: subscribe.Name})
+	require.Equal(t, types.ErrPushOutboxNotSupport, err)
+
+	chain.push.outbox, err = NewPushOutbox(dir, 0, 0)
+	require.Nil(t, err)
+	require.Nil(t, chain.push.addSubscriber(subscribe))
+	time.Sleep(time.Second)
+	createBlocks(t, mock33, chain, 5)
+	time.Sleep(2 * time.Second)
+
+	_, err = chain.ProcReadPushOutbox(&types.ReqPushOutbox{Name: subscribe.Name})
+	require.Equal(t, types.ErrPushAuthFailed, err)
+	records, err := chain.ProcReadPushOutbox(&types.ReqPushOutbox{Name: subscribe.Name, Secret: subscribe.Secret})
+	require.Nil(t, err)
+	require.NotEqual(t, 0, len(records.Records))
+	lastSeq, err := chain.ProcGetLastPushSeq(subscribe.Name)
+	require.Nil(t, err)
+	require.Equal(t, lastSeq, records.Records[len(records.Records)-1].Seq)
+	var headers types.HeaderSeqs
+	require.Nil(t, types.JSONToPB(records.Records[0].Data, &headers))
+	require.NotEqual(t, 0, len(headers.Seqs))
+
+	require.Nil(t, chain.procRemovePush(&types.ReqPushSubscribeAuth{Name: subscribe.Name, Secret: subscribe.Secret}))
+	_, err = chain.ProcReadPushOutbox(&types.ReqPushOutbox{Name: subscribe.Name, Secret: subscribe.Secret})
+	require.Equal(t, types.ErrPushNotSubscribed, err)
+}
@@ -3,8 +3,12 @@ package blockchain
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strconv"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -25,6 +29,7 @@ import (
 	"github.com/33cn/chain33/types"
 	"github.com/33cn/chain33/util"
 	"github.com/33cn/chain33/wallet"
+	go_metrics "github.com/rcrowley/go-metrics"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -366,6 +371,41 @@ func Test_PostDataFail(t *testing.T) {
 	require.NotEqual(t, nil, err)
 }
 
+//接收方处理慢或者回复的数据过大时推送请求及时返回，不会一直占用工作协程
+func Test_PostDataSlowServer(t *testing.T) {
+	release := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-time.After(10 * time.Second):
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer slow.Close()
+	defer close(release)
+	large := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+		_, _ = w.Write(make([]byte, 10*1024*1024))
+	}))
+	defer large.Close()
+
+	timeout := pushPostTimeout
+	pushPostTimeout = 500 * time.Millisecond
+	defer func() {
+		pushPostTimeout = timeout
+	}()
+	pushClient := &PushClient{client: newPushHTTPClient()}
+	subscribe := &types.PushSubscribeReq{Name: "push-test", URL: slow.URL, Type: int32(PushBlock)}
+	beg := time.Now()
+	err := pushClient.PostData(subscribe, []byte("1"), 1)
+	require.NotNil(t, err)
+	require.True(t, time.Since(beg) < 5*time.Second)
+
+	subscribe.URL = large.URL
+	err = pushClient.PostData(subscribe, []byte("1"), 1)
+	require.Equal(t, types.ErrPushSeqPostData, err)
+}
+
 func Test_PostBlockSuccess(t *testing.T) {
 	chain, mock33 := createBlockChain(t)
 	defer mock33.Close()
@@ -398,6 +438,10 @@ func Test_PostBlockSuccess(t *testing.T) {
 
 	lastSeq, _ := chain.ProcGetLastPushSeq(subscribe.Name)
 	require.Greater(t, lastSeq, int64(21))
+	lag := go_metrics.Get(pushLagName(subscribe.Name)).(go_metrics.Gauge)
+	require.Equal(t, int64(0), lag.Value())
+	chain.push.stopTask(subscribe.Name)
+	require.Nil(t, go_metrics.Get(pushLagName(subscribe.Name)))
 }
 
 func Test_PostBlockHeaderSuccess(t *testing.T) {
@@ -1216,6 +1260,268 @@ func Test_RecoverPush(t *testing.T) {
 }
 
 //init work
+func Test_RemoveAndPausePush(t *testing.T) {
+	chain, mock33 := createBlockChain(t)
+	defer mock33.Close()
+	ps := &bcMocks.PostService{}
+	ps.On("PostData", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	chain.push.postService = ps
+
+	subscribe := new(types.PushSubscribeReq)
+	subscribe.Name = "push-test"
+	subscribe.URL = "http://localhost"
+	subscribe.Type = int32(PushBlock)
+	subscribe.Secret = "123456"
+	err := chain.push.addSubscriber(subscribe)
+	require.Nil(t, err)
+	keyStr := string(calcPushKey(subscribe.Name))
+
+	//密钥不对外展示
+	pushes, err := chain.ProcListPush()
+	require.Nil(t, err)
+	require.Equal(t, "", pushes.Pushes[0].Secret)
+
+	err = chain.procPausePush(&types.ReqPushSubscribeAuth{Name: subscribe.Name, Secret: "654321"})
+	require.Equal(t, types.ErrPushAuthFailed, err)
+	err = chain.procPausePush(&types.ReqPushSubscribeAuth{Name: "not-exist", Secret: "654321"})
+	require.Equal(t, types.ErrPushNotSubscribed, err)
+
+	err = chain.procPausePush(&types.ReqPushSubscribeAuth{Name: subscribe.Name, Secret: subscribe.Secret})
+	require.Nil(t, err)
+	chain.push.mu.Lock()
+	require.Nil(t, chain.push.tasks[keyStr])
+	chain.push.mu.Unlock()
+	value, err := chain.push.store.GetKey(calcPushKey(subscribe.Name))
+	require.Nil(t, err)
+	var pushWithStatus types.PushWithStatus
+	require.Nil(t, types.Decode(value, &pushWithStatus))
+	require.Equal(t, subscribeStatusNotActive, pushWithStatus.Status)
+
+	//重新激活需要提供正确的密钥
+	resume := types.Clone(subscribe).(*types.PushSubscribeReq)
+	resume.Secret = ""
+	err = chain.push.addSubscriber(resume)
+	require.Equal(t, types.ErrPushAuthFailed, err)
+	err = chain.push.addSubscriber(subscribe)
+	require.Nil(t, err)
+	chain.push.mu.Lock()
+	require.NotNil(t, chain.push.tasks[keyStr])
+	chain.push.mu.Unlock()
+
+	err = chain.procRemovePush(&types.ReqPushSubscribeAuth{Name: subscribe.Name, Secret: subscribe.Secret})
+	require.Nil(t, err)
+	chain.push.mu.Lock()
+	require.Nil(t, chain.push.tasks[keyStr])
+	chain.push.mu.Unlock()
+	_, err = chain.push.store.GetKey(calcPushKey(subscribe.Name))
+	require.NotNil(t, err)
+	_, err = chain.ProcGetLastPushSeq(subscribe.Name)
+	require.Equal(t, types.ErrPushNotSubscribed, err)
+
+	//未设置密钥的订阅不能取消
+	subscribe.Name = "push-test-nosecret"
+	subscribe.Secret = ""
+	err = chain.push.addSubscriber(subscribe)
+	require.Nil(t, err)
+	err = chain.procRemovePush(&types.ReqPushSubscribeAuth{Name: subscribe.Name})
+	require.Equal(t, types.ErrPushAuthFailed, err)
+	//运维接口可以暂停和取消未设置密钥的订阅
+	err = chain.procPausePush(&types.ReqPushSubscribeAuth{Name: subscribe.Name, Operator: true})
+	require.Nil(t, err)
+	err = chain.procRemovePush(&types.ReqPushSubscribeAuth{Name: subscribe.Name, Operator: true})
+	require.Nil(t, err)
+	_, err = chain.push.store.GetKey(calcPushKey(subscribe.Name))
+	require.NotNil(t, err)
+}
+
+func Test_PushFilter(t *testing.T) {
+	require.Nil(t, newPushFilter(nil))
+	require.Nil(t, newPushFilter(&types.PushFilter{}))
+	require.True(t, checkPushFilter(nil))
+	require.False(t, checkPushFilter(&types.PushFilter{Execers: make([]string, maxPushFilterItems+1)}))
+
+	var nilFilter *pushFilter
+	tx := &types.Transaction{Execer: []byte("coins"), To: "1MCftFynyvG2F4ED5mdHYgziDxx6vDrScs"}
+	require.True(t, nilFilter.match(tx))
+	require.True(t, newPushFilter(&types.PushFilter{Execers: []string{"coins"}}).match(tx))
+	require.False(t, newPushFilter(&types.PushFilter{Execers: []string{"token"}}).match(tx))
+	require.True(t, newPushFilter(&types.PushFilter{ToAddrs: []string{"1mcftfynyvg2f4ed5mdhygzidxx6vdrscs"}}).match(tx))
+
+	evmTx := &types.Transaction{Execer: []byte("evm"), Payload: types.Encode(&types.EVMContractAction4Chain33{ContractAddr: "0xAbC"})}
+	require.True(t, newPushFilter(&types.PushFilter{ContractAddrs: []string{"0xabc"}}).match(evmTx))
+	require.False(t, newPushFilter(&types.PushFilter{ContractAddrs: []string{"0xdef"}}).match(evmTx))
+
+	chain, mock33 := createBlockChain(t)
+	defer mock33.Close()
+	subscribe := &types.PushSubscribeReq{
+		Name:   "push-test-filter",
+		Type:   int32(PushTxResult),
+		Filter: &types.PushFilter{Execers: []string{"token"}},
+	}
+	data, updateSeq, err := chain.push.getPushData(subscribe, 1, 5, pushMaxSize)
+	require.Nil(t, err)
+	require.Nil(t, data)
+	require.Equal(t, int64(5), updateSeq)
+
+	subscribe.Type = int32(PushBlock)
+	data, updateSeq, err = chain.push.getPushData(subscribe, 1, 5, pushMaxSize)
+	require.Nil(t, err)
+	require.Nil(t, data)
+	require.Equal(t, int64(5), updateSeq)
+
+	subscribe.Filter.Execers = []string{"coins"}
+	data, updateSeq, err = chain.push.getPushData(subscribe, 2, 5, pushMaxSize)
+	require.Nil(t, err)
+	require.Equal(t, int64(6), updateSeq)
+	var seqs types.BlockSeqs
+	require.Nil(t, types.Decode(data, &seqs))
+	require.NotEqual(t, 0, len(seqs.Seqs))
+}
+
+func Test_PostDataSignature(t *testing.T) {
+	subscribe := &types.PushSubscribeReq{Name: "push-test-sign", Secret: "123456"}
+	var signErr error
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		seq, _ := strconv.ParseInt(r.Header.Get(PushHeaderSeq), 10, 64)
+		timestamp, _ := strconv.ParseInt(r.Header.Get(PushHeaderTimestamp), 10, 64)
+		if r.Header.Get(PushHeaderName) != subscribe.Name ||
+			r.Header.Get(PushHeaderSignature) != CalcPushSignature(subscribe.Secret, timestamp, seq, body) {
+			signErr = errors.New("bad signature")
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+	subscribe.URL = server.URL
+
+	pushClient := &PushClient{client: &http.Client{}}
+	err := pushClient.PostData(subscribe, []byte("push data"), 10)
+	require.Nil(t, err)
+	require.Nil(t, signErr)
+
+	require.NotEqual(t, CalcPushSignature("123456", 1, 10, []byte("data")), CalcPushSignature("123456", 1, 11, []byte("data")))
+	require.NotEqual(t, CalcPushSignature("123456", 1, 10, []byte("data")), CalcPushSignature("654321", 1, 10, []byte("data")))
+}
+
+func Test_PushSharedData(t *testing.T) {
+	sub1 := &types.PushSubscribeReq{Name: "push-1", Type: int32(PushTxReceipt), Encode: encodeJSON,
+		Contract: map[string]bool{"coins": true, "token": true}, Filter: &types.PushFilter{ToAddrs: []string{"1A", "1b"}}}
+	sub2 := &types.PushSubscribeReq{Name: "push-2", Type: int32(PushTxReceipt), Encode: encodeJSON,
+		Contract: map[string]bool{"token": true, "coins": true}, Filter: &types.PushFilter{ToAddrs: []string{"1B", "1a"}}}
+	require.Equal(t, calcPushGroup(sub1), calcPushGroup(sub2))
+	sub2.Encode = encodeGrpc
+	require.NotEqual(t, calcPushGroup(sub1), calcPushGroup(sub2))
+
+	chain, mock33 := createBlockChain(t)
+	defer mock33.Close()
+	ps := &bcMocks.PostService{}
+	ps.On("PostData", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	chain.push.postService = ps
+
+	subCnt := 5
+	for i := 0; i < subCnt; i++ {
+		subscribe := &types.PushSubscribeReq{
+			Name: fmt.Sprintf("push-test-%d", i),
+			URL:  "http://localhost",
+			Type: int32(PushBlock),
+		}
+		require.Nil(t, chain.push.addSubscriber(subscribe))
+	}
+	time.Sleep(time.Second)
+	createBlocks(t, mock33, chain, 10)
+	time.Sleep(2 * time.Second)
+
+	stats, err := chain.ProcGetPushStats("")
+	require.Nil(t, err)
+	require.Equal(t, subCnt, len(stats.Subscribers))
+	for _, stat := range stats.Subscribers {
+		require.True(t, stat.Running)
+		require.Equal(t, int32(subscribeStatusActive), stat.Status)
+		require.Equal(t, stats.LastSequence, stat.LastPushSeq)
+		require.Equal(t, int64(0), stat.Lag)
+		require.Greater(t, stat.PushCount, int64(0))
+	}
+	//同一分组的订阅者共用推送数据，每个sequence的区块数据最多只从数据库加载一次
+	require.Greater(t, stats.BatchCacheHit, int64(0))
+	require.Greater(t, stats.BatchCacheHit, stats.BatchCacheMiss)
+	require.LessOrEqual(t, stats.SeqCacheMiss, stats.LastSequence+1)
+
+	stats, err = chain.ProcGetPushStats("push-test-0")
+	require.Nil(t, err)
+	require.Equal(t, 1, len(stats.Subscribers))
+	_, err = chain.ProcGetPushStats("not-exist")
+	require.Equal(t, types.ErrPushNotSubscribed, err)
+}
+
+func Test_PushBackpressure(t *testing.T) {
+	chain, mock33 := createBlockChain(t)
+	defer mock33.Close()
+	blockPost := make(chan struct{})
+	ps := &bcMocks.PostService{}
+	ps.On("PostData", mock.Anything, mock.Anything, mock.Anything).Return(func(subscribe *types.PushSubscribeReq, data []byte, seq int64) error {
+		if subscribe.Name == "push-slow" {
+			<-blockPost
+		}
+		return nil
+	})
+	chain.push.postService = ps
+
+	for _, name := range []string{"push-slow", "push-fast"} {
+		subscribe := &types.PushSubscribeReq{Name: name, URL: "http://localhost", Type: int32(PushBlockHeader)}
+		require.Nil(t, chain.push.addSubscriber(subscribe))
+	}
+	time.Sleep(time.Second)
+	createBlocks(t, mock33, chain, 5)
+	time.Sleep(2 * time.Second)
+
+	//处理慢的订阅者只会增加自己的lag，不会影响其他订阅者
+	slow, err := chain.ProcGetPushStats("push-slow")
+	require.Nil(t, err)
+	require.True(t, slow.Subscribers[0].InFlight)
+	require.Greater(t, slow.Subscribers[0].Lag, int64(0))
+	fast, err := chain.ProcGetPushStats("push-fast")
+	require.Nil(t, err)
+	require.Equal(t, int64(0), fast.Subscribers[0].Lag)
+	close(blockPost)
+}
+
+//取消订阅需要等待正在处理的推送批次结束，推送进度不会在取消之后被重新写入
+func Test_RemovePushInFlight(t *testing.T) {
+	chain, mock33 := createBlockChain(t)
+	defer mock33.Close()
+	blockPost := make(chan struct{})
+	ps := &bcMocks.PostService{}
+	ps.On("PostData", mock.Anything, mock.Anything, mock.Anything).Return(func(subscribe *types.PushSubscribeReq, data []byte, seq int64) error {
+		<-blockPost
+		return nil
+	})
+	chain.push.postService = ps
+
+	subscribe := &types.PushSubscribeReq{Name: "push-inflight", URL: "http://localhost", Type: int32(PushBlockHeader), Secret: "123456"}
+	require.Nil(t, chain.push.addSubscriber(subscribe))
+	time.Sleep(time.Second)
+	createBlocks(t, mock33, chain, 5)
+	time.Sleep(time.Second)
+	stats, err := chain.ProcGetPushStats(subscribe.Name)
+	require.Nil(t, err)
+	require.True(t, stats.Subscribers[0].InFlight)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- chain.procRemovePush(&types.ReqPushSubscribeAuth{Name: subscribe.Name, Secret: subscribe.Secret})
+	}()
+	select {
+	case <-done:
+		close(blockPost)
+		t.Fatal("remove push should wait for in-flight batch")
+	case <-time.After(500 * time.Millisecond):
+	}
+	close(blockPost)
+	require.Nil(t, <-done)
+	_, err = chain.push.store.GetKey(calcLastPushSeqNumKey(subscribe.Name))
+	require.NotNil(t, err)
+}
+
 func NewChain33Mock(cfgpath string, mockapi client.QueueProtocolAPI) *Chain33Mock {
 	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
 	return newWithConfigNoLock(cfg, mockapi)
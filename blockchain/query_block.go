@@ -83,6 +83,7 @@ func (chain *BlockChain) ProcGetBlockOverview(ReqHash *types.ReqHash) (*types.Bl
 	header.Hash = block.Block.Hash(chain.client.GetConfig())
 	header.TxCount = int64(len(block.Block.GetTxs()))
 	header.Difficulty = block.Block.Difficulty
+	header.BaseFee = block.Block.BaseFee
 	header.Signature = block.Block.Signature
 
 	blockOverview.Head = &header
@@ -0,0 +1,239 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/merkle"
+	"github.com/33cn/chain33/types"
+)
+
+//快照同步：
+//新节点配置检查点的高度和区块hash之后，不再从创世区块开始执行，而是从peer下载检查点的状态树快照，
+//状态树的roothash和检查点区块头的StateHash一致后保存检查点区块，然后从检查点高度继续正常同步。
+//检查点之前的区块、交易索引以及执行器的localdb数据不在本地，交易查重也无法覆盖检查点之前的交易
+
+var (
+	//每次请求的状态树节点个数
+	snapshotChunkSize int64 = 1024
+	//同时下载的分片个数
+	snapshotFetchParallel int64 = 4
+	//快照同步失败后的重试间隔
+	snapshotRetrySeconds int64 = 10
+)
+
+//needSnapshotSync 配置了检查点并且本地高度低于检查点时需要快照同步，
+//轻节点、平行链以及需要从创世区块开始记录区块序列的节点不支持
+func (chain *BlockChain) needSnapshotSync() bool {
+	if chain.cfg.SnapshotSyncHeight <= 0 || chain.cfg.SnapshotSyncHash == "" {
+		return false
+	}
+	if chain.lightMode || chain.isParaChain || chain.isRecordBlockSequence {
+		chainlog.Error("needSnapshotSync not support", "lightMode", chain.lightMode, "isParaChain", chain.isParaChain,
+			"isRecordBlockSequence", chain.isRecordBlockSequence)
+		return false
+	}
+	return chain.GetBlockHeight() < chain.cfg.SnapshotSyncHeight
+}
+
+//SnapshotSynRoutine 快照同步完成之后再开始正常的区块同步，失败后从头开始重试
+func (chain *BlockChain) SnapshotSynRoutine() {
+	for {
+		err := chain.syncSnapshot()
+		if err == nil {
+			break
+		}
+		synlog.Error("SnapshotSynRoutine", "height", chain.cfg.SnapshotSyncHeight, "err", err)
+		select {
+		case <-chain.quit:
+			return
+		case <-time.After(time.Duration(snapshotRetrySeconds) * time.Second):
+		}
+	}
+	// 定时检测/同步block
+	go chain.SynRoutine()
+
+	// 定时处理futureblock
+	go chain.UpdateRoutine()
+}
+
+//syncSnapshot 从peer获取检查点区块以及状态树快照，按顺序导入状态树节点，最后一批节点导入时store校验roothash
+func (chain *BlockChain) syncSnapshot() error {
+	cfg := chain.client.GetConfig()
+	height := chain.cfg.SnapshotSyncHeight
+	hash, err := common.FromHex(chain.cfg.SnapshotSyncHash)
+	if err != nil {
+		return err
+	}
+	resp, err := chain.queryModule("p2p", types.EventFetchSnapshotInfo, &types.ReqSnapshotInfo{Height: height, BlockHash: hash}, time.Minute)
+	if err != nil {
+		return err
+	}
+	info, ok := resp.(*types.SnapshotInfo)
+	if !ok {
+		return types.ErrTypeAsset
+	}
+	block := info.GetBlock().GetBlock()
+	if block == nil || block.Height != height || !bytes.Equal(block.Hash(cfg), hash) {
+		return types.ErrBlockHashNoMatch
+	}
+	//区块hash只覆盖区块头，需要校验peer发送的交易列表和区块头的TxHash一致
+	if !bytes.Equal(merkle.CalcMerkleRoot(cfg, block.Height, block.Txs), block.TxHash) {
+		return types.ErrCheckTxHash
+	}
+	synlog.Info("syncSnapshot start", "height", height, "stateHash", common.ToHex(block.StateHash), "nodeCount", info.NodeCount, "peers", len(info.Pids))
+
+	var root []byte
+	total := info.GetNodeCount()
+	if total == 0 {
+		root, err = chain.importSnapshot(&types.SnapshotImport{StateHash: block.StateHash, Height: height, Last: true})
+		if err != nil {
+			return err
+		}
+	}
+	for start := int64(0); start < total; {
+		chunks, err := chain.fetchSnapshotChunks(block.StateHash, start, total, info.GetPids())
+		if err != nil {
+			return err
+		}
+		for _, nodes := range chunks {
+			next := start + int64(len(nodes))
+			req := &types.SnapshotImport{StateHash: block.StateHash, Height: height, Start: start, Nodes: nodes, Last: next >= total}
+			root, err = chain.importSnapshot(req)
+			if err != nil {
+				return err
+			}
+			start = next
+		}
+		synlog.Debug("syncSnapshot", "imported", start, "total", total)
+	}
+	if !bytes.Equal(root, block.StateHash) {
+		return types.ErrStateHashMismatch
+	}
+	return chain.setSnapshotTip(info.Block)
+}
+
+//fetchSnapshotChunks 从start开始并行下载多个分片，返回的分片按节点顺序排列
+func (chain *BlockChain) fetchSnapshotChunks(stateHash []byte, start, total int64, pids []string) ([][]*types.SnapshotNode, error) {
+	var reqs []*types.ReqSnapshotChunk
+	for i := int64(0); i < snapshotFetchParallel && start < total; i++ {
+		count := snapshotChunkSize
+		if start+count > total {
+			count = total - start
+		}
+		reqs = append(reqs, &types.ReqSnapshotChunk{StateHash: stateHash, Start: start, Count: count, Pids: pids})
+		start += count
+	}
+	chunks := make([][]*types.SnapshotNode, len(reqs))
+	errs := make([]error, len(reqs))
+	var wg sync.WaitGroup
+	for i, req := range reqs {
+		wg.Add(1)
+		go func(i int, req *types.ReqSnapshotChunk) {
+			defer wg.Done()
+			resp, err := chain.queryModule("p2p", types.EventFetchSnapshotChunk, req, time.Minute*2)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			nodes, ok := resp.(*types.SnapshotNodes)
+			if !ok || int64(len(nodes.GetNodes())) != req.Count {
+				errs[i] = types.ErrSnapshotNode
+				return
+			}
+			chunks[i] = nodes.Nodes
+		}(i, req)
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err != nil {
+			synlog.Error("fetchSnapshotChunks", "start", reqs[i].Start, "count", reqs[i].Count, "err", err)
+			return nil, err
+		}
+	}
+	return chunks, nil
+}
+
+//importSnapshot 导入一批状态树节点，最后一批节点导入后返回状态树的roothash
+func (chain *BlockChain) importSnapshot(req *types.SnapshotImport) ([]byte, error) {
+	resp, err := chain.queryModule("store", types.EventStoreImportSnapshot, req, time.Minute)
+	if err != nil {
+		return nil, err
+	}
+	reply, ok := resp.(*types.ReplyHash)
+	if !ok {
+		return nil, types.ErrTypeAsset
+	}
+	return reply.GetHash(), nil
+}
+
+//queryModule 发送消息到其他模块并等待回复，模块回复的错误作为error返回
+func (chain *BlockChain) queryModule(topic string, ty int64, data interface{}, timeout time.Duration) (interface{}, error) {
+	msg := chain.client.NewMessage(topic, ty, data)
+	err := chain.client.Send(msg, true)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := chain.client.WaitTimeout(msg, timeout)
+	if err != nil {
+		return nil, err
+	}
+	if err = resp.Err(); err != nil {
+		return nil, err
+	}
+	return resp.GetData(), nil
+}
+
+//setSnapshotTip 保存检查点区块并设置为最优链的tip节点，区块回执不在区块hash的校验范围内，不保存peer发送的回执
+func (chain *BlockChain) setSnapshotTip(detail *types.BlockDetail) error {
+	chain.chainLock.Lock()
+	defer chain.chainLock.Unlock()
+
+	cfg := chain.client.GetConfig()
+	block := detail.GetBlock()
+	if chain.GetBlockHeight() >= block.Height {
+		return types.ErrBlockExist
+	}
+	detail = &types.BlockDetail{Block: block}
+	err := chain.blockStore.SaveSnapshotBlock(detail)
+	if err != nil {
+		chainlog.Error("setSnapshotTip SaveSnapshotBlock", "height", block.Height, "err", err)
+		return err
+	}
+	node := newBlockNodeByHeader(false, block.GetHeader(cfg), "self", -1)
+	node.parent = chain.bestChain.Tip()
+	chain.index.AddNode(node)
+	chain.bestChain.SetTip(node)
+	chain.query.updateStateHash(block.GetStateHash())
+	chain.AddCacheBlock(detail)
+
+	//检查点所在的chunk不完整，从下一个chunk开始归档
+	if !chain.cfg.DisableShard {
+		chunkNum, _, _ := chain.CalcChunkInfo(block.Height)
+		if chunkNum > chain.getMaxSerialChunkNum() {
+			err = chain.updateMaxSerialChunkNum(chunkNum)
+			if err != nil {
+				chainlog.Error("setSnapshotTip updateMaxSerialChunkNum", "chunkNum", chunkNum, "err", err)
+			}
+		}
+	}
+	chainlog.Info("setSnapshotTip", "height", block.Height, "hash", common.ToHex(node.hash), "stateHash", common.ToHex(block.StateHash))
+	chain.sendSnapshotTipEvent(detail)
+	return nil
+}
+
+//sendSnapshotTipEvent 检查点区块没有回执，只通知不依赖回执的模块更新最新区块，钱包从检查点之后的区块开始处理
+func (chain *BlockChain) sendSnapshotTipEvent(detail *types.BlockDetail) {
+	height := detail.GetBlock().GetHeight()
+	header := &types.Header{Height: height, BlockTime: detail.GetBlock().GetBlockTime()}
+	chain.sendAddBlockEvent("crypto", header, height)
+	chain.sendAddBlockEvent("mempool", detail, height)
+	chain.sendAddBlockEvent("consensus", detail, height)
+	chain.sendAddBlockEvent("p2p", detail.GetBlock(), height)
+}
@@ -0,0 +1,211 @@
+package blockchain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/client"
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/queue"
+	mavlstore "github.com/33cn/chain33/system/store/mavl"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/wallet"
+	"github.com/stretchr/testify/require"
+)
+
+//initSnapshotP2P 模拟p2p模块，从源节点的store获取快照，peer返回的检查点区块由block指定
+func initSnapshotP2P(q queue.Queue, src *BlockChain, block **types.BlockDetail) {
+	client := q.Client()
+	client.Sub("p2p")
+	go func() {
+		for msg := range client.Recv() {
+			switch msg.Ty {
+			case types.EventFetchSnapshotInfo:
+				detail := *block
+				resp, err := src.queryModule("store", types.EventStoreGetSnapshot, &types.ReqSnapshotNodes{StateHash: detail.Block.StateHash}, time.Minute)
+				if err != nil {
+					msg.Reply(client.NewMessage("", types.EventFetchSnapshotInfo, err))
+					continue
+				}
+				info := &types.SnapshotInfo{Block: detail, NodeCount: resp.(*types.SnapshotNodes).Total, Pids: []string{"pid"}}
+				msg.Reply(client.NewMessage("", types.EventFetchSnapshotInfo, info))
+			case types.EventFetchSnapshotChunk:
+				req := msg.GetData().(*types.ReqSnapshotChunk)
+				resp, err := src.queryModule("store", types.EventStoreGetSnapshot, &types.ReqSnapshotNodes{StateHash: req.StateHash, Start: req.Start, Count: req.Count}, time.Minute)
+				if err != nil {
+					msg.Reply(client.NewMessage("", types.EventFetchSnapshotChunk, err))
+					continue
+				}
+				msg.Reply(client.NewMessage("", types.EventFetchSnapshotChunk, resp))
+			}
+		}
+	}()
+}
+
+//newSnapshotChain 创建一个空的节点用于从快照启动
+func newSnapshotChain(q queue.Queue, db dbm.DB) *BlockChain {
+	chain := New(q.GetConfig())
+	chain.client = q.Client()
+	chain.isRecordBlockSequence = false
+	chain.blockStore = NewBlockStore(chain, db, chain.client)
+	chain.query = NewQuery(db, chain.client, zeroHash[:])
+	chain.InitCache(-1)
+	chain.InitIndexAndBestView()
+	return chain
+}
+
+func TestSnapshotSync(t *testing.T) {
+	chain, mock33 := createBlockChain(t)
+	defer mock33.Close()
+	cfg := chain.client.GetConfig()
+	curheight := chain.GetBlockHeight()
+	detail, err := chain.GetBlock(curheight)
+	require.Nil(t, err)
+	hash := detail.Block.Hash(cfg)
+
+	q := queue.New("snapshot")
+	q.SetConfig(cfg)
+	defer q.Close()
+	storeCfg := *cfg.GetModuleConfig().Store
+	storeCfg.Driver = "memdb"
+	store := mavlstore.New(&storeCfg, cfg.GetSubConfig().Store["mavl"], cfg)
+	store.SetQueueClient(q.Client())
+	defer store.Close()
+	peerBlock := detail
+	initSnapshotP2P(q, chain, &peerBlock)
+
+	db := dbm.NewDB("blockchain", "memdb", "", 100)
+	snap := newSnapshotChain(q, db)
+	snap.cfg.SnapshotSyncHeight = curheight
+	snap.cfg.SnapshotSyncHash = common.ToHex(hash)
+	require.True(t, snap.needSnapshotSync())
+
+	//peer返回的区块和检查点不一致
+	parent, err := chain.GetBlock(curheight - 1)
+	require.Nil(t, err)
+	peerBlock = parent
+	require.Equal(t, types.ErrBlockHashNoMatch, snap.syncSnapshot())
+
+	//区块头一致但是交易列表被篡改
+	tampered := types.Clone(detail).(*types.BlockDetail)
+	require.NotEmpty(t, tampered.Block.Txs)
+	tampered.Block.Txs[0].Nonce++
+	peerBlock = tampered
+	require.Equal(t, types.ErrCheckTxHash, snap.syncSnapshot())
+	require.True(t, snap.needSnapshotSync())
+
+	//分多个分片下载
+	snapshotChunkSize, snapshotFetchParallel = 3, 2
+	defer func() {
+		snapshotChunkSize, snapshotFetchParallel = 1024, 4
+	}()
+	peerBlock = detail
+	require.Nil(t, snap.syncSnapshot())
+	require.False(t, snap.needSnapshotSync())
+	require.Equal(t, curheight, snap.GetBlockHeight())
+	require.Equal(t, curheight, snap.blockStore.SnapshotHeight())
+	require.Equal(t, hash, snap.bestChain.Tip().hash)
+	require.Equal(t, detail.Block.StateHash, snap.query.getStateHash())
+	block, err := snap.GetBlock(curheight)
+	require.Nil(t, err)
+	require.Equal(t, hash, block.Block.Hash(cfg))
+	_, err = snap.GetBlock(curheight - 1)
+	require.NotNil(t, err)
+	require.Equal(t, types.ErrBlockExist, snap.setSnapshotTip(detail))
+
+	//导入的状态树和源节点一致
+	addr := address.PubKeyToAddr(address.DefaultID, mock33.GetGenesisKey().PubKey().Bytes())
+	get := &types.StoreGet{StateHash: detail.Block.StateHash, Keys: [][]byte{[]byte("mavl-coins-bty-" + addr)}}
+	expect, err := chain.queryModule("store", types.EventStoreGet, get, time.Minute)
+	require.Nil(t, err)
+	values, err := snap.queryModule("store", types.EventStoreGet, get, time.Minute)
+	require.Nil(t, err)
+	require.NotNil(t, values.(*types.StoreReplyValue).Values[0])
+	require.Equal(t, expect, values)
+
+	//重启后从检查点高度加载缓存和最优链
+	restart := New(cfg)
+	restart.client = snap.client
+	restart.isRecordBlockSequence = false
+	restart.blockStore = NewBlockStore(restart, db, restart.client)
+	require.Equal(t, curheight, restart.blockStore.SnapshotHeight())
+	restart.InitCache(restart.GetBlockHeight())
+	restart.InitIndexAndBestView()
+	require.Equal(t, hash, restart.bestChain.Tip().hash)
+}
+
+//检查点区块没有回执，从快照启动时钱包不处理检查点区块
+func TestSnapshotSyncWithWallet(t *testing.T) {
+	chain, mock33 := createBlockChain(t)
+	defer mock33.Close()
+	cfg := chain.client.GetConfig()
+	curheight := chain.GetBlockHeight()
+	detail, err := chain.GetBlock(curheight)
+	require.Nil(t, err)
+	require.NotEmpty(t, detail.Block.Txs)
+
+	q := queue.New("snapshot")
+	q.SetConfig(cfg)
+	defer q.Close()
+	storeCfg := *cfg.GetModuleConfig().Store
+	storeCfg.Driver = "memdb"
+	store := mavlstore.New(&storeCfg, cfg.GetSubConfig().Store["mavl"], cfg)
+	store.SetQueueClient(q.Client())
+	defer store.Close()
+	initSnapshotP2P(q, chain, &detail)
+
+	//快照同步之前钱包的查询由源节点回复
+	cli := q.Client()
+	cli.Sub("blockchain")
+	go func() {
+		for msg := range cli.Recv() {
+			resp, err := chain.queryModule("blockchain", msg.Ty, msg.GetData(), time.Minute)
+			if err != nil {
+				msg.Reply(cli.NewMessage("", msg.Ty, err))
+				continue
+			}
+			msg.Reply(cli.NewMessage("", msg.Ty, resp))
+		}
+	}()
+
+	//钱包导入了检查点区块中交易的发送地址
+	walletCfg := cfg.GetModuleConfig().Wallet
+	driver := walletCfg.Driver
+	walletCfg.Driver = "memdb"
+	w := wallet.New(cfg)
+	walletCfg.Driver = driver
+	w.SetQueueClient(q.Client())
+	defer w.Close()
+	api, err := client.New(q.Client(), nil)
+	require.Nil(t, err)
+	newWalletRealize(api)
+
+	snap := newSnapshotChain(q, dbm.NewDB("blockchain", "memdb", "", 100))
+	recorder := &addBlockRecorder{Client: snap.client}
+	snap.client = recorder
+	snap.cfg.SnapshotSyncHeight = curheight
+	snap.cfg.SnapshotSyncHash = common.ToHex(detail.Block.Hash(cfg))
+	require.Nil(t, snap.syncSnapshot())
+	require.Equal(t, curheight, snap.GetBlockHeight())
+	require.Equal(t, []string{"crypto", "mempool", "consensus", "p2p"}, recorder.topics)
+
+	//钱包仍然可以正常处理请求
+	reply, err := api.ExecWalletFunc("wallet", "WalletGetAccountList", &types.ReqAccountList{WithoutBalance: true})
+	require.Nil(t, err)
+	require.NotEmpty(t, reply.(*types.WalletAccounts).Wallets)
+}
+
+//addBlockRecorder 记录区块链模块发送的EventAddBlock消息的topic
+type addBlockRecorder struct {
+	queue.Client
+	topics []string
+}
+
+func (r *addBlockRecorder) Send(msg *queue.Message, waitReply bool) error {
+	if msg.Ty == types.EventAddBlock {
+		r.topics = append(r.topics, msg.Topic)
+	}
+	return r.Client.Send(msg, waitReply)
+}
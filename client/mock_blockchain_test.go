@@ -214,6 +214,12 @@ func (m *mockBlockChain) SetQueueClient(q queue.Queue) {
 				msg.Reply(client.NewMessage(blockchainKey, types.EventReplyQuery, &types.PushSubscribes{}))
 			case types.EventGetPushLastNum:
 				msg.Reply(client.NewMessage(blockchainKey, types.EventReplyQuery, &types.Int64{}))
+			case types.EventRemovePush, types.EventPausePush:
+				msg.Reply(client.NewMessage(blockchainKey, types.EventReplySubscribePush, &types.ReplySubscribePush{}))
+			case types.EventGetPushStats:
+				msg.Reply(client.NewMessage(blockchainKey, types.EventGetPushStats, &types.PushStats{}))
+			case types.EventReadPushOutbox:
+				msg.Reply(client.NewMessage(blockchainKey, types.EventReadPushOutbox, &types.PushOutboxRecords{}))
 			default:
 				msg.ReplyErr("Do not support", types.ErrNotSupport)
 			}
@@ -40,6 +40,8 @@ func (m *mockStore) SetQueueClient(q queue.Queue) {
 				} else {
 					msg.ReplyErr("Do not support", types.ErrInvalidParam)
 				}
+			case types.EventStoreGetProof:
+				msg.Reply(client.NewMessage("store", types.EventStoreGetProof, &types.StateProof{}))
 			default:
 				msg.ReplyErr("Do not support", types.ErrNotSupport)
 			}
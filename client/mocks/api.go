@@ -157,6 +157,29 @@ func (_m *QueueProtocolAPI) DialPeer(in *types.SetPeer) (*types.Reply, error) {
 	return r0, r1
 }
 
+// ExecTxList provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) ExecTxList(param *types.ExecTxList) (*types.Receipts, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.Receipts
+	if rf, ok := ret.Get(0).(func(*types.ExecTxList) *types.Receipts); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Receipts)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ExecTxList) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ExecWallet provides a mock function with given fields: param
 func (_m *QueueProtocolAPI) ExecWallet(param *types.ChainExecutor) (types.Message, error) {
 	ret := _m.Called(param)
@@ -380,6 +403,29 @@ func (_m *QueueProtocolAPI) GetConfig() *types.Chain33Config {
 	return r0
 }
 
+// GetFutureMempool provides a mock function with given fields:
+func (_m *QueueProtocolAPI) GetFutureMempool() (*types.ReplyTxList, error) {
+	ret := _m.Called()
+
+	var r0 *types.ReplyTxList
+	if rf, ok := ret.Get(0).(func() *types.ReplyTxList); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReplyTxList)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetHeaders provides a mock function with given fields: param
 func (_m *QueueProtocolAPI) GetHeaders(param *types.ReqBlocks) (*types.Headers, error) {
 	ret := _m.Called(param)
@@ -679,6 +725,29 @@ func (_m *QueueProtocolAPI) GetPushSeqLastNum(param *types.ReqString) (*types.In
 	return r0, r1
 }
 
+// GetPushStats provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) GetPushStats(param *types.ReqString) (*types.PushStats, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.PushStats
+	if rf, ok := ret.Get(0).(func(*types.ReqString) *types.PushStats); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.PushStats)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqString) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSequenceByHash provides a mock function with given fields: param
 func (_m *QueueProtocolAPI) GetSequenceByHash(param *types.ReqHash) (*types.Int64, error) {
 	ret := _m.Called(param)
@@ -1087,6 +1156,29 @@ func (_m *QueueProtocolAPI) Notify(topic string, ty int64, data interface{}) (*q
 	return r0, r1
 }
 
+// PausePushSubscribe provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) PausePushSubscribe(param *types.ReqPushSubscribeAuth) (*types.ReplySubscribePush, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.ReplySubscribePush
+	if rf, ok := ret.Get(0).(func(*types.ReqPushSubscribeAuth) *types.ReplySubscribePush); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReplySubscribePush)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqPushSubscribeAuth) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PeerInfo provides a mock function with given fields: param
 func (_m *QueueProtocolAPI) PeerInfo(param *types.P2PGetPeerReq) (*types.PeerList, error) {
 	ret := _m.Called(param)
@@ -1110,6 +1202,29 @@ func (_m *QueueProtocolAPI) PeerInfo(param *types.P2PGetPeerReq) (*types.PeerLis
 	return r0, r1
 }
 
+// PeerScores provides a mock function with given fields: req
+func (_m *QueueProtocolAPI) PeerScores(req *types.ReqNil) (*types.PeerScores, error) {
+	ret := _m.Called(req)
+
+	var r0 *types.PeerScores
+	if rf, ok := ret.Get(0).(func(*types.ReqNil) *types.PeerScores); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.PeerScores)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqNil) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Query provides a mock function with given fields: driver, funcname, param
 func (_m *QueueProtocolAPI) Query(driver string, funcname string, param types.Message) (types.Message, error) {
 	ret := _m.Called(driver, funcname, param)
@@ -1225,6 +1340,52 @@ func (_m *QueueProtocolAPI) QueryTx(param *types.ReqHash) (*types.TransactionDet
 	return r0, r1
 }
 
+// ReadPushOutbox provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) ReadPushOutbox(param *types.ReqPushOutbox) (*types.PushOutboxRecords, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.PushOutboxRecords
+	if rf, ok := ret.Get(0).(func(*types.ReqPushOutbox) *types.PushOutboxRecords); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.PushOutboxRecords)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqPushOutbox) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemovePushSubscribe provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) RemovePushSubscribe(param *types.ReqPushSubscribeAuth) (*types.ReplySubscribePush, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.ReplySubscribePush
+	if rf, ok := ret.Get(0).(func(*types.ReqPushSubscribeAuth) *types.ReplySubscribePush); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReplySubscribePush)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqPushSubscribeAuth) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveTxsByHashList provides a mock function with given fields: hashList
 func (_m *QueueProtocolAPI) RemoveTxsByHashList(hashList *types.TxHashList) error {
 	ret := _m.Called(hashList)
@@ -1377,6 +1538,29 @@ func (_m *QueueProtocolAPI) StoreGet(_a0 *types.StoreGet) (*types.StoreReplyValu
 	return r0, r1
 }
 
+// StoreGetProof provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) StoreGetProof(param *types.StoreGet) (*types.StateProof, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.StateProof
+	if rf, ok := ret.Get(0).(func(*types.StoreGet) *types.StateProof); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.StateProof)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.StoreGet) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // StoreGetTotalCoins provides a mock function with given fields: _a0
 func (_m *QueueProtocolAPI) StoreGetTotalCoins(_a0 *types.IterateRangeByStateHash) (*types.ReplyGetTotalCoins, error) {
 	ret := _m.Called(_a0)
@@ -367,6 +367,19 @@ func (q *QueueProtocol) GetLastMempool() (*types.ReplyTxList, error) {
 	return nil, types.ErrTypeAsset
 }
 
+// GetFutureMempool get transactions from the future queue of mempool
+func (q *QueueProtocol) GetFutureMempool() (*types.ReplyTxList, error) {
+	msg, err := q.send(mempoolKey, types.EventGetFutureMempool, &types.ReqNil{})
+	if err != nil {
+		log.Error("GetFutureMempool", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.ReplyTxList); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
 // GetProperFee get proper fee from mempool
 func (q *QueueProtocol) GetProperFee(req *types.ReqProperFee) (*types.ReplyProperFee, error) {
 	msg, err := q.send(mempoolKey, types.EventGetProperFee, req)
@@ -445,6 +458,26 @@ func (q *QueueProtocol) Query(driver, funcname string, param types.Message) (typ
 	return q.QueryChain(query)
 }
 
+// ExecTxList execute txs on the given state without commit
+func (q *QueueProtocol) ExecTxList(param *types.ExecTxList) (*types.Receipts, error) {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("ExecTxList", "Error", err)
+		return nil, err
+	}
+	msg, err := q.send(executorKey, types.EventExecTxList, param)
+	if err != nil {
+		log.Error("ExecTxList", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.Receipts); ok {
+		return reply, nil
+	}
+	err = types.ErrTypeAsset
+	log.Error("ExecTxList", "Error", err.Error())
+	return nil, err
+}
+
 // QueryConsensus query consensus data
 func (q *QueueProtocol) QueryConsensus(param *types.ChainExecutor) (types.Message, error) {
 	if param == nil {
@@ -817,6 +850,31 @@ func (q *QueueProtocol) StoreDel(param *types.StoreDel) (*types.ReplyHash, error
 	return nil, err
 }
 
+//StoreGetProof get values and mavl proofs of keys by statehash
+func (q *QueueProtocol) StoreGetProof(param *types.StoreGet) (*types.StateProof, error) {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("StoreGetProof", "Error", err)
+		return nil, err
+	}
+
+	msg, err := q.send(storeKey, types.EventStoreGetProof, param)
+	if err != nil {
+		log.Error("StoreGetProof", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.StateProof); ok {
+		return reply, nil
+	}
+	//不支持状态证明的store返回错误信息
+	if reply, ok := msg.GetData().(*types.Reply); ok && !reply.GetIsOk() {
+		return nil, errors.New(string(reply.GetMsg()))
+	}
+	err = types.ErrTypeAsset
+	log.Error("StoreGetProof", "Error", err.Error())
+	return nil, err
+}
+
 //StoreList query list from statedb
 func (q *QueueProtocol) StoreList(param *types.StoreList) (*types.StoreListReply, error) {
 	if param == nil {
@@ -1011,6 +1069,58 @@ func (q *QueueProtocol) GetPushSeqLastNum(param *types.ReqString) (*types.Int64,
 	return nil, types.ErrTypeAsset
 }
 
+// RemovePushSubscribe remove push subscribe
+func (q *QueueProtocol) RemovePushSubscribe(param *types.ReqPushSubscribeAuth) (*types.ReplySubscribePush, error) {
+	msg, err := q.send(blockchainKey, types.EventRemovePush, param)
+	if err != nil {
+		log.Error("RemovePushSubscribe", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.ReplySubscribePush); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// PausePushSubscribe pause push subscribe
+func (q *QueueProtocol) PausePushSubscribe(param *types.ReqPushSubscribeAuth) (*types.ReplySubscribePush, error) {
+	msg, err := q.send(blockchainKey, types.EventPausePush, param)
+	if err != nil {
+		log.Error("PausePushSubscribe", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.ReplySubscribePush); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// GetPushStats get push service stats
+func (q *QueueProtocol) GetPushStats(param *types.ReqString) (*types.PushStats, error) {
+	msg, err := q.send(blockchainKey, types.EventGetPushStats, param)
+	if err != nil {
+		log.Error("GetPushStats", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.PushStats); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// ReadPushOutbox read push records from outbox
+func (q *QueueProtocol) ReadPushOutbox(param *types.ReqPushOutbox) (*types.PushOutboxRecords, error) {
+	msg, err := q.send(blockchainKey, types.EventReadPushOutbox, param)
+	if err != nil {
+		log.Error("ReadPushOutbox", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.PushOutboxRecords); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
 // GetLastBlockMainSequence 获取最新的block执行序列号
 func (q *QueueProtocol) GetLastBlockMainSequence() (*types.Int64, error) {
 	msg, err := q.send(blockchainKey, types.EventGetLastBlockMainSequence, &types.ReqNil{})
@@ -1192,6 +1302,21 @@ func (q *QueueProtocol) ShowBlacklist(req *types.ReqNil) (*types.Blacklist, erro
 
 }
 
+//PeerScores show scores of misbehaving peers
+func (q *QueueProtocol) PeerScores(req *types.ReqNil) (*types.PeerScores, error) {
+	msg, err := q.send(p2pKey, types.EventPeerScores, req)
+	if err != nil {
+		log.Error("PeerScores", "Error", err.Error())
+		return nil, err
+	}
+
+	if reply, ok := msg.GetData().(*types.PeerScores); ok {
+		return reply, nil
+	}
+
+	return nil, types.ErrInvalidParam
+}
+
 //DialPeer  dial the the specified peer
 func (q *QueueProtocol) DialPeer(req *types.SetPeer) (*types.Reply, error) {
 	msg, err := q.send(p2pKey, types.EventDialPeer, req)
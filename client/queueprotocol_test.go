@@ -73,6 +73,7 @@ func TestQueueProtocol(t *testing.T) {
 	testPeerInfo(t, api)
 	testGetHeaders(t, api)
 	testGetLastMempool(t, api)
+	testGetFutureMempool(t, api)
 	testGetProperFee(t, api)
 	testGetBlockOverview(t, api)
 	testGetAddrOverview(t, api)
@@ -82,6 +83,7 @@ func TestQueueProtocol(t *testing.T) {
 	testAddSeqCallBack(t, api)
 	testListSeqCallBack(t, api)
 	testGetSeqCallBackLastNum(t, api)
+	testRemovePushSubscribe(t, api)
 	testGetLastBlockSequence(t, api)
 	testIsSync(t, api)
 	testIsNtpClockSync(t, api)
@@ -97,7 +99,9 @@ func TestQueueProtocol(t *testing.T) {
 	testStoreDel(t, api)
 	testStoreGetTotalCoins(t, api)
 	testStoreList(t, api)
+	testStoreGetProof(t, api)
 	testBlockChainQuery(t, api)
+	testExecTxList(t, api)
 	testQueryConsensus(t, api)
 	testExecWalletFunc(t, api)
 	testGetSequenceByHash(t, api)
@@ -228,6 +232,21 @@ func testGetSeqCallBackLastNum(t *testing.T, api client.QueueProtocolAPI) {
 	assert.Equal(t, &types.Int64{}, res)
 }
 
+func testRemovePushSubscribe(t *testing.T, api client.QueueProtocolAPI) {
+	res, err := api.RemovePushSubscribe(&types.ReqPushSubscribeAuth{})
+	assert.Nil(t, err)
+	assert.Equal(t, &types.ReplySubscribePush{}, res)
+	res, err = api.PausePushSubscribe(&types.ReqPushSubscribeAuth{})
+	assert.Nil(t, err)
+	assert.Equal(t, &types.ReplySubscribePush{}, res)
+	stats, err := api.GetPushStats(&types.ReqString{})
+	assert.Nil(t, err)
+	assert.Equal(t, &types.PushStats{}, stats)
+	records, err := api.ReadPushOutbox(&types.ReqPushOutbox{})
+	assert.Nil(t, err)
+	assert.Equal(t, &types.PushOutboxRecords{}, records)
+}
+
 func testStoreSet(t *testing.T, api client.QueueProtocolAPI) {
 	_, err := api.StoreSet(&types.StoreSetWithSync{})
 	if err != nil {
@@ -327,6 +346,30 @@ func testStoreList(t *testing.T, api client.QueueProtocolAPI) {
 	}
 }
 
+func testExecTxList(t *testing.T, api client.QueueProtocolAPI) {
+	_, err := api.ExecTxList(&types.ExecTxList{})
+	if err != nil {
+		t.Error("Call ExecTxList Failed.", err)
+	}
+
+	_, err = api.ExecTxList(nil)
+	if err == nil {
+		t.Error("ExecTxList(nil) need return error.")
+	}
+}
+
+func testStoreGetProof(t *testing.T, api client.QueueProtocolAPI) {
+	_, err := api.StoreGetProof(&types.StoreGet{})
+	if err != nil {
+		t.Error("Call StoreGetProof Failed.", err)
+	}
+
+	_, err = api.StoreGetProof(nil)
+	if err == nil {
+		t.Error("StoreGetProof(nil) need return error.")
+	}
+}
+
 func testGetLastHeader(t *testing.T, api client.QueueProtocolAPI) {
 	_, err := api.GetLastHeader()
 	if err != nil {
@@ -445,6 +488,13 @@ func testGetLastMempool(t *testing.T, api client.QueueProtocolAPI) {
 	}
 }
 
+func testGetFutureMempool(t *testing.T, api client.QueueProtocolAPI) {
+	_, err := api.GetFutureMempool()
+	if err != nil {
+		t.Error("Call GetFutureMempool Failed.", err)
+	}
+}
+
 func testGetProperFee(t *testing.T, api client.QueueProtocolAPI) {
 	_, err := api.GetProperFee(nil)
 	if err != nil {
@@ -26,6 +26,8 @@ type QueueProtocolAPI interface {
 	GetMempool(req *types.ReqGetMempool) (*types.ReplyTxList, error)
 	// types.EventGetLastMempool
 	GetLastMempool() (*types.ReplyTxList, error)
+	// types.EventGetFutureMempool
+	GetFutureMempool() (*types.ReplyTxList, error)
 	// types.EventGetProperFee
 	GetProperFee(req *types.ReqProperFee) (*types.ReplyProperFee, error)
 	//types.EventDelTxList
@@ -38,6 +40,8 @@ type QueueProtocolAPI interface {
 	QueryChain(param *types.ChainExecutor) (types.Message, error)
 	ExecWalletFunc(driver string, funcname string, param types.Message) (types.Message, error)
 	ExecWallet(param *types.ChainExecutor) (types.Message, error)
+	// types.EventExecTxList 在指定状态上执行交易，不写入状态数据库
+	ExecTxList(param *types.ExecTxList) (*types.Receipts, error)
 	// --------------- execs interfaces end
 
 	// +++++++++++++++ p2p interfaces begin
@@ -116,6 +120,7 @@ type QueueProtocolAPI interface {
 	StoreDel(param *types.StoreDel) (*types.ReplyHash, error)
 	StoreGetTotalCoins(*types.IterateRangeByStateHash) (*types.ReplyGetTotalCoins, error)
 	StoreList(param *types.StoreList) (*types.StoreListReply, error)
+	StoreGetProof(param *types.StoreGet) (*types.StateProof, error)
 	// --------------- store interfaces end
 
 	// +++++++++++++++ other interfaces begin
@@ -128,6 +133,14 @@ type QueueProtocolAPI interface {
 	ListPushes() (*types.PushSubscribes, error)
 	// types.EventGetSeqCBLastNum
 	GetPushSeqLastNum(param *types.ReqString) (*types.Int64, error)
+	// types.EventRemovePush
+	RemovePushSubscribe(param *types.ReqPushSubscribeAuth) (*types.ReplySubscribePush, error)
+	// types.EventPausePush
+	PausePushSubscribe(param *types.ReqPushSubscribeAuth) (*types.ReplySubscribePush, error)
+	// types.EventGetPushStats
+	GetPushStats(param *types.ReqString) (*types.PushStats, error)
+	// types.EventReadPushOutbox
+	ReadPushOutbox(param *types.ReqPushOutbox) (*types.PushOutboxRecords, error)
 	// types.EventGetParaTxByTitle
 	GetParaTxByTitle(param *types.ReqParaTxByTitle) (*types.ParaTxDetails, error)
 	// types.EventGetHeightByTitle
@@ -144,6 +157,8 @@ type QueueProtocolAPI interface {
 	DelBlacklist(req *types.BlackPeer) (*types.Reply, error)
 	//ShowBlacklist  show blacklist
 	ShowBlacklist(req *types.ReqNil) (*types.Blacklist, error)
+	//PeerScores show scores of misbehaving peers
+	PeerScores(req *types.ReqNil) (*types.PeerScores, error)
 	//DialPeer dial the specified  peer
 	DialPeer(in *types.SetPeer) (*types.Reply, error)
 	//ClosePeer close specified peer
@@ -0,0 +1,101 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// package main 把数据库从一种存储引擎迁移到另一种存储引擎，如leveldb迁移到pebble
+//
+// 迁移blockchain和store数据库:
+// dbmigrate -src datadir -dst datadir.pebble -dstdriver pebble -name blockchain
+// dbmigrate -src datadir/mavltree -dst datadir.pebble/mavltree -dstdriver pebble -name store
+// 迁移完成后把配置文件中对应模块的dbPath和driver修改为新的路径和类型
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	dbm "github.com/33cn/chain33/common/db"
+)
+
+var (
+	src       = flag.String("src", "", "source db dir")
+	srcDriver = flag.String("srcdriver", "leveldb", "source db driver")
+	dst       = flag.String("dst", "", "destination db dir")
+	dstDriver = flag.String("dstdriver", "", "destination db driver, "+strings.Join(dbm.Backends(), ", "))
+	name      = flag.String("name", "", "db name, such as blockchain, store, wallet")
+	cache     = flag.Int("cache", 128, "db cache size(MB)")
+	verify    = flag.Bool("verify", true, "verify all data after migration")
+)
+
+func main() {
+	flag.Parse()
+	if *src == "" || *dst == "" || *dstDriver == "" || *name == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+	if *src == *dst && *srcDriver == *dstDriver {
+		fmt.Fprintln(os.Stderr, "source and destination are the same db")
+		os.Exit(1)
+	}
+	//源数据库不存在时NewDB会创建空的数据库，迁移和校验都会成功
+	if err := checkSrc(*src, *srcDriver, *name); err != nil {
+		fmt.Fprintln(os.Stderr, "source db err:", err)
+		os.Exit(1)
+	}
+	srcDB := dbm.NewDB(*name, *srcDriver, *src, int32(*cache))
+	defer srcDB.Close()
+	dstDB := dbm.NewDB(*name, *dstDriver, *dst, int32(*cache))
+	defer dstDB.Close()
+
+	begin := time.Now()
+	count, err := dbm.Migrate(srcDB, dstDB, func(count int64) {
+		fmt.Printf("\rmigrated %d keys, cost %v", count, time.Since(begin).Truncate(time.Second))
+	})
+	fmt.Println()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "migrate err:", err)
+		os.Exit(1)
+	}
+	fmt.Printf("migrate %s from %s(%s) to %s(%s) done, %d keys, cost %v\n", *name, *src, *srcDriver, *dst, *dstDriver, count, time.Since(begin))
+	if !*verify {
+		return
+	}
+	begin = time.Now()
+	count, err = dbm.Verify(srcDB, dstDB)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "verify err:", err, "verified keys:", count)
+		os.Exit(1)
+	}
+	fmt.Printf("verify done, %d keys, cost %v\n", count, time.Since(begin))
+}
+
+//checkSrc 检查本地存储的源数据库目录存在并且不为空，ssdb和pegasus的路径是服务地址不做检查
+func checkSrc(dir, driver, name string) error {
+	switch driver {
+	case "ssdb", "pegasus":
+		return nil
+	case "gobadgerdb":
+	default:
+		dir = filepath.Join(dir, name+".db")
+	}
+	info, err := os.Stat(dir)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", dir)
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("%s is empty", dir)
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"os"
+	"strconv"
+)
+
+type graphmlKey struct {
+	ID   string `xml:"id,attr"`
+	For  string `xml:"for,attr"`
+	Name string `xml:"attr.name,attr"`
+	Type string `xml:"attr.type,attr"`
+}
+
+type graphmlData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+type graphmlNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphmlData `xml:"data"`
+}
+
+type graphmlEdge struct {
+	Source string `xml:"source,attr"`
+	Target string `xml:"target,attr"`
+}
+
+type graphmlGraph struct {
+	ID          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphmlNode `xml:"node"`
+	Edges       []graphmlEdge `xml:"edge"`
+}
+
+type graphml struct {
+	XMLName xml.Name     `xml:"graphml"`
+	XMLNS   string       `xml:"xmlns,attr"`
+	Keys    []graphmlKey `xml:"key"`
+	Graph   graphmlGraph `xml:"graph"`
+}
+
+//节点属性, 和graphmlKey的ID对应
+var graphmlKeys = []graphmlKey{
+	{ID: "reachable", For: "node", Name: "reachable", Type: "boolean"},
+	{ID: "version", For: "node", Name: "version", Type: "string"},
+	{ID: "height", For: "node", Name: "height", Type: "long"},
+	{ID: "hash", For: "node", Name: "hash", Type: "string"},
+	{ID: "head", For: "node", Name: "head", Type: "string"},
+	{ID: "fullNode", For: "node", Name: "fullNode", Type: "boolean"},
+	{ID: "nat", For: "node", Name: "nat", Type: "string"},
+	{ID: "relayed", For: "node", Name: "relayed", Type: "boolean"},
+	{ID: "latencyMs", For: "node", Name: "latencyMs", Type: "long"},
+	{ID: "chunkStart", For: "node", Name: "chunkStart", Type: "long"},
+	{ID: "chunkEnd", For: "node", Name: "chunkEnd", Type: "long"},
+}
+
+// writeGraphML 以GraphML格式输出网络拓扑, 边为节点路由表中的节点
+func writeGraphML(w io.Writer, snapshot *Snapshot) error {
+	heads := make(map[string]string)
+	for _, head := range snapshot.Report.Heads {
+		heads[head.Hash] = head.Status
+	}
+	g := graphml{
+		XMLNS: "http://graphml.graphdrawing.org/xmlns",
+		Keys:  graphmlKeys,
+		Graph: graphmlGraph{ID: "chain33", EdgeDefault: "directed"},
+	}
+	for _, node := range snapshot.Nodes {
+		g.Graph.Nodes = append(g.Graph.Nodes, graphmlNode{
+			ID: node.ID,
+			Data: []graphmlData{
+				{Key: "reachable", Value: strconv.FormatBool(node.Reachable)},
+				{Key: "version", Value: node.Version},
+				{Key: "height", Value: strconv.FormatInt(node.Height, 10)},
+				{Key: "hash", Value: node.Hash},
+				{Key: "head", Value: heads[node.Hash]},
+				{Key: "fullNode", Value: strconv.FormatBool(node.FullNode)},
+				{Key: "nat", Value: node.NAT},
+				{Key: "relayed", Value: strconv.FormatBool(node.Relayed)},
+				{Key: "latencyMs", Value: strconv.FormatInt(node.LatencyMs, 10)},
+				{Key: "chunkStart", Value: strconv.FormatInt(node.ChunkStart, 10)},
+				{Key: "chunkEnd", Value: strconv.FormatInt(node.ChunkEnd, 10)},
+			},
+		})
+		for _, target := range node.Knows {
+			g.Graph.Edges = append(g.Graph.Edges, graphmlEdge{Source: node.ID, Target: target})
+		}
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	return encoder.Encode(&g)
+}
+
+func writeJSON(w io.Writer, v interface{}) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+// exportSnapshot 输出GraphML和JSON格式的快照文件, 文件名为空时不输出
+func exportSnapshot(snapshot *Snapshot, graphmlFile, jsonFile string) error {
+	if graphmlFile != "" {
+		if err := writeFile(graphmlFile, func(w io.Writer) error { return writeGraphML(w, snapshot) }); err != nil {
+			return err
+		}
+	}
+	if jsonFile != "" {
+		return writeFile(jsonFile, func(w io.Writer) error { return writeJSON(w, snapshot) })
+	}
+	return nil
+}
+
+func writeFile(path string, write func(w io.Writer) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err = write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
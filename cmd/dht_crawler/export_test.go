@@ -0,0 +1,72 @@
+package main
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSnapshot(t int64) *Snapshot {
+	nodes := []*NodeInfo{
+		{ID: "a", Reachable: true, Version: "v1", Height: 100, Hash: "0x01", NAT: natPublic, Knows: []string{"b", "c"}, ChunkStart: 0, ChunkEnd: 1},
+		{ID: "b", Reachable: true, Version: "v1", Height: 100, Hash: "0x01", NAT: natPrivate, Relayed: true, Knows: []string{"a"}, ChunkStart: -1, ChunkEnd: -1},
+		{ID: "c", Reachable: false, NAT: natUnknown, ChunkStart: -1, ChunkEnd: -1},
+	}
+	heads := groupHeads(nodes)
+	chunks := []*ChunkReplica{{Num: 0, Start: 0, End: 99, Replicas: 1}}
+	return &Snapshot{Time: t, Nodes: nodes, Chunks: chunks, Report: newReport(nodes, heads, chunks, 2)}
+}
+
+func TestExportSnapshot(t *testing.T) {
+	dir, err := ioutil.TempDir("", "crawler")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	snapshot := newTestSnapshot(1000)
+	graphmlFile, jsonFile := filepath.Join(dir, "net.graphml"), filepath.Join(dir, "net.json")
+	require.NoError(t, exportSnapshot(snapshot, graphmlFile, jsonFile))
+
+	data, err := ioutil.ReadFile(graphmlFile)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), xml.Header)
+	g := &graphml{}
+	require.NoError(t, xml.Unmarshal(data, g))
+	assert.Equal(t, "http://graphml.graphdrawing.org/xmlns", g.XMLNS)
+	assert.Equal(t, len(graphmlKeys), len(g.Keys))
+	require.Equal(t, 3, len(g.Graph.Nodes))
+	assert.Equal(t, "a", g.Graph.Nodes[0].ID)
+	values := make(map[string]string)
+	for _, d := range g.Graph.Nodes[0].Data {
+		values[d.Key] = d.Value
+	}
+	assert.Equal(t, "true", values["reachable"])
+	assert.Equal(t, "100", values["height"])
+	assert.Equal(t, headMain, values["head"])
+	assert.Equal(t, natPublic, values["nat"])
+	assert.Equal(t, "1", values["chunkEnd"])
+	assert.Equal(t, []graphmlEdge{{Source: "a", Target: "b"}, {Source: "a", Target: "c"}, {Source: "b", Target: "a"}}, g.Graph.Edges)
+
+	data, err = ioutil.ReadFile(jsonFile)
+	require.NoError(t, err)
+	decoded := &Snapshot{}
+	require.NoError(t, json.Unmarshal(data, decoded))
+	assert.Equal(t, snapshot, decoded)
+
+	//文件名为空时不输出
+	require.NoError(t, os.Remove(graphmlFile))
+	require.NoError(t, os.Remove(jsonFile))
+	require.NoError(t, exportSnapshot(snapshot, "", jsonFile))
+	_, err = os.Stat(graphmlFile)
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(jsonFile)
+	assert.NoError(t, err)
+
+	//目录不存在时返回错误
+	assert.NotNil(t, exportSnapshot(snapshot, filepath.Join(dir, "none", "net.graphml"), ""))
+	assert.NotNil(t, exportSnapshot(snapshot, "", filepath.Join(dir, "none", "net.json")))
+}
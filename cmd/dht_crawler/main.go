@@ -5,9 +5,18 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
+	"net/http"
 	"os"
+	"os/signal"
+	"regexp"
+	"sort"
+	"strconv"
+	"syscall"
 	"time"
 
+	clog "github.com/33cn/chain33/common/log"
+	"github.com/33cn/chain33/common/log/log15"
+	types2 "github.com/33cn/chain33/system/p2p/dht/types"
 	"github.com/libp2p/go-libp2p"
 	core "github.com/libp2p/go-libp2p-core"
 	"github.com/libp2p/go-libp2p-core/peer"
@@ -18,6 +27,8 @@ import (
 
 // Run：
 //dht_crawler -proto "/chain33-0/kad/1.0.0" -node "/ip4/ip/tcp/port/pid"
+//每10分钟爬取一次, 保存快照并通过http查看网络报告:
+//dht_crawler -proto "/chain33-0/kad/1.0.0" -node "/ip4/ip/tcp/port/pid" -interval 10m -http "localhost:8080"
 
 //dhtprotoId="/chain33-0/kad/1.0.0"
 var (
@@ -34,8 +45,28 @@ var (
 	allPeers  = make(map[peer.ID]*peer.AddrInfo)             //pid---->[ip:port,ip:port,ip:port]
 )
 
+var (
+	graphmlOut  = flag.String("graphml", "topology.graphml", "graphml format topology with node status")
+	snapshotOut = flag.String("snapshot", "snapshot.json", "network snapshot with node status and report")
+	//快照保存目录
+	dbDir       = flag.String("db", "crawler_data", "snapshot db dir")
+	httpAddr    = flag.String("http", "", "http report listen address, such as localhost:8080, disabled if empty")
+	interval    = flag.Duration("interval", 0, "crawl interval, such as 10m, crawl only once if 0")
+	concurrency = flag.Int("concurrency", 16, "number of nodes probed concurrently")
+	//和区块链配置中的chunkblockNum以及dht配置中的percentage一致, 用于估计chunk副本数量
+	chunkBlockNum = flag.Int64("chunkblocknum", 1000, "block number of each chunk")
+	percentage    = flag.Int("percentage", types2.DefaultPercentage, "percentage of nearest shard nodes storing each chunk")
+	minReplicas   = flag.Int("replicas", 3, "chunks with fewer replicas are reported as under-replicated")
+	logLevel      = flag.String("loglevel", "error", "console log level")
+
+	log = log15.New("module", "dht_crawler")
+	//dht协议ID格式为/title-channel/kad/1.0.0
+	channelRegexp = regexp.MustCompile(`-(\d+)/kad/`)
+)
+
 func main() {
 	flag.Parse()
+	clog.SetLogLevel(*logLevel)
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	h, err := libp2p.New(ctx)
@@ -83,6 +114,39 @@ d::::::ddddd::::::dd h:::::h     h:::::h     t::::::tttt:::::t
  d:::::::::::::::::d h:::::h     h:::::h     tt::::::::::::::t
   d:::::::::ddd::::d h:::::h     h:::::h       tt:::::::::::tt
    ddddddddd   ddddd hhhhhhh     hhhhhhh         ttttttttttt     crawler start working....,wait a moment`)
+	store := newSnapshotStore(*dbDir)
+	defer store.close()
+	if *httpAddr != "" {
+		go func() {
+			if err := http.ListenAndServe(*httpAddr, newReportServer(store)); err != nil {
+				fmt.Println("http report server err:", err)
+			}
+		}()
+	}
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+	for {
+		crawl(ctx, h, cl, startingPeerInfo, store)
+		if *interval <= 0 {
+			break
+		}
+		select {
+		case <-time.After(*interval):
+		case <-interrupt:
+			return
+		}
+	}
+	if *httpAddr != "" {
+		fmt.Println("---->http report listening on", *httpAddr)
+		<-interrupt
+	}
+}
+
+func crawl(ctx context.Context, h core.Host, cl *crawler.Crawler, startingPeerInfo *peer.AddrInfo, store *snapshotStore) {
+	peerMap = make(map[peer.ID]map[peer.ID]*peer.AddrInfo)
+	peerKnows = make(map[string][]string)
+	allPeers = make(map[peer.ID]*peer.AddrInfo)
+	finished = false
 	barRun(handlerSuccess)
 	cl.Run(ctx, []*peer.AddrInfo{startingPeerInfo}, handlerSuccess, nil)
 	finished = true
@@ -92,6 +156,55 @@ d::::::ddddd::::::dd h:::::h     h:::::h     t::::::tttt:::::t
 	fmt.Println("\n++++++++++dht crawler done...++++++++++")
 	fmt.Println("---->totalPeerNum.", len(allPeers))
 
+	snapshot := probeNetwork(ctx, h)
+	if err := store.save(snapshot); err != nil {
+		fmt.Println("save snapshot err:", err)
+	}
+	if err := exportSnapshot(snapshot, *graphmlOut, *snapshotOut); err != nil {
+		fmt.Println("export snapshot err:", err)
+	}
+	report := snapshot.Report
+	fmt.Println("---->reachablePeerNum.", report.Reachable, "maxHeight.", report.MaxHeight)
+	fmt.Println("---->forks.", len(report.Forks), "stuckPeers.", len(report.Stuck), "underReplicatedChunkRanges.", len(report.UnderReplicated))
+}
+
+//probeNetwork 探测爬取到的所有节点, 生成网络快照
+func probeNetwork(ctx context.Context, h core.Host) *Snapshot {
+	pids := make([]peer.ID, 0, len(allPeers))
+	for pid := range allPeers {
+		if pid != h.ID() {
+			pids = append(pids, pid)
+		}
+	}
+	p := newProber(h, parseChannel(*dhtProtoID), *chunkBlockNum)
+	nodes := p.probeAll(ctx, pids, *concurrency)
+	for _, node := range nodes {
+		node.Knows = peerKnows[node.ID]
+		sort.Strings(node.Knows)
+	}
+	heads := groupHeads(nodes)
+	p.resolveHeads(ctx, heads)
+	var mainHead *HeadGroup
+	if len(heads) > 0 {
+		mainHead = heads[0]
+	}
+	chunks := p.estimateReplicas(nodes, mainHead, *percentage)
+	return &Snapshot{
+		Time:   time.Now().Unix(),
+		Nodes:  nodes,
+		Chunks: chunks,
+		Report: newReport(nodes, heads, chunks, *minReplicas),
+	}
+}
+
+//parseChannel 从dht协议ID中解析channel, 用于版本协议的检查
+func parseChannel(protoID string) int32 {
+	match := channelRegexp.FindStringSubmatch(protoID)
+	if len(match) < 2 {
+		return 0
+	}
+	channel, _ := strconv.ParseInt(match[1], 10, 32)
+	return int32(channel)
 }
 
 func handlerSuccess(p peer.ID, rtPeers []*peer.AddrInfo) {
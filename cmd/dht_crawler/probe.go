@@ -0,0 +1,399 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common/utils"
+	prototypes "github.com/33cn/chain33/system/p2p/dht/protocol"
+	"github.com/33cn/chain33/types"
+	core "github.com/libp2p/go-libp2p-core"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	kb "github.com/libp2p/go-libp2p-kbucket"
+	"github.com/libp2p/go-libp2p/p2p/protocol/ping"
+	"github.com/multiformats/go-multiaddr"
+)
+
+//探测使用的chain33节点协议
+const (
+	peerInfoID     = "/chain33/peer-info/1.0.0"
+	peerVersionID  = "/chain33/peer-version/1.0.0"
+	getHeaderID    = "/chain33/headers/1.0.0"
+	getChunkRecord = "/chain33/chunk-record/1.0.0"
+	relayID        = "/libp2p/circuit/relay/0.1.0"
+	//和p2pstore保持一致, 用于计算chunk存储节点
+	chunkNameSpace = "chunk"
+)
+
+const (
+	probeTimeout = time.Second * 10
+	//每次查询的chunk记录数量
+	chunkRecordPage = 100
+)
+
+// prober 探测节点状态
+type prober struct {
+	host          core.Host
+	channel       int32
+	chunkBlockNum int64
+	//每个节点可提供的chunk记录, 用于估计chunk副本数量
+	lock    sync.Mutex
+	records map[string][]*types.ChunkInfo
+}
+
+func newProber(host core.Host, channel int32, chunkBlockNum int64) *prober {
+	return &prober{
+		host:          host,
+		channel:       channel,
+		chunkBlockNum: chunkBlockNum,
+		records:       make(map[string][]*types.ChunkInfo),
+	}
+}
+
+// probeAll 并发探测所有节点, 返回结果按节点ID排序
+func (p *prober) probeAll(ctx context.Context, pids []peer.ID, concurrency int) []*NodeInfo {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	nodes := make([]*NodeInfo, len(pids))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range jobs {
+				nodes[index] = p.probe(ctx, pids[index])
+			}
+		}()
+	}
+	for i := range pids {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].ID < nodes[j].ID })
+	return nodes
+}
+
+func (p *prober) probe(ctx context.Context, pid peer.ID) *NodeInfo {
+	node := &NodeInfo{ID: pid.Pretty(), NAT: natUnknown, ChunkStart: -1, ChunkEnd: -1}
+	for _, addr := range p.host.Peerstore().Addrs(pid) {
+		node.Addrs = append(node.Addrs, addr.String())
+	}
+	start := time.Now()
+	info, err := p.peerInfo(ctx, pid)
+	if err != nil {
+		node.Error = err.Error()
+		return node
+	}
+	node.LatencyMs = time.Since(start).Milliseconds()
+	node.Reachable = true
+	node.Version = info.GetVersion()
+	node.Height = info.GetHeader().GetHeight()
+	node.Hash = hex.EncodeToString(info.GetHeader().GetHash())
+	node.FullNode = info.GetFullNode()
+	node.Blocked = info.GetBlocked()
+	if rtt, err := p.ping(ctx, pid); err == nil {
+		node.LatencyMs = rtt.Milliseconds()
+	}
+	if version, err := p.version(ctx, pid); err == nil {
+		node.ExternalAddr = version.GetAddrFrom()
+	}
+	protocols, err := p.host.Peerstore().SupportsProtocols(pid, relayID)
+	node.SupportRelay = err == nil && len(protocols) > 0
+	p.setNAT(node, pid)
+
+	records, err := p.chunkRecords(ctx, pid, node.Height)
+	if err != nil && len(records) == 0 {
+		log.Debug("probe", "pid", pid, "chunk records err", err)
+	}
+	if len(records) > 0 {
+		node.ChunkStart, node.ChunkEnd = records[0].ChunkNum, records[len(records)-1].ChunkNum
+		p.lock.Lock()
+		p.records[node.ID] = records
+		p.lock.Unlock()
+	}
+	return node
+}
+
+//setNAT 根据节点记录的外部地址和当前连接判断节点是否位于NAT后
+func (p *prober) setNAT(node *NodeInfo, pid peer.ID) {
+	if ip := multiaddrIP(node.ExternalAddr); ip != "" {
+		if utils.IsPublicIP(ip) {
+			node.NAT = natPublic
+		} else {
+			node.NAT = natPrivate
+		}
+	}
+	for _, conn := range p.host.Network().ConnsToPeer(pid) {
+		remote := conn.RemoteMultiaddr()
+		if _, err := remote.ValueForProtocol(multiaddr.P_CIRCUIT); err == nil {
+			node.Relayed = true
+			continue
+		}
+		if node.NAT == natUnknown {
+			node.NAT = natPrivate
+		}
+		if utils.IsPublicIP(multiaddrIP(remote.String())) {
+			node.NAT = natPublic
+		}
+	}
+	if node.Relayed && node.NAT == natUnknown {
+		node.NAT = natPrivate
+	}
+}
+
+func multiaddrIP(addr string) string {
+	maddr, err := multiaddr.NewMultiaddr(addr)
+	if err != nil {
+		return ""
+	}
+	if ip, err := maddr.ValueForProtocol(multiaddr.P_IP4); err == nil {
+		return ip
+	}
+	ip, _ := maddr.ValueForProtocol(multiaddr.P_IP6)
+	return ip
+}
+
+func (p *prober) peerInfo(ctx context.Context, pid peer.ID) (*types.Peer, error) {
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+	stream, err := p.host.NewStream(ctx, pid, peerInfoID)
+	if err != nil {
+		return nil, err
+	}
+	defer prototypes.CloseStream(stream)
+	_ = stream.SetDeadline(time.Now().Add(probeTimeout))
+	var info types.Peer
+	if err = prototypes.ReadStream(&info, stream); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+//version 交换版本信息, 节点会返回其记录的外部地址, channel不一致时对方会断开连接
+func (p *prober) version(ctx context.Context, pid peer.ID) (*types.P2PVersion, error) {
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+	stream, err := p.host.NewStream(ctx, pid, peerVersionID)
+	if err != nil {
+		return nil, err
+	}
+	defer prototypes.CloseStream(stream)
+	_ = stream.SetDeadline(time.Now().Add(probeTimeout))
+	req := &types.P2PVersion{
+		Version:   p.channel,
+		AddrRecv:  stream.Conn().RemoteMultiaddr().String(),
+		Timestamp: time.Now().Unix(),
+	}
+	if err = prototypes.WriteStream(req, stream); err != nil {
+		return nil, err
+	}
+	var resp types.P2PVersion
+	if err = prototypes.ReadStream(&resp, stream); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (p *prober) ping(ctx context.Context, pid peer.ID) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+	select {
+	case res := <-ping.Ping(ctx, p.host, pid):
+		return res.RTT, res.Error
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+}
+
+//request 发送签名的请求并验证返回结果的签名
+func (p *prober) request(ctx context.Context, pid peer.ID, id protocol.ID, req *types.P2PRequest) (*types.P2PResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+	stream, err := p.host.NewStream(ctx, pid, id)
+	if err != nil {
+		return nil, err
+	}
+	defer prototypes.CloseStream(stream)
+	_ = stream.SetDeadline(time.Now().Add(probeTimeout))
+	if err = prototypes.SignAndWriteStream(req, stream); err != nil {
+		return nil, err
+	}
+	var res types.P2PResponse
+	if err = prototypes.ReadStreamAndAuthenticate(&res, stream); err != nil {
+		return nil, err
+	}
+	if res.Error != "" {
+		return nil, errors.New(res.Error)
+	}
+	return &res, nil
+}
+
+func (p *prober) header(ctx context.Context, pid peer.ID, height int64) (*types.Header, error) {
+	res, err := p.request(ctx, pid, getHeaderID, &types.P2PRequest{
+		Request: &types.P2PRequest_ReqBlocks{ReqBlocks: &types.ReqBlocks{Start: height, End: height}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	headers := res.GetBlockHeaders().GetItems()
+	if len(headers) != 1 || headers[0].GetHeight() != height {
+		return nil, types.ErrNotFound
+	}
+	return headers[0], nil
+}
+
+func (p *prober) chunkRecordRange(ctx context.Context, pid peer.ID, start, end int64) ([]*types.ChunkInfo, error) {
+	res, err := p.request(ctx, pid, getChunkRecord, &types.P2PRequest{
+		Request: &types.P2PRequest_ReqChunkRecords{ReqChunkRecords: &types.ReqChunkRecords{Start: start, End: end}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.GetChunkRecords().GetInfos(), nil
+}
+
+//chunkRecords 分页查询节点的chunk记录, 节点只要缺少范围内的一个记录就会返回ErrNotFound,
+//此时二分查找最后一个可用的记录
+func (p *prober) chunkRecords(ctx context.Context, pid peer.ID, height int64) ([]*types.ChunkInfo, error) {
+	if p.chunkBlockNum <= 0 {
+		return nil, nil
+	}
+	maxChunk := height / p.chunkBlockNum
+	var records []*types.ChunkInfo
+	for start := int64(0); start < maxChunk; start += chunkRecordPage {
+		end := start + chunkRecordPage - 1
+		if end >= maxChunk {
+			end = maxChunk - 1
+		}
+		infos, err := p.chunkRecordRange(ctx, pid, start, end)
+		if err == nil {
+			records = append(records, infos...)
+			continue
+		}
+		if err.Error() != types.ErrNotFound.Error() {
+			return records, err
+		}
+		var found []*types.ChunkInfo
+		low, high := start, end-1
+		for low <= high {
+			mid := (low + high) / 2
+			infos, err = p.chunkRecordRange(ctx, pid, start, mid)
+			if err != nil {
+				high = mid - 1
+				continue
+			}
+			found = infos
+			low = mid + 1
+		}
+		records = append(records, found...)
+		break
+	}
+	return records, nil
+}
+
+//resolveHeads 对比各组节点的主链头和主链上相同高度的区块, 区分落后, 领先和分叉的节点
+func (p *prober) resolveHeads(ctx context.Context, heads []*HeadGroup) {
+	if len(heads) == 0 {
+		return
+	}
+	mainHead := heads[0]
+	for _, head := range heads[1:] {
+		var err error
+		var header *types.Header
+		if head.Height <= mainHead.Height {
+			header, err = p.groupHeader(ctx, mainHead, head.Height)
+			if err == nil && hex.EncodeToString(header.Hash) == head.Hash {
+				head.Status = headLagging
+			}
+		} else {
+			header, err = p.groupHeader(ctx, head, mainHead.Height)
+			if err == nil && hex.EncodeToString(header.Hash) == mainHead.Hash {
+				head.Status = headAhead
+			}
+		}
+		if err != nil {
+			log.Error("resolveHeads", "hash", head.Hash, "height", head.Height, "err", err)
+			continue
+		}
+		if head.Status == headUnknown {
+			head.Status = headFork
+		}
+	}
+}
+
+//groupHeader 从组内节点获取指定高度的区块头, 最多尝试3个节点
+func (p *prober) groupHeader(ctx context.Context, group *HeadGroup, height int64) (*types.Header, error) {
+	err := types.ErrNotFound
+	for i, id := range group.Nodes {
+		if i >= 3 {
+			break
+		}
+		pid, decodeErr := peer.Decode(id)
+		if decodeErr != nil {
+			continue
+		}
+		var header *types.Header
+		if header, err = p.header(ctx, pid, height); err == nil {
+			return header, nil
+		}
+	}
+	return nil, err
+}
+
+//estimateReplicas 估计主链上每个chunk的副本数量, 全节点保存所有chunk,
+//分片节点按照p2pstore的规则保存距离chunk最近的percentage比例节点中的chunk
+func (p *prober) estimateReplicas(nodes []*NodeInfo, mainHead *HeadGroup, percentage int) []*ChunkReplica {
+	if mainHead == nil {
+		return nil
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	//取主链节点中chunk记录最多的作为参考
+	var reference []*types.ChunkInfo
+	for _, id := range mainHead.Nodes {
+		if records := p.records[id]; len(records) > len(reference) {
+			reference = records
+		}
+	}
+	var pids []peer.ID
+	byID := make(map[peer.ID]*NodeInfo)
+	for _, node := range nodes {
+		pid, err := peer.Decode(node.ID)
+		if err != nil || !node.Reachable {
+			continue
+		}
+		pids = append(pids, pid)
+		byID[pid] = node
+	}
+	chunks := make([]*ChunkReplica, 0, len(reference))
+	for _, info := range reference {
+		chunk := &ChunkReplica{
+			Num:   info.ChunkNum,
+			Hash:  hex.EncodeToString(info.ChunkHash),
+			Start: info.Start,
+			End:   info.End,
+		}
+		sorted := kb.SortClosestPeers(pids, kb.ConvertKey(fmt.Sprintf("/%s/%s", chunkNameSpace, chunk.Hash)))
+		cutoff := (len(sorted) - 1) * percentage / 100
+		for i, pid := range sorted {
+			node := byID[pid]
+			if chunk.Num < node.ChunkStart || chunk.Num > node.ChunkEnd {
+				continue
+			}
+			if node.FullNode || i <= cutoff {
+				chunk.Replicas++
+			}
+		}
+		chunks = append(chunks, chunk)
+	}
+	return chunks
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"html/template"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+//历史快照列表的最大数量
+const maxHistory = 50
+
+var reportTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"formatTime": func(t int64) string { return time.Unix(t, 0).Format("2006-01-02 15:04:05") },
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>chain33 network report</title>
+<style>
+body { font-family: monospace; }
+table { border-collapse: collapse; margin-bottom: 16px; }
+th, td { border: 1px solid #ccc; padding: 2px 8px; text-align: left; }
+.fork, .stuck, .under { color: #c00; }
+</style>
+</head>
+<body>
+{{with .Snapshot}}
+<h2>snapshot {{formatTime .Time}}</h2>
+<p>
+<a href="snapshot.json?time={{.Time}}">json</a>
+<a href="topology.graphml?time={{.Time}}">graphml</a>
+</p>
+{{with .Report}}
+<table>
+<tr><th>nodes</th><td>{{.Nodes}}</td></tr>
+<tr><th>reachable</th><td>{{.Reachable}}</td></tr>
+<tr><th>full nodes</th><td>{{.FullNodes}}</td></tr>
+<tr><th>relayed</th><td>{{.Relayed}}</td></tr>
+<tr><th>max height</th><td>{{.MaxHeight}}</td></tr>
+</table>
+
+<h3>heads</h3>
+<table>
+<tr><th>status</th><th>height</th><th>hash</th><th>nodes</th></tr>
+{{range .Heads}}<tr class="{{.Status}}"><td>{{.Status}}</td><td>{{.Height}}</td><td>{{.Hash}}</td><td>{{len .Nodes}}</td></tr>
+{{end}}
+</table>
+
+{{if .Forks}}
+<h3 class="fork">forks</h3>
+<table>
+<tr><th>height</th><th>hash</th><th>nodes</th></tr>
+{{range .Forks}}<tr><td>{{.Height}}</td><td>{{.Hash}}</td><td>{{range .Nodes}}{{.}}<br>{{end}}</td></tr>
+{{end}}
+</table>
+{{end}}
+
+{{if .Stuck}}
+<h3 class="stuck">stuck nodes</h3>
+<table>
+{{range .Stuck}}<tr><td>{{.}}</td></tr>
+{{end}}
+</table>
+{{end}}
+
+{{if .UnderReplicated}}
+<h3 class="under">under-replicated chunks</h3>
+<table>
+<tr><th>chunks</th><th>heights</th><th>min replicas</th></tr>
+{{range .UnderReplicated}}<tr><td>{{.StartChunk}}-{{.EndChunk}}</td><td>{{.StartHeight}}-{{.EndHeight}}</td><td>{{.MinReplicas}}</td></tr>
+{{end}}
+</table>
+{{end}}
+
+<h3>versions</h3>
+<table>
+{{range $version, $count := .Versions}}<tr><td>{{$version}}</td><td>{{$count}}</td></tr>
+{{end}}
+</table>
+
+<h3>nat</h3>
+<table>
+{{range $nat, $count := .NAT}}<tr><td>{{$nat}}</td><td>{{$count}}</td></tr>
+{{end}}
+</table>
+{{end}}
+
+<h3>nodes</h3>
+<table>
+<tr><th>id</th><th>reachable</th><th>version</th><th>height</th><th>full</th><th>nat</th><th>relayed</th><th>latency(ms)</th><th>chunks</th><th>error</th></tr>
+{{range .Nodes}}<tr><td>{{.ID}}</td><td>{{.Reachable}}</td><td>{{.Version}}</td><td>{{.Height}}</td><td>{{.FullNode}}</td><td>{{.NAT}}</td><td>{{.Relayed}}</td><td>{{.LatencyMs}}</td><td>{{if ge .ChunkStart 0}}{{.ChunkStart}}-{{.ChunkEnd}}{{end}}</td><td>{{.Error}}</td></tr>
+{{end}}
+</table>
+{{else}}
+<p>no snapshot yet</p>
+{{end}}
+
+<h3>history</h3>
+<table>
+<tr><th>time</th><th>nodes</th><th>reachable</th><th>max height</th><th>forks</th><th>stuck</th><th>under-replicated</th></tr>
+{{range .History}}<tr><td><a href="?time={{.Time}}">{{formatTime .Time}}</a></td><td>{{.Nodes}}</td><td>{{.Reachable}}</td><td>{{.MaxHeight}}</td><td>{{.Forks}}</td><td>{{.Stuck}}</td><td>{{.UnderReplicated}}</td></tr>
+{{end}}
+</table>
+</body>
+</html>
+`))
+
+//reportServer 通过http展示网络快照
+type reportServer struct {
+	store *snapshotStore
+}
+
+func newReportServer(store *snapshotStore) http.Handler {
+	s := &reportServer{store: store}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", s.handleReport)
+	mux.HandleFunc("/snapshot.json", s.handleSnapshot)
+	mux.HandleFunc("/topology.graphml", s.handleGraphML)
+	mux.HandleFunc("/snapshots.json", s.handleList)
+	return mux
+}
+
+//snapshot 获取请求参数time指定的快照, 未指定时获取最新的快照
+func (s *reportServer) snapshot(w http.ResponseWriter, r *http.Request) (*Snapshot, bool) {
+	var t int64
+	if param := r.URL.Query().Get("time"); param != "" {
+		var err error
+		if t, err = strconv.ParseInt(param, 10, 64); err != nil {
+			http.Error(w, "invalid time", http.StatusBadRequest)
+			return nil, false
+		}
+	}
+	snapshot, err := s.store.get(t)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return nil, false
+	}
+	return snapshot, true
+}
+
+func (s *reportServer) handleReport(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+	history, err := s.store.list(maxHistory)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	data := struct {
+		Snapshot *Snapshot
+		History  []*SnapshotSummary
+	}{History: history}
+	if len(history) > 0 {
+		if data.Snapshot, _ = s.snapshot(w, r); data.Snapshot == nil {
+			return
+		}
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err = reportTemplate.Execute(w, data); err != nil {
+		log.Error("handleReport", "execute template err", err)
+	}
+}
+
+func (s *reportServer) handleSnapshot(w http.ResponseWriter, r *http.Request) {
+	snapshot, ok := s.snapshot(w, r)
+	if !ok {
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = writeJSON(w, snapshot)
+}
+
+func (s *reportServer) handleGraphML(w http.ResponseWriter, r *http.Request) {
+	snapshot, ok := s.snapshot(w, r)
+	if !ok {
+		return
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	_ = writeGraphML(w, snapshot)
+}
+
+func (s *reportServer) handleList(w http.ResponseWriter, r *http.Request) {
+	count := int64(maxHistory)
+	if param := r.URL.Query().Get("count"); param != "" {
+		var err error
+		if count, err = strconv.ParseInt(param, 10, 32); err != nil || count <= 0 {
+			http.Error(w, "invalid count", http.StatusBadRequest)
+			return
+		}
+	}
+	summaries, err := s.store.list(int32(count))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = writeJSON(w, summaries)
+}
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"sort"
+)
+
+//节点主链头状态
+const (
+	headMain    = "main"
+	headLagging = "lagging"
+	headAhead   = "ahead"
+	headFork    = "fork"
+	headUnknown = "unknown"
+)
+
+//节点NAT状态
+const (
+	natPublic  = "public"
+	natPrivate = "private"
+	natUnknown = "unknown"
+)
+
+// NodeInfo 单个节点的探测结果
+type NodeInfo struct {
+	ID    string   `json:"id"`
+	Addrs []string `json:"addrs"`
+	//路由表中的节点
+	Knows     []string `json:"knows,omitempty"`
+	Reachable bool     `json:"reachable"`
+	Error     string   `json:"error,omitempty"`
+	Version   string   `json:"version,omitempty"`
+	Height    int64    `json:"height"`
+	Hash      string   `json:"hash,omitempty"`
+	FullNode  bool     `json:"fullNode"`
+	Blocked   bool     `json:"blocked"`
+	//节点自身记录的外部地址
+	ExternalAddr string `json:"externalAddr,omitempty"`
+	NAT          string `json:"nat"`
+	//是否通过中继地址连接
+	Relayed bool `json:"relayed"`
+	//是否支持中继协议
+	SupportRelay bool  `json:"supportRelay"`
+	LatencyMs    int64 `json:"latencyMs"`
+	//节点可提供的chunk编号范围, 没有chunk时为-1
+	ChunkStart int64 `json:"chunkStart"`
+	ChunkEnd   int64 `json:"chunkEnd"`
+}
+
+// ChunkReplica chunk及估计的副本数量
+type ChunkReplica struct {
+	Num      int64  `json:"num"`
+	Hash     string `json:"hash"`
+	Start    int64  `json:"start"`
+	End      int64  `json:"end"`
+	Replicas int    `json:"replicas"`
+}
+
+// HeadGroup 主链头相同的节点
+type HeadGroup struct {
+	Height int64    `json:"height"`
+	Hash   string   `json:"hash"`
+	Status string   `json:"status"`
+	Nodes  []string `json:"nodes"`
+}
+
+// ChunkRange 连续的副本不足的chunk
+type ChunkRange struct {
+	StartChunk  int64 `json:"startChunk"`
+	EndChunk    int64 `json:"endChunk"`
+	StartHeight int64 `json:"startHeight"`
+	EndHeight   int64 `json:"endHeight"`
+	MinReplicas int   `json:"minReplicas"`
+}
+
+// Report 网络状态统计
+type Report struct {
+	Nodes     int            `json:"nodes"`
+	Reachable int            `json:"reachable"`
+	MaxHeight int64          `json:"maxHeight"`
+	Versions  map[string]int `json:"versions"`
+	NAT       map[string]int `json:"nat"`
+	Relayed   int            `json:"relayed"`
+	FullNodes int            `json:"fullNodes"`
+	Heads     []*HeadGroup   `json:"heads"`
+	//和主链分叉的节点组
+	Forks []*HeadGroup `json:"forks,omitempty"`
+	//高度落后且已停止增长的节点
+	Stuck           []string      `json:"stuck,omitempty"`
+	UnderReplicated []*ChunkRange `json:"underReplicated,omitempty"`
+}
+
+// Snapshot 一次爬取的网络快照
+type Snapshot struct {
+	Time   int64           `json:"time"`
+	Nodes  []*NodeInfo     `json:"nodes"`
+	Chunks []*ChunkReplica `json:"chunks,omitempty"`
+	Report *Report         `json:"report"`
+}
+
+//groupHeads 按照主链头对可达节点分组, 节点数最多的为主链, 数量相同时取高度较高的
+func groupHeads(nodes []*NodeInfo) []*HeadGroup {
+	groups := make(map[string]*HeadGroup)
+	for _, node := range nodes {
+		if !node.Reachable || node.Hash == "" {
+			continue
+		}
+		group, ok := groups[node.Hash]
+		if !ok {
+			group = &HeadGroup{Height: node.Height, Hash: node.Hash, Status: headUnknown}
+			groups[node.Hash] = group
+		}
+		group.Nodes = append(group.Nodes, node.ID)
+	}
+	heads := make([]*HeadGroup, 0, len(groups))
+	for _, group := range groups {
+		sort.Strings(group.Nodes)
+		heads = append(heads, group)
+	}
+	sort.Slice(heads, func(i, j int) bool {
+		if len(heads[i].Nodes) != len(heads[j].Nodes) {
+			return len(heads[i].Nodes) > len(heads[j].Nodes)
+		}
+		if heads[i].Height != heads[j].Height {
+			return heads[i].Height > heads[j].Height
+		}
+		return heads[i].Hash < heads[j].Hash
+	})
+	if len(heads) > 0 {
+		heads[0].Status = headMain
+	}
+	return heads
+}
+
+// mergeUnderReplicated 合并副本数量低于minReplicas的连续chunk
+func mergeUnderReplicated(chunks []*ChunkReplica, minReplicas int) []*ChunkRange {
+	var ranges []*ChunkRange
+	var last *ChunkRange
+	for _, chunk := range chunks {
+		if chunk.Replicas >= minReplicas {
+			last = nil
+			continue
+		}
+		if last != nil && last.EndChunk+1 == chunk.Num {
+			last.EndChunk, last.EndHeight = chunk.Num, chunk.End
+			if chunk.Replicas < last.MinReplicas {
+				last.MinReplicas = chunk.Replicas
+			}
+			continue
+		}
+		last = &ChunkRange{
+			StartChunk:  chunk.Num,
+			EndChunk:    chunk.Num,
+			StartHeight: chunk.Start,
+			EndHeight:   chunk.End,
+			MinReplicas: chunk.Replicas,
+		}
+		ranges = append(ranges, last)
+	}
+	return ranges
+}
+
+// newReport 统计节点信息, heads需要已经确定状态
+func newReport(nodes []*NodeInfo, heads []*HeadGroup, chunks []*ChunkReplica, minReplicas int) *Report {
+	report := &Report{
+		Nodes:    len(nodes),
+		Versions: make(map[string]int),
+		NAT:      make(map[string]int),
+		Heads:    heads,
+	}
+	lagging := make(map[string]bool)
+	for _, head := range heads {
+		switch head.Status {
+		case headFork:
+			report.Forks = append(report.Forks, head)
+		case headLagging:
+			for _, id := range head.Nodes {
+				lagging[id] = true
+			}
+		}
+	}
+	for _, node := range nodes {
+		report.NAT[node.NAT]++
+		if !node.Reachable {
+			continue
+		}
+		report.Reachable++
+		report.Versions[node.Version]++
+		if node.Height > report.MaxHeight {
+			report.MaxHeight = node.Height
+		}
+		if node.Relayed {
+			report.Relayed++
+		}
+		if node.FullNode {
+			report.FullNodes++
+		}
+		if node.Blocked && lagging[node.ID] {
+			report.Stuck = append(report.Stuck, node.ID)
+		}
+	}
+	sort.Strings(report.Stuck)
+	report.UnderReplicated = mergeUnderReplicated(chunks, minReplicas)
+	return report
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupHeads(t *testing.T) {
+	nodes := []*NodeInfo{
+		{ID: "a", Reachable: true, Height: 100, Hash: "0x01"},
+		{ID: "c", Reachable: true, Height: 100, Hash: "0x01"},
+		{ID: "b", Reachable: true, Height: 100, Hash: "0x01"},
+		{ID: "d", Reachable: true, Height: 90, Hash: "0x02"},
+		{ID: "e", Reachable: true, Height: 110, Hash: "0x03"},
+		//不可达或者没有主链头的节点不参与分组
+		{ID: "f", Reachable: false, Height: 120, Hash: "0x04"},
+		{ID: "g", Reachable: true},
+	}
+	heads := groupHeads(nodes)
+	require.Equal(t, 3, len(heads))
+	assert.Equal(t, &HeadGroup{Height: 100, Hash: "0x01", Status: headMain, Nodes: []string{"a", "b", "c"}}, heads[0])
+	//节点数相同时高度高的在前
+	assert.Equal(t, "0x03", heads[1].Hash)
+	assert.Equal(t, headUnknown, heads[1].Status)
+	assert.Equal(t, "0x02", heads[2].Hash)
+	assert.Equal(t, []string{"d"}, heads[2].Nodes)
+
+	//节点数和高度都相同时按hash排序
+	heads = groupHeads([]*NodeInfo{
+		{ID: "a", Reachable: true, Height: 10, Hash: "0x02"},
+		{ID: "b", Reachable: true, Height: 10, Hash: "0x01"},
+	})
+	require.Equal(t, 2, len(heads))
+	assert.Equal(t, "0x01", heads[0].Hash)
+	assert.Equal(t, headMain, heads[0].Status)
+
+	assert.Equal(t, 0, len(groupHeads(nil)))
+}
+
+func TestMergeUnderReplicated(t *testing.T) {
+	chunks := []*ChunkReplica{
+		{Num: 0, Start: 0, End: 99, Replicas: 1},
+		{Num: 1, Start: 100, End: 199, Replicas: 0},
+		{Num: 2, Start: 200, End: 299, Replicas: 2},
+		{Num: 3, Start: 300, End: 399, Replicas: 5},
+		{Num: 4, Start: 400, End: 499, Replicas: 1},
+		//不连续的chunk单独成为一个范围
+		{Num: 6, Start: 600, End: 699, Replicas: 1},
+	}
+	ranges := mergeUnderReplicated(chunks, 2)
+	require.Equal(t, 3, len(ranges))
+	assert.Equal(t, &ChunkRange{StartChunk: 0, EndChunk: 1, StartHeight: 0, EndHeight: 199, MinReplicas: 0}, ranges[0])
+	assert.Equal(t, &ChunkRange{StartChunk: 4, EndChunk: 4, StartHeight: 400, EndHeight: 499, MinReplicas: 1}, ranges[1])
+	assert.Equal(t, &ChunkRange{StartChunk: 6, EndChunk: 6, StartHeight: 600, EndHeight: 699, MinReplicas: 1}, ranges[2])
+
+	assert.Equal(t, 0, len(mergeUnderReplicated(chunks, 0)))
+	assert.Equal(t, 0, len(mergeUnderReplicated(nil, 2)))
+	ranges = mergeUnderReplicated(chunks, 10)
+	require.Equal(t, 2, len(ranges))
+	assert.Equal(t, int64(4), ranges[0].EndChunk)
+	assert.Equal(t, 0, ranges[0].MinReplicas)
+}
+
+func TestNewReport(t *testing.T) {
+	nodes := []*NodeInfo{
+		{ID: "a", Reachable: true, Version: "v1", Height: 100, Hash: "0x01", FullNode: true, NAT: natPublic},
+		{ID: "b", Reachable: true, Version: "v1", Height: 100, Hash: "0x01", NAT: natPrivate, Relayed: true},
+		{ID: "c", Reachable: true, Version: "v2", Height: 80, Hash: "0x02", NAT: natPublic, Blocked: true},
+		{ID: "d", Reachable: true, Version: "v2", Height: 80, Hash: "0x02", NAT: natPublic},
+		{ID: "e", Reachable: true, Version: "v1", Height: 90, Hash: "0x03", NAT: natPublic, Blocked: true},
+		{ID: "f", Reachable: false, Height: 200, NAT: natUnknown},
+	}
+	heads := []*HeadGroup{
+		{Height: 100, Hash: "0x01", Status: headMain, Nodes: []string{"a", "b"}},
+		{Height: 80, Hash: "0x02", Status: headLagging, Nodes: []string{"c", "d"}},
+		{Height: 90, Hash: "0x03", Status: headFork, Nodes: []string{"e"}},
+	}
+	chunks := []*ChunkReplica{
+		{Num: 0, Start: 0, End: 99, Replicas: 3},
+		{Num: 1, Start: 100, End: 199, Replicas: 1},
+	}
+	report := newReport(nodes, heads, chunks, 2)
+	assert.Equal(t, 6, report.Nodes)
+	assert.Equal(t, 5, report.Reachable)
+	//不可达节点的高度不计入
+	assert.Equal(t, int64(100), report.MaxHeight)
+	assert.Equal(t, map[string]int{"v1": 3, "v2": 2}, report.Versions)
+	assert.Equal(t, map[string]int{natPublic: 4, natPrivate: 1, natUnknown: 1}, report.NAT)
+	assert.Equal(t, 1, report.Relayed)
+	assert.Equal(t, 1, report.FullNodes)
+	assert.Equal(t, heads, report.Heads)
+	assert.Equal(t, []*HeadGroup{heads[2]}, report.Forks)
+	//只有落后于主链且停止增长的节点为stuck, 分叉节点不计入
+	assert.Equal(t, []string{"c"}, report.Stuck)
+	assert.Equal(t, []*ChunkRange{{StartChunk: 1, EndChunk: 1, StartHeight: 100, EndHeight: 199, MinReplicas: 1}}, report.UnderReplicated)
+
+	report = newReport(nil, nil, nil, 2)
+	assert.Equal(t, 0, report.Nodes)
+	assert.Equal(t, 0, len(report.Forks))
+	assert.Equal(t, 0, len(report.Stuck))
+	assert.Equal(t, 0, len(report.UnderReplicated))
+}
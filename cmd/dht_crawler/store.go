@@ -0,0 +1,104 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	dbm "github.com/33cn/chain33/common/db"
+)
+
+var (
+	snapshotPrefix = []byte("crawler-snapshot-")
+	summaryPrefix  = []byte("crawler-summary-")
+)
+
+// SnapshotSummary 快照概要, 用于列出历史快照
+type SnapshotSummary struct {
+	Time            int64 `json:"time"`
+	Nodes           int   `json:"nodes"`
+	Reachable       int   `json:"reachable"`
+	MaxHeight       int64 `json:"maxHeight"`
+	Forks           int   `json:"forks"`
+	Stuck           int   `json:"stuck"`
+	UnderReplicated int   `json:"underReplicated"`
+}
+
+func newSnapshotSummary(s *Snapshot) *SnapshotSummary {
+	return &SnapshotSummary{
+		Time:            s.Time,
+		Nodes:           s.Report.Nodes,
+		Reachable:       s.Report.Reachable,
+		MaxHeight:       s.Report.MaxHeight,
+		Forks:           len(s.Report.Forks),
+		Stuck:           len(s.Report.Stuck),
+		UnderReplicated: len(s.Report.UnderReplicated),
+	}
+}
+
+//snapshotStore 按照时间保存网络快照
+type snapshotStore struct {
+	db dbm.DB
+}
+
+func newSnapshotStore(dir string) *snapshotStore {
+	return &snapshotStore{db: dbm.NewDB("crawler", "leveldb", dir, 16)}
+}
+
+func snapshotKey(prefix []byte, t int64) []byte {
+	return append(append([]byte{}, prefix...), fmt.Sprintf("%020d", t)...)
+}
+
+func (s *snapshotStore) save(snapshot *Snapshot) error {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	summary, err := json.Marshal(newSnapshotSummary(snapshot))
+	if err != nil {
+		return err
+	}
+	batch := s.db.NewBatch(true)
+	batch.Set(snapshotKey(snapshotPrefix, snapshot.Time), data)
+	batch.Set(snapshotKey(summaryPrefix, snapshot.Time), summary)
+	return batch.Write()
+}
+
+// get 获取指定时间的快照, t为0时获取最新的快照
+func (s *snapshotStore) get(t int64) (*Snapshot, error) {
+	var data []byte
+	if t == 0 {
+		values := dbm.NewListHelper(s.db).List(snapshotPrefix, nil, 1, dbm.ListDESC)
+		if len(values) == 0 {
+			return nil, dbm.ErrNotFoundInDb
+		}
+		data = values[0]
+	} else {
+		var err error
+		if data, err = s.db.Get(snapshotKey(snapshotPrefix, t)); err != nil {
+			return nil, err
+		}
+	}
+	snapshot := &Snapshot{}
+	if err := json.Unmarshal(data, snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// list 从新到旧列出最多count个快照概要
+func (s *snapshotStore) list(count int32) ([]*SnapshotSummary, error) {
+	values := dbm.NewListHelper(s.db).List(summaryPrefix, nil, count, dbm.ListDESC)
+	summaries := make([]*SnapshotSummary, 0, len(values))
+	for _, value := range values {
+		summary := &SnapshotSummary{}
+		if err := json.Unmarshal(value, summary); err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries, nil
+}
+
+func (s *snapshotStore) close() {
+	s.db.Close()
+}
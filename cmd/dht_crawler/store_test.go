@@ -0,0 +1,44 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "crawler")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	store := newSnapshotStore(dir)
+	defer store.close()
+
+	_, err = store.get(0)
+	assert.Equal(t, dbm.ErrNotFoundInDb, err)
+	summaries, err := store.list(10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(summaries))
+
+	for _, tm := range []int64{1000, 3000, 2000} {
+		require.NoError(t, store.save(newTestSnapshot(tm)))
+	}
+	//时间为0时获取最新的快照
+	snapshot, err := store.get(0)
+	require.NoError(t, err)
+	assert.Equal(t, newTestSnapshot(3000), snapshot)
+	snapshot, err = store.get(2000)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2000), snapshot.Time)
+	_, err = store.get(1500)
+	assert.Equal(t, dbm.ErrNotFoundInDb, err)
+
+	summaries, err = store.list(2)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(summaries))
+	assert.Equal(t, &SnapshotSummary{Time: 3000, Nodes: 3, Reachable: 2, MaxHeight: 100, UnderReplicated: 1}, summaries[0])
+	assert.Equal(t, int64(2000), summaries[1].Time)
+}
@@ -3,7 +3,7 @@
 // license that can be found in the LICENSE file.
 
 // Package db 数据库操作底层接口定义以及实现包括：leveldb、
-// memdb、mvcc、badgerdb、pegasus、ssdb
+// memdb、mvcc、badgerdb、pebble、pegasus、ssdb
 package db
 
 import (
@@ -182,6 +182,7 @@ const (
 	goBadgerDBBackendStr  = "gobadgerdb"
 	ssDBBackendStr        = "ssdb"
 	goPegasusDbBackendStr = "pegasus"
+	pebbleBackendStr      = "pebble"
 )
 
 type dbCreator func(name string, dir string, cache int) (DB, error)
@@ -0,0 +1,362 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"bytes"
+	"path"
+
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/types"
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/bloom"
+)
+
+var plog = log.New("module", "db.pebble")
+
+func init() {
+	dbCreator := func(name string, dir string, cache int) (DB, error) {
+		return NewPebbleDB(name, dir, cache)
+	}
+	registerDBCreator(pebbleBackendStr, dbCreator, false)
+}
+
+//PebbleDB db
+type PebbleDB struct {
+	BaseDB
+	db *pebble.DB
+}
+
+//NewPebbleDB new
+func NewPebbleDB(name string, dir string, cache int) (*PebbleDB, error) {
+	dbPath := path.Join(dir, name+".db")
+	if cache == 0 {
+		cache = 64
+	}
+	handles := cache
+	if handles < 16 {
+		handles = 16
+	}
+	if cache < 4 {
+		cache = 4
+	}
+	blockCache := pebble.NewCache(int64(cache / 2 * 1024 * 1024))
+	defer blockCache.Unref()
+	opts := &pebble.Options{
+		Cache:        blockCache,
+		MaxOpenFiles: handles,
+		MemTableSize: cache / 4 * 1024 * 1024,
+		Levels:       []pebble.LevelOptions{{FilterPolicy: bloom.FilterPolicy(10)}},
+		//写入因为compaction停顿时输出日志，便于定位区块处理的延迟
+		EventListener: pebble.EventListener{
+			WriteStallBegin: func(info pebble.WriteStallBeginInfo) {
+				plog.Warn("Database compacting, write stall begin", "reason", info.Reason)
+			},
+			WriteStallEnd: func() {
+				plog.Warn("Database compacting, write stall end")
+			},
+		},
+	}
+	db, err := pebble.Open(dbPath, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &PebbleDB{db: db}, nil
+}
+
+//Get get
+func (db *PebbleDB) Get(key []byte) ([]byte, error) {
+	res, closer, err := db.db.Get(key)
+	if err != nil {
+		if err == pebble.ErrNotFound {
+			return nil, ErrNotFoundInDb
+		}
+		plog.Error("Get", "error", err)
+		return nil, err
+	}
+	value := cloneByte(res)
+	closer.Close()
+	return value, nil
+}
+
+//Set set
+func (db *PebbleDB) Set(key []byte, value []byte) error {
+	err := db.db.Set(key, value, pebble.NoSync)
+	if err != nil {
+		plog.Error("Set", "error", err)
+		return err
+	}
+	return nil
+}
+
+//SetSync 同步
+func (db *PebbleDB) SetSync(key []byte, value []byte) error {
+	err := db.db.Set(key, value, pebble.Sync)
+	if err != nil {
+		plog.Error("SetSync", "error", err)
+		return err
+	}
+	return nil
+}
+
+//Delete 删除
+func (db *PebbleDB) Delete(key []byte) error {
+	err := db.db.Delete(key, pebble.NoSync)
+	if err != nil {
+		plog.Error("Delete", "error", err)
+		return err
+	}
+	return nil
+}
+
+//DeleteSync 删除同步
+func (db *PebbleDB) DeleteSync(key []byte) error {
+	err := db.db.Delete(key, pebble.Sync)
+	if err != nil {
+		plog.Error("DeleteSync", "error", err)
+		return err
+	}
+	return nil
+}
+
+//DB db
+func (db *PebbleDB) DB() *pebble.DB {
+	return db.db
+}
+
+//Close 关闭
+func (db *PebbleDB) Close() {
+	err := db.db.Close()
+	if err != nil {
+		plog.Error("Close", "error", err)
+	}
+}
+
+//Print 打印
+func (db *PebbleDB) Print() {
+	plog.Info("Print", "stats", db.db.Metrics().String())
+
+	iter := db.db.NewIter(nil)
+	defer iter.Close()
+	for iter.First(); iter.Valid(); iter.Next() {
+		plog.Info("Print", "key", string(iter.Key()), "value", string(iter.Value()))
+	}
+}
+
+//Stats ...
+func (db *PebbleDB) Stats() map[string]string {
+	metrics := db.db.Metrics()
+	stats := make(map[string]string)
+	stats["pebble.stats"] = metrics.String()
+	return stats
+}
+
+//Iterator 迭代器
+func (db *PebbleDB) Iterator(start []byte, end []byte, reverse bool) Iterator {
+	if end == nil {
+		end = bytesPrefix(start)
+	}
+	if bytes.Equal(end, types.EmptyValue) {
+		end = nil
+	}
+	it := db.db.NewIter(&pebble.IterOptions{LowerBound: start, UpperBound: end})
+	return &pebbleIt{it, itBase{start, end, reverse}}
+}
+
+//BeginTx 事务中的写入在提交之前只对事务可见
+func (db *PebbleDB) BeginTx() (TxKV, error) {
+	return &pebbleTx{batch: db.db.NewIndexedBatch()}, nil
+}
+
+// CompactRange limit为nil时compact到最后一个key
+func (db *PebbleDB) CompactRange(start, limit []byte) error {
+	if limit == nil {
+		it := db.db.NewIter(&pebble.IterOptions{LowerBound: start})
+		if it.Last() {
+			limit = append(cloneByte(it.Key()), 0)
+		}
+		err := it.Close()
+		if err != nil {
+			return err
+		}
+	}
+	if start == nil {
+		start = []byte{}
+	}
+	if bytes.Compare(start, limit) >= 0 {
+		return nil
+	}
+	return db.db.Compact(start, limit)
+}
+
+type pebbleIt struct {
+	*pebble.Iterator
+	itBase
+}
+
+//Close 关闭
+func (dbit *pebbleIt) Close() {
+	err := dbit.Iterator.Close()
+	if err != nil {
+		plog.Error("Iterator Close", "error", err)
+	}
+}
+
+//Next next
+func (dbit *pebbleIt) Next() bool {
+	if dbit.reverse {
+		return dbit.Iterator.Prev() && dbit.Valid()
+	}
+	return dbit.Iterator.Next() && dbit.Valid()
+}
+
+//Seek seek key, 反向迭代时key不存在则定位到前一个key
+func (dbit *pebbleIt) Seek(key []byte) bool {
+	exist := dbit.Iterator.SeekGE(key)
+	if dbit.reverse && !(exist && bytes.Equal(dbit.Key(), key)) {
+		return dbit.Iterator.SeekLT(key) && dbit.Valid()
+	}
+	return exist
+}
+
+//Rewind ...
+func (dbit *pebbleIt) Rewind() bool {
+	if dbit.reverse {
+		return dbit.Iterator.Last() && dbit.Valid()
+	}
+	return dbit.Iterator.First() && dbit.Valid()
+}
+
+func (dbit *pebbleIt) Value() []byte {
+	return dbit.Iterator.Value()
+}
+
+func (dbit *pebbleIt) ValueCopy() []byte {
+	return cloneByte(dbit.Iterator.Value())
+}
+
+func (dbit *pebbleIt) Valid() bool {
+	return dbit.Iterator.Valid() && dbit.checkKey(dbit.Key())
+}
+
+type pebbleBatch struct {
+	db    *PebbleDB
+	batch *pebble.Batch
+	wop   *pebble.WriteOptions
+	size  int
+	len   int
+}
+
+//NewBatch new
+func (db *PebbleDB) NewBatch(sync bool) Batch {
+	return &pebbleBatch{db: db, batch: db.db.NewBatch(), wop: writeOptions(sync)}
+}
+
+func writeOptions(sync bool) *pebble.WriteOptions {
+	if sync {
+		return pebble.Sync
+	}
+	return pebble.NoSync
+}
+
+func (mBatch *pebbleBatch) Set(key, value []byte) {
+	_ = mBatch.batch.Set(key, value, nil)
+	mBatch.size += len(key)
+	mBatch.size += len(value)
+	mBatch.len += len(value)
+}
+
+func (mBatch *pebbleBatch) Delete(key []byte) {
+	_ = mBatch.batch.Delete(key, nil)
+	mBatch.size += len(key)
+	mBatch.len++
+}
+
+//Write 写入之后需要Reset才能继续使用
+func (mBatch *pebbleBatch) Write() error {
+	err := mBatch.db.db.Apply(mBatch.batch, mBatch.wop)
+	if err != nil {
+		plog.Error("Write", "error", err)
+		return err
+	}
+	return nil
+}
+
+func (mBatch *pebbleBatch) ValueSize() int {
+	return mBatch.size
+}
+
+//ValueLen  batch数量
+func (mBatch *pebbleBatch) ValueLen() int {
+	return mBatch.len
+}
+
+func (mBatch *pebbleBatch) Reset() {
+	mBatch.batch.Reset()
+	mBatch.len = 0
+	mBatch.size = 0
+}
+
+func (mBatch *pebbleBatch) UpdateWriteSync(sync bool) {
+	mBatch.wop = writeOptions(sync)
+}
+
+//pebbleTx 使用indexed batch实现事务，读取和迭代包含事务中未提交的写入
+type pebbleTx struct {
+	batch *pebble.Batch
+}
+
+func (db *pebbleTx) Commit() error {
+	return db.batch.Commit(pebble.Sync)
+}
+
+func (db *pebbleTx) Rollback() {
+	err := db.batch.Close()
+	if err != nil {
+		plog.Error("tx Rollback", "error", err)
+	}
+}
+
+//Get get in transaction
+func (db *pebbleTx) Get(key []byte) ([]byte, error) {
+	res, closer, err := db.batch.Get(key)
+	if err != nil {
+		if err == pebble.ErrNotFound {
+			return nil, ErrNotFoundInDb
+		}
+		plog.Error("tx Get", "error", err)
+		return nil, err
+	}
+	value := cloneByte(res)
+	closer.Close()
+	return value, nil
+}
+
+//Set set in transaction
+func (db *pebbleTx) Set(key []byte, value []byte) error {
+	err := db.batch.Set(key, value, nil)
+	if err != nil {
+		plog.Error("tx Set", "error", err)
+		return err
+	}
+	return nil
+}
+
+//Iterator 迭代器 in transaction
+func (db *pebbleTx) Iterator(start []byte, end []byte, reverse bool) Iterator {
+	if end == nil {
+		end = bytesPrefix(start)
+	}
+	if bytes.Equal(end, types.EmptyValue) {
+		end = nil
+	}
+	it := db.batch.NewIter(&pebble.IterOptions{LowerBound: start, UpperBound: end})
+	return &pebbleIt{it, itBase{start, end, reverse}}
+}
+
+//Begin call panic when Begin not rewrite
+func (db *pebbleTx) Begin() {
+	panic("Begin not impl")
+}
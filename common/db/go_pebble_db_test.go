@@ -0,0 +1,179 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPebbleDB(t *testing.T) (*PebbleDB, func()) {
+	dir, err := ioutil.TempDir("", "pebble")
+	require.NoError(t, err)
+	db, err := NewPebbleDB("pebble", dir, 128)
+	require.NoError(t, err)
+	return db, func() {
+		db.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+// pebble迭代器测试
+func TestPebbleDBIterator(t *testing.T) {
+	db, closer := newTestPebbleDB(t)
+	defer closer()
+	testDBIterator(t, db)
+}
+
+func TestPebbleDBIteratorAll(t *testing.T) {
+	db, closer := newTestPebbleDB(t)
+	defer closer()
+	testDBIteratorAllKey(t, db)
+}
+
+func TestPebbleDBIteratorReserverExample(t *testing.T) {
+	db, closer := newTestPebbleDB(t)
+	defer closer()
+	testDBIteratorReserverExample(t, db)
+}
+
+func TestPebbleDBIteratorDel(t *testing.T) {
+	db, closer := newTestPebbleDB(t)
+	defer closer()
+	testDBIteratorDel(t, db)
+}
+
+func TestPebbleDBBatch(t *testing.T) {
+	db, closer := newTestPebbleDB(t)
+	defer closer()
+	testBatch(t, db)
+
+	//Reset之后可以继续使用
+	batch := db.NewBatch(true)
+	batch.Set([]byte("batch1"), []byte("value1"))
+	batch.Delete([]byte("hello"))
+	assert.Equal(t, len("batch1")+len("value1")+len("hello"), batch.ValueSize())
+	require.NoError(t, batch.Write())
+	batch.Reset()
+	assert.Equal(t, 0, batch.ValueSize())
+	assert.Equal(t, 0, batch.ValueLen())
+	batch.UpdateWriteSync(false)
+	batch.Set([]byte("batch2"), []byte("value2"))
+	require.NoError(t, batch.Write())
+	_, err := db.Get([]byte("hello"))
+	assert.Equal(t, types.ErrNotFound, err)
+	value, err := db.Get([]byte("batch2"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value2"), value)
+}
+
+func TestPebbleDBTransaction(t *testing.T) {
+	db, closer := newTestPebbleDB(t)
+	defer closer()
+	testTransaction(t, db)
+
+	//事务中的迭代包含未提交的写入
+	require.NoError(t, db.Set([]byte("tx/1"), []byte("1")))
+	tx, err := db.BeginTx()
+	require.NoError(t, err)
+	require.NoError(t, tx.Set([]byte("tx/2"), []byte("2")))
+	it := tx.Iterator([]byte("tx/"), nil, true)
+	var keys []string
+	for it.Rewind(); it.Valid(); it.Next() {
+		keys = append(keys, string(it.Key()))
+	}
+	it.Close()
+	assert.Equal(t, []string{"tx/2", "tx/1"}, keys)
+	_, err = db.Get([]byte("tx/2"))
+	assert.Equal(t, types.ErrNotFound, err)
+	tx.Rollback()
+}
+
+// pebble边界测试
+func TestPebbleDBBoundary(t *testing.T) {
+	db, closer := newTestPebbleDB(t)
+	defer closer()
+	testDBBoundary(t, db)
+}
+
+// pebble返回值测试
+func TestPebbleDBResult(t *testing.T) {
+	db, closer := newTestPebbleDB(t)
+	defer closer()
+	testDBIteratorResult(t, db)
+}
+
+func TestPebbleDBSeek(t *testing.T) {
+	db, closer := newTestPebbleDB(t)
+	defer closer()
+	for i := 1; i <= 5; i += 2 {
+		require.NoError(t, db.Set([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("%d", i))))
+	}
+	it := db.Iterator([]byte("key"), nil, false)
+	assert.True(t, it.Seek([]byte("key1")))
+	assert.Equal(t, []byte("key1"), it.Key())
+	assert.True(t, it.Seek([]byte("key2")))
+	assert.Equal(t, []byte("key3"), it.Key())
+	assert.False(t, it.Seek([]byte("key6")))
+	it.Close()
+
+	//反向迭代时key不存在则定位到前一个key
+	it = db.Iterator([]byte("key"), nil, true)
+	assert.True(t, it.Seek([]byte("key3")))
+	assert.Equal(t, []byte("key3"), it.Key())
+	assert.True(t, it.Seek([]byte("key4")))
+	assert.Equal(t, []byte("key3"), it.Key())
+	assert.True(t, it.Seek([]byte("key6")))
+	assert.Equal(t, []byte("key5"), it.Key())
+	assert.True(t, it.Next())
+	assert.Equal(t, []byte("key3"), it.Key())
+	assert.False(t, it.Seek([]byte("key0")))
+	it.Close()
+}
+
+func TestPebbleDBCompactRange(t *testing.T) {
+	db, closer := newTestPebbleDB(t)
+	defer closer()
+	//空数据库
+	require.NoError(t, db.CompactRange(nil, nil))
+	for i := 0; i < 1000; i++ {
+		require.NoError(t, db.Set([]byte(fmt.Sprintf("key%04d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+	for i := 0; i < 500; i++ {
+		require.NoError(t, db.Delete([]byte(fmt.Sprintf("key%04d", i))))
+	}
+	require.NoError(t, db.CompactRange([]byte("key0500"), []byte("key0800")))
+	require.NoError(t, db.CompactRange(nil, nil))
+	require.NoError(t, db.CompactRange([]byte("key0900"), []byte("key0100")))
+	_, err := db.Get([]byte("key0000"))
+	assert.Equal(t, types.ErrNotFound, err)
+	value, err := db.Get([]byte("key0999"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value999"), value)
+
+	stats := db.Stats()
+	assert.Contains(t, stats["pebble.stats"], "total")
+	db.Print()
+}
+
+func TestPebbleDBBackend(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pebble")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	db := NewDB("test", pebbleBackendStr, dir, 16)
+	defer db.Close()
+	_, ok := db.(*PebbleDB)
+	assert.True(t, ok)
+	require.NoError(t, db.SetSync([]byte("key"), []byte("value")))
+	require.NoError(t, db.DeleteSync([]byte("key")))
+	_, err = db.Get([]byte("key"))
+	assert.Equal(t, types.ErrNotFound, err)
+}
@@ -20,6 +20,10 @@ func TestListHelper_List(t *testing.T) {
 	require.Nil(t, err)
 	defer bdb.Close()
 	testListDB(t, bdb)
+	pdb, err := NewPebbleDB("pebble", dir, 128)
+	require.Nil(t, err)
+	defer pdb.Close()
+	testListDB(t, pdb)
 }
 
 func testListDB(t *testing.T, db DB) {
@@ -55,6 +55,14 @@ func newGoBadgerDB(t *testing.T) (DB, string) {
 	return db, dir
 }
 
+func newPebbleDB(t *testing.T) (DB, string) {
+	dir, err := ioutil.TempDir("", "pebble")
+	assert.Nil(t, err)
+	db, err := NewPebbleDB("test", dir, 16)
+	assert.Nil(t, err)
+	return db, dir
+}
+
 func TestMergeIterSeek1(t *testing.T) {
 	db1 := newGoMemDB(t)
 	db1.Set([]byte("1"), []byte("1"))
@@ -328,6 +336,14 @@ func TestMergeIterList(t *testing.T) {
 	testMergeIterList(t, badgerDB, levelDB, newGoMemDB(t))
 	_ = os.RemoveAll(dir1)
 	_ = os.RemoveAll(dir2)
+	pebbleDB, dir := newPebbleDB(t)
+	testMergeIterList(t, newGoMemDB(t), newGoMemDB(t), pebbleDB)
+	_ = os.RemoveAll(dir)
+	levelDB, dir1 = newGoLevelDB(t)
+	pebbleDB, dir2 = newPebbleDB(t)
+	testMergeIterList(t, pebbleDB, levelDB, newGoMemDB(t))
+	_ = os.RemoveAll(dir1)
+	_ = os.RemoveAll(dir2)
 }
 
 func testMergeIterList(t *testing.T, db1, db2, db3 DB) {
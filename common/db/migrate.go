@@ -0,0 +1,90 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+)
+
+//迁移时每个batch写入的数据大小
+const migrateBatchSize = 4 * 1024 * 1024
+
+//Backends 返回已经注册的数据库类型
+func Backends() []string {
+	names := make([]string, 0, len(backends))
+	for name := range backends {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+//Migrate 把src中的所有数据复制到dst，progress不为nil时每写入一个batch回调已复制的key数量
+func Migrate(src, dst DB, progress func(count int64)) (int64, error) {
+	it := src.Iterator(nil, nil, false)
+	defer it.Close()
+	batch := dst.NewBatch(false)
+	var count int64
+	for it.Rewind(); it.Valid(); it.Next() {
+		batch.Set(cloneByte(it.Key()), it.ValueCopy())
+		count++
+		if batch.ValueSize() >= migrateBatchSize {
+			if err := batch.Write(); err != nil {
+				return count, err
+			}
+			batch.Reset()
+			if progress != nil {
+				progress(count)
+			}
+		}
+	}
+	if err := it.Error(); err != nil {
+		return count, err
+	}
+	batch.UpdateWriteSync(true)
+	if err := batch.Write(); err != nil {
+		return count, err
+	}
+	if progress != nil {
+		progress(count)
+	}
+	return count, nil
+}
+
+//Verify 按顺序比较src和dst中的所有数据，返回比较的key数量，数据不一致时返回错误
+func Verify(src, dst DB) (int64, error) {
+	sit := src.Iterator(nil, nil, false)
+	defer sit.Close()
+	dit := dst.Iterator(nil, nil, false)
+	defer dit.Close()
+	var count int64
+	sit.Rewind()
+	dit.Rewind()
+	for ; sit.Valid(); sit.Next() {
+		if !dit.Valid() {
+			return count, fmt.Errorf("key %x not found in dst", sit.Key())
+		}
+		if !bytes.Equal(sit.Key(), dit.Key()) {
+			return count, fmt.Errorf("key mismatch, src %x dst %x", sit.Key(), dit.Key())
+		}
+		if !bytes.Equal(sit.Value(), dit.Value()) {
+			return count, fmt.Errorf("value mismatch, key %x", sit.Key())
+		}
+		count++
+		dit.Next()
+	}
+	if err := sit.Error(); err != nil {
+		return count, err
+	}
+	if err := dit.Error(); err != nil {
+		return count, err
+	}
+	if dit.Valid() {
+		return count, fmt.Errorf("key %x not found in src", dit.Key())
+	}
+	return count, nil
+}
@@ -0,0 +1,70 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migrate")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	src, err := NewGoLevelDB("src", dir, 128)
+	require.NoError(t, err)
+	defer src.Close()
+	for i := 0; i < 1000; i++ {
+		require.NoError(t, src.Set([]byte(fmt.Sprintf("key%04d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+	require.NoError(t, src.Set([]byte{0xff, 0xff}, []byte("max")))
+
+	dst, err := NewGoBadgerDB("dst", dir, 128)
+	require.NoError(t, err)
+	defer dst.Close()
+	var progress int64
+	count, err := Migrate(src, dst, func(n int64) { progress = n })
+	require.NoError(t, err)
+	assert.Equal(t, int64(1001), count)
+	assert.Equal(t, count, progress)
+	value, err := dst.Get([]byte("key0999"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value999"), value)
+
+	count, err = Verify(src, dst)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1001), count)
+
+	//数据不一致
+	require.NoError(t, dst.Set([]byte("key0500"), []byte("modified")))
+	_, err = Verify(src, dst)
+	assert.NotNil(t, err)
+	require.NoError(t, dst.Delete([]byte("key0500")))
+	_, err = Verify(src, dst)
+	assert.NotNil(t, err)
+	require.NoError(t, dst.Set([]byte("key0500"), []byte("value500")))
+	require.NoError(t, dst.Set([]byte("key1000"), []byte("value1000")))
+	_, err = Verify(src, dst)
+	assert.NotNil(t, err)
+
+	assert.Contains(t, Backends(), "leveldb")
+	assert.Contains(t, Backends(), "pebble")
+
+	//leveldb迁移到pebble
+	pdb, err := NewPebbleDB("pebble", dir, 128)
+	require.NoError(t, err)
+	defer pdb.Close()
+	count, err = Migrate(src, pdb, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1001), count)
+	count, err = Verify(src, pdb)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1001), count)
+}
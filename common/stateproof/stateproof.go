@@ -0,0 +1,126 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package stateproof 验证mavl状态证明，不依赖数据库，供轻节点以及链外服务使用
+//
+// 调用方需要自行确认区块头的可信性(比如通过区块头同步和共识校验)，
+// 本包只负责验证状态数据与区块头中的stateHash一致
+package stateproof
+
+import (
+	"bytes"
+
+	"github.com/33cn/chain33/types"
+)
+
+var emptyRoot [32]byte
+
+// VerifyHeader 验证状态证明锚定在区块头的stateHash上，并逐个验证key的证明
+func VerifyHeader(header *types.Header, proof *types.StateProof) error {
+	if header == nil || proof == nil {
+		return types.ErrInvalidParam
+	}
+	if !bytes.Equal(header.GetStateHash(), proof.GetStateHash()) {
+		return types.ErrStateHashMismatch
+	}
+	return Verify(header.GetStateHash(), proof)
+}
+
+// Verify 验证状态证明中所有key的证明都对应stateHash
+func Verify(stateHash []byte, proof *types.StateProof) error {
+	if proof == nil {
+		return types.ErrInvalidParam
+	}
+	if !bytes.Equal(stateHash, proof.GetStateHash()) {
+		return types.ErrStateHashMismatch
+	}
+	for _, keyProof := range proof.GetProofs() {
+		if err := VerifyKey(stateHash, keyProof); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// VerifyKey 验证单个key的证明，key存在时验证key:value，不存在时验证相邻叶子节点
+func VerifyKey(stateHash []byte, proof *types.StateKeyProof) error {
+	if proof == nil {
+		return types.ErrInvalidStateProof
+	}
+	//空树中所有的key都不存在
+	if len(stateHash) == 0 || bytes.Equal(stateHash, emptyRoot[:]) {
+		if proof.Exists || proof.Left != nil || proof.Right != nil {
+			return types.ErrInvalidStateProof
+		}
+		return nil
+	}
+	if proof.Exists {
+		root, _, _ := leafRoot(proof.Key, proof.Value, proof.InnerNodes)
+		if !bytes.Equal(root, stateHash) {
+			return types.ErrInvalidStateProof
+		}
+		return nil
+	}
+	return verifyAbsence(stateHash, proof)
+}
+
+//verifyAbsence 叶子节点按key排序，key不存在时需要证明前后两个叶子节点在树中相邻，
+//且key位于两者之间；key位于所有叶子之前时后一个节点必须是第一个叶子，之后时前一个节点必须是最后一个叶子
+func verifyAbsence(stateHash []byte, proof *types.StateKeyProof) error {
+	left, right := proof.Left, proof.Right
+	if left == nil && right == nil {
+		return types.ErrInvalidStateProof
+	}
+	var leftIndex, rightIndex int32
+	if left != nil {
+		root, index, size := leafRoot(left.Key, left.Value, left.InnerNodes)
+		if !bytes.Equal(root, stateHash) || bytes.Compare(left.Key, proof.Key) >= 0 {
+			return types.ErrInvalidStateProof
+		}
+		if right == nil && index != size-1 {
+			return types.ErrInvalidStateProof
+		}
+		leftIndex = index
+	}
+	if right != nil {
+		root, index, _ := leafRoot(right.Key, right.Value, right.InnerNodes)
+		if !bytes.Equal(root, stateHash) || bytes.Compare(right.Key, proof.Key) <= 0 {
+			return types.ErrInvalidStateProof
+		}
+		if left == nil && index != 0 {
+			return types.ErrInvalidStateProof
+		}
+		rightIndex = index
+	}
+	if left != nil && right != nil && rightIndex != leftIndex+1 {
+		return types.ErrInvalidStateProof
+	}
+	return nil
+}
+
+//leafRoot 由叶子节点沿证明路径计算根hash，同时得到叶子在树中的序号以及树的叶子总数，
+//中间节点的size参与hash计算，所以根hash一致时序号是可信的
+func leafRoot(key, value []byte, branches []*types.InnerNode) (root []byte, index int32, size int32) {
+	leaf := types.LeafNode{Key: key, Value: value, Height: 0, Size: 1}
+	hash := leaf.Hash()
+	size = 1
+	for _, branch := range branches {
+		if branch == nil {
+			return nil, 0, 0
+		}
+		node := types.InnerNode{Height: branch.Height, Size: branch.Size}
+		if len(branch.LeftHash) == 0 {
+			node.LeftHash = hash
+			node.RightHash = branch.RightHash
+		} else {
+			//路径经过右子树，左子树的叶子都排在前面
+			node.LeftHash = branch.LeftHash
+			node.RightHash = hash
+			index += branch.Size - size
+		}
+		hash = node.Hash()
+		size = branch.Size
+	}
+	return hash, index, size
+}
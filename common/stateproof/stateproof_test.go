@@ -0,0 +1,107 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stateproof
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/33cn/chain33/common/db"
+	mavl "github.com/33cn/chain33/system/store/mavl/db"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyStateProof(t *testing.T) {
+	dir, err := ioutil.TempDir("", "stateproof")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	ldb := db.NewDB("mavltree", "leveldb", dir, 100)
+	defer ldb.Close()
+
+	tree := mavl.NewTree(ldb, true, nil)
+	for i := 1; i <= 20; i++ {
+		tree.Set([]byte(fmt.Sprintf("key%03d", i*10)), []byte(fmt.Sprintf("value%d", i)))
+	}
+	stateHash := tree.Save()
+	header := &types.Header{Height: 1, StateHash: stateHash}
+
+	keys := [][]byte{
+		[]byte("key010"), []byte("key200"), []byte("key105"),
+		[]byte("key000"), []byte("key999"), []byte("key050"),
+	}
+	proof, err := mavl.GetStateProof(ldb, &types.StoreGet{StateHash: stateHash, Keys: keys}, nil)
+	require.Nil(t, err)
+	require.Equal(t, len(keys), len(proof.Proofs))
+	require.Nil(t, VerifyHeader(header, proof))
+
+	require.True(t, proof.Proofs[0].Exists)
+	require.Equal(t, []byte("value1"), proof.Proofs[0].Value)
+	require.True(t, proof.Proofs[1].Exists)
+	require.False(t, proof.Proofs[2].Exists)
+	require.Equal(t, []byte("key100"), proof.Proofs[2].Left.Key)
+	require.Equal(t, []byte("key110"), proof.Proofs[2].Right.Key)
+	require.Nil(t, proof.Proofs[3].Left)
+	require.Equal(t, []byte("key010"), proof.Proofs[3].Right.Key)
+	require.Equal(t, []byte("key200"), proof.Proofs[4].Left.Key)
+	require.Nil(t, proof.Proofs[4].Right)
+
+	//区块头的stateHash不一致
+	require.Equal(t, types.ErrStateHashMismatch, VerifyHeader(&types.Header{StateHash: make([]byte, 32)}, proof))
+
+	//篡改数据
+	value := proof.Proofs[0].Value
+	proof.Proofs[0].Value = []byte("value2")
+	require.Equal(t, types.ErrInvalidStateProof, VerifyKey(stateHash, proof.Proofs[0]))
+	proof.Proofs[0].Value = value
+
+	//存在的key不能伪造为不存在
+	around, err := mavl.GetStateProof(ldb, &types.StoreGet{StateHash: stateHash, Keys: [][]byte{[]byte("key045"), []byte("key055")}}, nil)
+	require.Nil(t, err)
+	require.Nil(t, Verify(stateHash, around))
+	fake := &types.StateKeyProof{Key: []byte("key050"), Left: around.Proofs[0].Left, Right: around.Proofs[1].Right}
+	require.Equal(t, types.ErrInvalidStateProof, VerifyKey(stateHash, fake))
+	//相邻节点必须连续
+	fake = &types.StateKeyProof{Key: []byte("key105"), Left: proof.Proofs[4].Left, Right: proof.Proofs[2].Right}
+	require.Equal(t, types.ErrInvalidStateProof, VerifyKey(stateHash, fake))
+	fake = &types.StateKeyProof{Key: []byte("key105"), Left: proof.Proofs[3].Right, Right: proof.Proofs[2].Right}
+	require.Equal(t, types.ErrInvalidStateProof, VerifyKey(stateHash, fake))
+	//只有一侧相邻节点时必须是边界叶子
+	fake = &types.StateKeyProof{Key: []byte("key105"), Left: proof.Proofs[2].Left}
+	require.Equal(t, types.ErrInvalidStateProof, VerifyKey(stateHash, fake))
+	fake = &types.StateKeyProof{Key: []byte("key105"), Right: proof.Proofs[2].Right}
+	require.Equal(t, types.ErrInvalidStateProof, VerifyKey(stateHash, fake))
+	require.Equal(t, types.ErrInvalidStateProof, VerifyKey(stateHash, &types.StateKeyProof{Key: []byte("key105")}))
+}
+
+func TestVerifySmallTree(t *testing.T) {
+	dir, err := ioutil.TempDir("", "stateproof")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	ldb := db.NewDB("mavltree", "leveldb", dir, 100)
+	defer ldb.Close()
+
+	//空树
+	keys := [][]byte{[]byte("a")}
+	proof, err := mavl.GetStateProof(ldb, &types.StoreGet{StateHash: make([]byte, 32), Keys: keys}, nil)
+	require.Nil(t, err)
+	require.Nil(t, Verify(make([]byte, 32), proof))
+	require.False(t, proof.Proofs[0].Exists)
+
+	//只有一个叶子节点
+	tree := mavl.NewTree(ldb, true, nil)
+	tree.Set([]byte("b"), []byte("1"))
+	stateHash := tree.Save()
+	keys = [][]byte{[]byte("a"), []byte("b"), []byte("c")}
+	proof, err = mavl.GetStateProof(ldb, &types.StoreGet{StateHash: stateHash, Keys: keys}, nil)
+	require.Nil(t, err)
+	require.Nil(t, Verify(stateHash, proof))
+	require.False(t, proof.Proofs[0].Exists)
+	require.True(t, proof.Proofs[1].Exists)
+	require.False(t, proof.Proofs[2].Exists)
+	require.Equal(t, types.ErrStateHashMismatch, Verify(make([]byte, 32), proof))
+}
@@ -0,0 +1,220 @@
+package trace
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	log15 "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/types"
+)
+
+var log = log15.New("module", "trace")
+
+// Exporter span输出接口
+type Exporter interface {
+	ExportSpans(spans []*SpanData) error
+	Shutdown() error
+}
+
+// Init 根据配置开启追踪，未配置或者未使能时不做处理
+func Init(cfg *types.Trace) error {
+	if cfg == nil || !cfg.Enable {
+		return nil
+	}
+	var exporter Exporter
+	switch cfg.Exporter {
+	case "", "file":
+		file, err := NewFileExporter(cfg.File)
+		if err != nil {
+			return err
+		}
+		exporter = file
+	case "otlp":
+		exporter = NewOTLPExporter(cfg.Endpoint, cfg.ServiceName)
+	default:
+		return fmt.Errorf("trace exporter %s not supported", cfg.Exporter)
+	}
+	sampleRate := cfg.SampleRate
+	if sampleRate <= 0 {
+		sampleRate = 1
+	}
+	log.Info("Init", "exporter", cfg.Exporter, "file", cfg.File, "endpoint", cfg.Endpoint, "sampleRate", sampleRate)
+	Start(exporter, sampleRate)
+	return nil
+}
+
+type fileExporter struct {
+	mu   sync.Mutex
+	file *os.File
+	w    *bufio.Writer
+}
+
+// NewFileExporter 以每行一个json的格式追加输出到文件
+func NewFileExporter(path string) (Exporter, error) {
+	if path == "" {
+		path = "logs/trace.json"
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &fileExporter{file: file, w: bufio.NewWriter(file)}, nil
+}
+
+func (e *fileExporter) ExportSpans(spans []*SpanData) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	enc := json.NewEncoder(e.w)
+	for _, span := range spans {
+		if err := enc.Encode(span); err != nil {
+			return err
+		}
+	}
+	return e.w.Flush()
+}
+
+func (e *fileExporter) Shutdown() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if err := e.w.Flush(); err != nil {
+		return err
+	}
+	return e.file.Close()
+}
+
+// ReadSpans 读取文件输出的span
+func ReadSpans(r io.Reader) ([]*SpanData, error) {
+	var spans []*SpanData
+	dec := json.NewDecoder(r)
+	for {
+		span := &SpanData{}
+		err := dec.Decode(span)
+		if err == io.EOF {
+			return spans, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		spans = append(spans, span)
+	}
+}
+
+type otlpExporter struct {
+	endpoint string
+	service  string
+	client   *http.Client
+}
+
+// NewOTLPExporter 以OTLP/HTTP json格式输出到OpenTelemetry collector
+func NewOTLPExporter(endpoint, service string) Exporter {
+	if endpoint == "" {
+		endpoint = "http://localhost:4318/v1/traces"
+	}
+	if service == "" {
+		service = "chain33"
+	}
+	return &otlpExporter{endpoint: endpoint, service: service, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+type otlpValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceID      string          `json:"traceId"`
+	SpanID       string          `json:"spanId"`
+	ParentSpanID string          `json:"parentSpanId,omitempty"`
+	Name         string          `json:"name"`
+	Kind         int             `json:"kind"`
+	Start        string          `json:"startTimeUnixNano"`
+	End          string          `json:"endTimeUnixNano"`
+	Attributes   []otlpAttribute `json:"attributes,omitempty"`
+	Status       otlpStatus      `json:"status"`
+}
+
+type otlpScopeSpans struct {
+	Scope struct {
+		Name string `json:"name"`
+	} `json:"scope"`
+	Spans []*otlpSpan `json:"spans"`
+}
+
+type otlpResourceSpans struct {
+	Resource struct {
+		Attributes []otlpAttribute `json:"attributes"`
+	} `json:"resource"`
+	ScopeSpans []*otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpRequest struct {
+	ResourceSpans []*otlpResourceSpans `json:"resourceSpans"`
+}
+
+func (e *otlpExporter) ExportSpans(spans []*SpanData) error {
+	scope := &otlpScopeSpans{}
+	scope.Scope.Name = "github.com/33cn/chain33"
+	for _, span := range spans {
+		s := &otlpSpan{
+			TraceID:      span.TraceID,
+			SpanID:       span.SpanID,
+			ParentSpanID: span.ParentID,
+			Name:         span.Name,
+			//SPAN_KIND_INTERNAL
+			Kind:  1,
+			Start: strconv.FormatInt(span.Start, 10),
+			End:   strconv.FormatInt(span.End, 10),
+		}
+		for k, v := range span.Attributes {
+			s.Attributes = append(s.Attributes, otlpAttribute{Key: k, Value: otlpValue{StringValue: v}})
+		}
+		if span.Error != "" {
+			//STATUS_CODE_ERROR
+			s.Status = otlpStatus{Code: 2, Message: span.Error}
+		}
+		scope.Spans = append(scope.Spans, s)
+	}
+	resource := &otlpResourceSpans{ScopeSpans: []*otlpScopeSpans{scope}}
+	resource.Resource.Attributes = []otlpAttribute{{Key: "service.name", Value: otlpValue{StringValue: e.service}}}
+	body, err := json.Marshal(&otlpRequest{ResourceSpans: []*otlpResourceSpans{resource}})
+	if err != nil {
+		return err
+	}
+	resp, err := e.client.Post(e.endpoint, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return errors.New("otlp export: " + resp.Status + " " + string(msg))
+	}
+	return nil
+}
+
+func (e *otlpExporter) Shutdown() error {
+	return nil
+}
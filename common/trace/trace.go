@@ -0,0 +1,309 @@
+// Package trace 分布式追踪，span上下文兼容W3C traceparent格式，可以输出到文件或者OpenTelemetry collector
+package trace
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// TraceID 追踪id
+type TraceID [16]byte
+
+// SpanID span id
+type SpanID [8]byte
+
+// SpanContext 在模块和节点之间传递的span上下文
+type SpanContext struct {
+	TraceID TraceID
+	SpanID  SpanID
+	Sampled bool
+}
+
+// IsValid 是否为有效的上下文
+func (sc SpanContext) IsValid() bool {
+	return sc.TraceID != TraceID{} && sc.SpanID != SpanID{}
+}
+
+// Traceparent 编码为W3C traceparent格式，无效的上下文返回空字符串
+func (sc SpanContext) Traceparent() string {
+	if !sc.IsValid() {
+		return ""
+	}
+	flags := "00"
+	if sc.Sampled {
+		flags = "01"
+	}
+	return fmt.Sprintf("00-%s-%s-%s", hex.EncodeToString(sc.TraceID[:]), hex.EncodeToString(sc.SpanID[:]), flags)
+}
+
+// ParseTraceparent 解析W3C traceparent格式的上下文
+func ParseTraceparent(s string) (SpanContext, error) {
+	var sc SpanContext
+	parts := strings.Split(s, "-")
+	if len(parts) != 4 || parts[0] != "00" || len(parts[1]) != 32 || len(parts[2]) != 16 || len(parts[3]) != 2 {
+		return sc, errors.New("invalid traceparent")
+	}
+	if _, err := hex.Decode(sc.TraceID[:], []byte(parts[1])); err != nil {
+		return sc, err
+	}
+	if _, err := hex.Decode(sc.SpanID[:], []byte(parts[2])); err != nil {
+		return sc, err
+	}
+	flags, err := hex.DecodeString(parts[3])
+	if err != nil {
+		return sc, err
+	}
+	sc.Sampled = flags[0]&1 == 1
+	if !sc.IsValid() {
+		return sc, errors.New("invalid traceparent")
+	}
+	return sc, nil
+}
+
+//span的通用属性
+const (
+	AttrTxHash      = "tx.hash"
+	AttrBlockHeight = "block.height"
+	AttrExecer      = "execer"
+	AttrPeer        = "peer.id"
+)
+
+// SpanData 结束的span，也是文件输出的格式
+type SpanData struct {
+	Name       string            `json:"name"`
+	TraceID    string            `json:"traceId"`
+	SpanID     string            `json:"spanId"`
+	ParentID   string            `json:"parentSpanId,omitempty"`
+	Start      int64             `json:"startTimeUnixNano"`
+	End        int64             `json:"endTimeUnixNano"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+	Error      string            `json:"error,omitempty"`
+}
+
+// Span 一次操作的耗时记录，未开启追踪时为nil，所有方法都可以在nil上调用
+type Span struct {
+	ctx   SpanContext
+	mu    sync.Mutex
+	data  *SpanData
+	ended bool
+}
+
+// StartSpan 以parent为父span开始一个span，parent无效时开始一个新的追踪
+func StartSpan(name string, parent SpanContext) *Span {
+	t := getTracer()
+	if t == nil {
+		return nil
+	}
+	span := &Span{}
+	if parent.IsValid() {
+		span.ctx.TraceID = parent.TraceID
+		span.ctx.Sampled = parent.Sampled
+	} else {
+		span.ctx.TraceID = t.newTraceID()
+		span.ctx.Sampled = t.sample()
+	}
+	span.ctx.SpanID = t.newSpanID()
+	//未采样的span只传递上下文，不记录数据
+	if !span.ctx.Sampled {
+		return span
+	}
+	span.data = &SpanData{
+		Name:    name,
+		TraceID: hex.EncodeToString(span.ctx.TraceID[:]),
+		SpanID:  hex.EncodeToString(span.ctx.SpanID[:]),
+		Start:   time.Now().UnixNano(),
+	}
+	if parent.IsValid() {
+		span.data.ParentID = hex.EncodeToString(parent.SpanID[:])
+	}
+	return span
+}
+
+// Context 返回span上下文
+func (s *Span) Context() SpanContext {
+	if s == nil {
+		return SpanContext{}
+	}
+	return s.ctx
+}
+
+// IsRecording 是否记录span数据，未采样的span只传递上下文
+func (s *Span) IsRecording() bool {
+	return s != nil && s.data != nil
+}
+
+// SetAttribute 设置span属性
+func (s *Span) SetAttribute(key, value string) {
+	if s == nil || s.data == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.data.Attributes == nil {
+		s.data.Attributes = make(map[string]string)
+	}
+	s.data.Attributes[key] = value
+}
+
+// SetError 记录span执行错误
+func (s *Span) SetError(err error) {
+	if s == nil || s.data == nil || err == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data.Error = err.Error()
+}
+
+// End 结束span并输出，重复调用只输出一次
+func (s *Span) End() {
+	if s == nil || s.data == nil {
+		return
+	}
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		return
+	}
+	s.ended = true
+	s.data.End = time.Now().UnixNano()
+	s.mu.Unlock()
+	if t := getTracer(); t != nil {
+		t.export(s.data)
+	}
+}
+
+const (
+	exportBatchSize = 512
+	exportQueueSize = 8192
+	exportInterval  = time.Second
+)
+
+type tracer struct {
+	exporter   Exporter
+	sampleRate float64
+	spans      chan *SpanData
+	done       chan struct{}
+	wg         sync.WaitGroup
+	dropped    int64
+	mu         sync.Mutex
+	rand       *rand.Rand
+}
+
+var global atomic.Value
+
+func getTracer() *tracer {
+	t, _ := global.Load().(*tracer)
+	return t
+}
+
+// Start 开启追踪，span按sampleRate比例采样后由exporter批量输出
+func Start(exporter Exporter, sampleRate float64) {
+	t := &tracer{
+		exporter:   exporter,
+		sampleRate: sampleRate,
+		spans:      make(chan *SpanData, exportQueueSize),
+		done:       make(chan struct{}),
+		rand:       rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+	Stop()
+	t.wg.Add(1)
+	go t.run()
+	global.Store(t)
+}
+
+// Stop 停止追踪，输出剩余的span
+func Stop() {
+	t := getTracer()
+	if t == nil {
+		return
+	}
+	global.Store((*tracer)(nil))
+	close(t.done)
+	t.wg.Wait()
+	if err := t.exporter.Shutdown(); err != nil {
+		log.Error("Stop", "exporter shutdown err", err)
+	}
+}
+
+// Enabled 是否开启了追踪
+func Enabled() bool {
+	return getTracer() != nil
+}
+
+func (t *tracer) newTraceID() (id TraceID) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.rand.Read(id[:])
+	return
+}
+
+func (t *tracer) newSpanID() (id SpanID) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.rand.Read(id[:])
+	return
+}
+
+func (t *tracer) sample() bool {
+	if t.sampleRate >= 1 {
+		return true
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.rand.Float64() < t.sampleRate
+}
+
+//export 输出队列满时丢弃span，不阻塞业务流程
+func (t *tracer) export(data *SpanData) {
+	select {
+	case t.spans <- data:
+	default:
+		if atomic.AddInt64(&t.dropped, 1)%exportQueueSize == 1 {
+			log.Error("export", "span queue full, dropped", atomic.LoadInt64(&t.dropped))
+		}
+	}
+}
+
+func (t *tracer) run() {
+	defer t.wg.Done()
+	ticker := time.NewTicker(exportInterval)
+	defer ticker.Stop()
+	batch := make([]*SpanData, 0, exportBatchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := t.exporter.ExportSpans(batch); err != nil {
+			log.Error("run", "export spans err", err, "count", len(batch))
+		}
+		batch = make([]*SpanData, 0, exportBatchSize)
+	}
+	for {
+		select {
+		case data := <-t.spans:
+			batch = append(batch, data)
+			if len(batch) >= exportBatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-t.done:
+			for {
+				select {
+				case data := <-t.spans:
+					batch = append(batch, data)
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
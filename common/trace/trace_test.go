@@ -0,0 +1,151 @@
+package trace
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memExporter struct {
+	mu    sync.Mutex
+	spans []*SpanData
+}
+
+func (e *memExporter) ExportSpans(spans []*SpanData) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.spans = append(e.spans, spans...)
+	return nil
+}
+
+func (e *memExporter) Shutdown() error { return nil }
+
+func TestTraceparent(t *testing.T) {
+	sc := SpanContext{TraceID: TraceID{1, 2, 3}, SpanID: SpanID{4, 5}, Sampled: true}
+	s := sc.Traceparent()
+	assert.Equal(t, "00-01020300000000000000000000000000-0405000000000000-01", s)
+	parsed, err := ParseTraceparent(s)
+	assert.Nil(t, err)
+	assert.Equal(t, sc, parsed)
+
+	sc.Sampled = false
+	parsed, err = ParseTraceparent(sc.Traceparent())
+	assert.Nil(t, err)
+	assert.False(t, parsed.Sampled)
+
+	assert.Equal(t, "", SpanContext{}.Traceparent())
+	for _, s := range []string{"", "00-01-02-01", "01-01020300000000000000000000000000-0405000000000000-01",
+		"00-00000000000000000000000000000000-0405000000000000-01", "00-0102030000000000000000000000000x-0405000000000000-01"} {
+		_, err = ParseTraceparent(s)
+		assert.NotNil(t, err, s)
+	}
+}
+
+func TestSpan(t *testing.T) {
+	//未开启追踪时span为nil
+	assert.False(t, Enabled())
+	span := StartSpan("disabled", SpanContext{})
+	assert.Nil(t, span)
+	assert.False(t, span.Context().IsValid())
+	span.SetAttribute("k", "v")
+	span.End()
+
+	exporter := &memExporter{}
+	Start(exporter, 1)
+	assert.True(t, Enabled())
+	root := StartSpan("root", SpanContext{})
+	root.SetAttribute(AttrTxHash, "0x01")
+	child := StartSpan("child", root.Context())
+	child.SetError(types.ErrNotFound)
+	child.End()
+	child.End()
+	root.End()
+	Stop()
+	assert.False(t, Enabled())
+
+	require.Equal(t, 2, len(exporter.spans))
+	c, r := exporter.spans[0], exporter.spans[1]
+	assert.Equal(t, "child", c.Name)
+	assert.Equal(t, r.TraceID, c.TraceID)
+	assert.Equal(t, r.SpanID, c.ParentID)
+	assert.Equal(t, "ErrNotFound", c.Error)
+	assert.Equal(t, "", r.ParentID)
+	assert.Equal(t, "0x01", r.Attributes[AttrTxHash])
+	assert.True(t, r.End >= r.Start)
+
+	//未采样的追踪只传递上下文
+	exporter = &memExporter{}
+	Start(exporter, 0.000001)
+	unsampled := StartSpan("root", SpanContext{})
+	for unsampled.Context().Sampled {
+		unsampled = StartSpan("root", SpanContext{})
+	}
+	assert.False(t, unsampled.IsRecording())
+	child = StartSpan("child", unsampled.Context())
+	assert.Equal(t, unsampled.Context().TraceID, child.Context().TraceID)
+	assert.False(t, child.IsRecording())
+	child.End()
+	unsampled.End()
+	Stop()
+	assert.Equal(t, 0, len(exporter.spans))
+}
+
+func TestFileExporter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "trace")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "logs", "trace.json")
+	require.Nil(t, Init(&types.Trace{Enable: true, Exporter: "file", File: path}))
+	span := StartSpan("root", SpanContext{})
+	StartSpan("child", span.Context()).End()
+	span.End()
+	Stop()
+
+	f, err := os.Open(path)
+	require.Nil(t, err)
+	defer f.Close()
+	spans, err := ReadSpans(f)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(spans))
+	assert.Equal(t, "child", spans[0].Name)
+	assert.Equal(t, spans[1].SpanID, spans[0].ParentID)
+
+	assert.Nil(t, Init(&types.Trace{}))
+	assert.False(t, Enabled())
+	assert.NotNil(t, Init(&types.Trace{Enable: true, Exporter: "jaeger"}))
+}
+
+func TestOTLPExporter(t *testing.T) {
+	var req otlpRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&req))
+	}))
+	defer server.Close()
+
+	exporter := NewOTLPExporter(server.URL, "")
+	err := exporter.ExportSpans([]*SpanData{{Name: "exec coins", TraceID: "01", SpanID: "02", ParentID: "03", Start: 1, End: 2,
+		Attributes: map[string]string{AttrTxHash: "0x01"}, Error: "ErrNoBalance"}})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(req.ResourceSpans))
+	rs := req.ResourceSpans[0]
+	assert.Equal(t, "chain33", rs.Resource.Attributes[0].Value.StringValue)
+	span := rs.ScopeSpans[0].Spans[0]
+	assert.Equal(t, "exec coins", span.Name)
+	assert.Equal(t, "03", span.ParentSpanID)
+	assert.Equal(t, "1", span.Start)
+	assert.Equal(t, AttrTxHash, span.Attributes[0].Key)
+	assert.Equal(t, 2, span.Status.Code)
+
+	server.Close()
+	assert.NotNil(t, exporter.ExportSpans([]*SpanData{{Name: "root"}}))
+}
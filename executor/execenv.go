@@ -6,6 +6,7 @@ package executor
 
 import (
 	"bytes"
+	"time"
 
 	"github.com/33cn/chain33/account"
 	"github.com/33cn/chain33/client"
@@ -13,6 +14,7 @@ import (
 	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/address"
 	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/common/trace"
 	drivers "github.com/33cn/chain33/system/dapp"
 	"github.com/33cn/chain33/types"
 	"github.com/golang/protobuf/proto"
@@ -51,6 +53,9 @@ type executorCtx struct {
 	parentHash []byte
 	mainHash   []byte
 	mainHeight int64
+	baseFee    int64
+	//交易执行span的父span
+	trace trace.SpanContext
 }
 
 func newExecutor(ctx *executorCtx, exec *Executor, localdb dbm.KVDB, txs []*types.Transaction, receipts []*types.ReceiptData) *executor {
@@ -112,20 +117,56 @@ func DelMVCC(db dbm.KVDB, detail *types.BlockDetail) (kvlist []*types.KeyValue)
 //隐私交易费扣除规则：
 //1.公对私交易：直接从coin合约中扣除
 //2.私对私交易或者私对公交易：交易费的扣除从隐私合约账户在coin合约中的账户中扣除
+//开启基础手续费之后，手续费中基础手续费的部分燃烧，燃烧和扣费合并为一条手续费日志，收据格式保持不变
 func (e *executor) processFee(tx *types.Transaction) (*types.Receipt, error) {
 	from := tx.From()
 	accFrom := e.coinsAccount.LoadAccount(from)
 	if accFrom.GetBalance()-tx.Fee >= 0 {
 		copyfrom := types.CloneAccount(accFrom)
-		accFrom.Balance = accFrom.GetBalance() - tx.Fee
+		burnFee := e.getBurnFee(tx)
+		var burnReceipt *types.Receipt
+		if burnFee > 0 {
+			var err error
+			if burnReceipt, err = e.coinsAccount.Burn(from, burnFee); err != nil {
+				return nil, err
+			}
+			accFrom = e.coinsAccount.LoadAccount(from)
+		}
+		accFrom.Balance = accFrom.GetBalance() - (tx.Fee - burnFee)
 		receiptBalance := &types.ReceiptAccountTransfer{Prev: copyfrom, Current: accFrom}
 		set := e.coinsAccount.GetKVSet(accFrom)
 		e.coinsAccount.SaveKVSet(set)
-		return e.cutFeeReceipt(set, receiptBalance), nil
+		receipt := e.cutFeeReceipt(set, receiptBalance)
+		//手续费日志记录扣除的全部手续费，燃烧的部分另外记录燃烧日志，燃烧的kv在前，被扣费后的kv覆盖
+		if burnReceipt != nil {
+			receipt.KV = append(burnReceipt.KV, receipt.KV...)
+			receipt.Logs = append(receipt.Logs, burnReceipt.Logs...)
+		}
+		return receipt, nil
 	}
 	return nil, types.ErrNoBalance
 }
 
+//getBurnFee 按区块的基础手续费费率计算需要燃烧的手续费
+func (e *executor) getBurnFee(tx *types.Transaction) int64 {
+	if e.ctx.baseFee <= 0 {
+		return 0
+	}
+	burnFee, err := tx.GetRealFee(e.ctx.baseFee)
+	if err != nil || burnFee > tx.Fee {
+		return tx.Fee
+	}
+	return burnFee
+}
+
+//getMinTxFeeRate 开启基础手续费之后，交易的最低手续费费率不低于区块的基础手续费
+func (e *executor) getMinTxFeeRate() int64 {
+	if e.ctx.baseFee > e.cfg.GetMinTxFeeRate() {
+		return e.ctx.baseFee
+	}
+	return e.cfg.GetMinTxFeeRate()
+}
+
 func (e *executor) cutFeeReceipt(kvset []*types.KeyValue, receiptBalance proto.Message) *types.Receipt {
 	feelog := &types.ReceiptLog{Ty: types.TyLogFee, Log: types.Encode(receiptBalance)}
 	return &types.Receipt{
@@ -157,7 +198,7 @@ func (e *executor) checkTx(tx *types.Transaction, index int) error {
 		//如果已经过期
 		return types.ErrTxExpire
 	}
-	if err := tx.Check(e.cfg, e.height, e.cfg.GetMinTxFeeRate(), e.cfg.GetMaxTxFee()); err != nil {
+	if err := tx.Check(e.cfg, e.height, e.getMinTxFeeRate(), e.cfg.GetMaxTxFee()); err != nil {
 		return err
 	}
 	//允许重写的情况
@@ -189,7 +230,7 @@ func (e *executor) checkTxGroup(txgroup *types.Transactions, index int) error {
 		//如果已经过期
 		return types.ErrTxExpire
 	}
-	if err := txgroup.Check(e.cfg, e.height, e.cfg.GetMinTxFeeRate(), e.cfg.GetMaxTxFee()); err != nil {
+	if err := txgroup.Check(e.cfg, e.height, e.getMinTxFeeRate(), e.cfg.GetMaxTxFee()); err != nil {
 		return err
 	}
 	return nil
@@ -248,6 +289,19 @@ func (e *executor) Exec(tx *types.Transaction, index int) (receipt *types.Receip
 	}()
 
 	exec := e.loadDriver(tx, index)
+	//按执行器统计交易执行耗时
+	if timer := e.exec.execTimer(exec.GetDriverName()); timer != nil {
+		defer timer.UpdateSince(time.Now())
+	}
+	span := trace.StartSpan("exec "+exec.GetDriverName(), e.ctx.trace)
+	if span.IsRecording() {
+		span.SetAttribute(trace.AttrTxHash, common.ToHex(tx.Hash()))
+		span.SetAttribute(trace.AttrExecer, string(tx.Execer))
+	}
+	defer func() {
+		span.SetError(err)
+		span.End()
+	}()
 	//to 必须是一个地址
 	if err := drivers.CheckAddress(e.cfg, tx.GetRealToAddr(), e.height); err != nil {
 		return nil, err
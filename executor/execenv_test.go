@@ -12,10 +12,14 @@ import (
 
 	"strings"
 
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/store"
 	_ "github.com/33cn/chain33/system"
 	"github.com/33cn/chain33/types"
 	"github.com/33cn/chain33/util"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestLoadDriverFork(t *testing.T) {
@@ -97,3 +101,64 @@ func (app *notAllowApp) Allow(tx *types.Transaction, index int) error {
 	}
 	return types.ErrActionNotSupport
 }
+
+func TestProcessBaseFee(t *testing.T) {
+	exec, q := initEnv(types.GetDefaultCfgstring())
+	exec.disableLocal = true
+	cfg := exec.client.GetConfig()
+	execInit(cfg)
+	s := store.New(cfg)
+	s.SetQueueClient(q.Client())
+	defer s.Close()
+
+	genkey := util.TestPrivkeyList[0]
+	genaddr := address.PubKeyToAddr(address.DefaultID, genkey.PubKey().Bytes())
+	addr, _ := util.Genaddress()
+	coins := account.NewCoinsAccount(cfg)
+	kvs := coins.GetKVSet(&types.Account{Addr: genaddr, Balance: 100 * types.DefaultCoinPrecision})
+	stateHash, err := util.ExecKVMemSet(exec.client, nil, 0, kvs, true, false)
+	require.Nil(t, err)
+	require.Nil(t, util.ExecKVSetCommit(exec.client, stateHash, false))
+
+	minFee := cfg.GetMinTxFeeRate()
+	ctx := &executorCtx{
+		stateHash:  stateHash,
+		height:     1,
+		blocktime:  time.Now().Unix(),
+		difficulty: 1,
+		baseFee:    2 * minFee,
+	}
+	//手续费低于基础手续费
+	tx := util.CreateCoinsTx(cfg, genkey, addr, types.DefaultCoinPrecision)
+	e := newExecutor(ctx, exec, nil, []*types.Transaction{tx}, nil)
+	_, err = e.execTx(exec, tx, 0)
+	require.Equal(t, types.ErrTxFeeTooLow, err)
+
+	tx.Fee = 5 * minFee
+	tx.Sign(types.SECP256K1, genkey)
+	require.Equal(t, 2*minFee, e.getBurnFee(tx))
+	receipt, err := e.execTx(exec, tx, 0)
+	require.Nil(t, err)
+	require.Equal(t, int32(types.ExecOk), receipt.Ty)
+	//手续费日志记录扣除的全部手续费，燃烧的手续费记录燃烧日志
+	require.Equal(t, int32(types.TyLogFee), receipt.Logs[0].Ty)
+	var feeLog types.ReceiptAccountTransfer
+	require.Nil(t, types.Decode(receipt.Logs[0].Log, &feeLog))
+	require.Equal(t, tx.Fee, feeLog.Prev.Balance-feeLog.Current.Balance)
+	require.Equal(t, int32(types.TyLogBurn), receipt.Logs[1].Ty)
+	var burnLog types.ReceiptAccountBurn
+	require.Nil(t, types.Decode(receipt.Logs[1].Log, &burnLog))
+	require.Equal(t, 2*minFee, burnLog.Prev.Balance-burnLog.Current.Balance)
+	require.Equal(t, int32(types.TyLogTransfer), receipt.Logs[2].Ty)
+}
+
+func TestExecTimer(t *testing.T) {
+	exec := &Executor{}
+	require.Nil(t, exec.execTimer("coins"))
+	//开启metrics时按执行器缓存timer
+	exec.enableMetrics = true
+	timer := exec.execTimer("coins")
+	require.NotNil(t, timer)
+	require.True(t, timer == exec.execTimer("coins"))
+	require.False(t, timer == exec.execTimer("none"))
+}
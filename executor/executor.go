@@ -16,9 +16,11 @@ import (
 	dbm "github.com/33cn/chain33/common/db"
 	clog "github.com/33cn/chain33/common/log"
 	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/metrics"
 	"github.com/33cn/chain33/pluginmgr"
 	"github.com/33cn/chain33/rpc/grpcclient"
 	drivers "github.com/33cn/chain33/system/dapp"
+	go_metrics "github.com/rcrowley/go-metrics"
 
 	// register drivers
 	"github.com/33cn/chain33/client"
@@ -49,6 +51,22 @@ type Executor struct {
 	pluginEnable     map[string]bool
 	alias            map[string]string
 	noneDriverPool   *sync.Pool
+	enableMetrics    bool
+	//执行器名称 --> 交易执行耗时的timer
+	execTimers sync.Map
+}
+
+//execTimer 按执行器名称获取交易执行耗时的timer，没有开启metrics时返回nil
+func (exec *Executor) execTimer(name string) go_metrics.Timer {
+	if exec == nil || !exec.enableMetrics {
+		return nil
+	}
+	if timer, ok := exec.execTimers.Load(name); ok {
+		return timer.(go_metrics.Timer)
+	}
+	timer := go_metrics.GetOrRegisterTimer(metrics.Name("executor_exec_seconds", "execer", name), nil)
+	exec.execTimers.Store(name, timer)
+	return timer
 }
 
 func execInit(cfg *typ.Chain33Config) {
@@ -67,6 +85,7 @@ func New(cfg *typ.Chain33Config) *Executor {
 	exec := &Executor{}
 	exec.pluginEnable = make(map[string]bool)
 	exec.disableExecLocal = mcfg.DisableExecLocal
+	exec.enableMetrics = metrics.Enabled(cfg)
 	exec.pluginEnable["stat"] = mcfg.EnableStat
 	exec.pluginEnable["mvcc"] = mcfg.EnableMVCC
 	exec.pluginEnable["addrindex"] = !mcfg.DisableAddrIndex
@@ -260,6 +279,7 @@ func (exec *Executor) procExecCheckTx(msg *queue.Message) {
 		mainHash:   datas.MainHash,
 		mainHeight: datas.MainHeight,
 		parentHash: datas.ParentHash,
+		baseFee:    datas.BaseFee,
 	}
 	var localdb dbm.KVDB
 
@@ -313,6 +333,8 @@ func (exec *Executor) procExecTxList(msg *queue.Message) {
 		mainHash:   datas.MainHash,
 		mainHeight: datas.MainHeight,
 		parentHash: datas.ParentHash,
+		baseFee:    datas.BaseFee,
+		trace:      msg.Trace,
 	}
 	var localdb dbm.KVDB
 	if !exec.disableLocal {
@@ -321,6 +343,12 @@ func (exec *Executor) procExecTxList(msg *queue.Message) {
 	}
 	execute := newExecutor(ctx, exec, localdb, datas.Txs, nil)
 	execute.enableMVCC(nil)
+	//并行执行的结果，按区块顺序确认，冲突的交易重新执行
+	var parallel []*parallelResult
+	if execute.isParallelExec(datas.Txs) {
+		execute.stateDB.(*StateDB).enableRWSet()
+		parallel = execute.execTxsParallel(datas.Txs)
+	}
 	var receipts []*types.Receipt
 	index := 0
 	for i := 0; i < len(datas.Txs); i++ {
@@ -331,7 +359,13 @@ func (exec *Executor) procExecTxList(msg *queue.Message) {
 			continue
 		}
 		if tx.GroupCount == 0 {
-			receipt, err := execute.execTx(exec, tx, index)
+			var receipt *types.Receipt
+			var err error
+			if parallel != nil && execute.acceptParallel(parallel[i], index) {
+				receipt, err = parallel[i].receipt, parallel[i].err
+			} else {
+				receipt, err = execute.execTx(exec, tx, index)
+			}
 			if api.IsAPIEnvError(err) {
 				msg.Reply(exec.client.NewMessage("", types.EventReceipts, err))
 				return
@@ -396,6 +430,7 @@ func (exec *Executor) procExecAddBlock(msg *queue.Message) {
 		mainHash:   b.MainHash,
 		mainHeight: b.MainHeight,
 		parentHash: b.ParentHash,
+		baseFee:    b.BaseFee,
 	}
 	var localdb dbm.KVDB
 	if !exec.disableLocal {
@@ -475,6 +510,7 @@ func (exec *Executor) procExecDelBlock(msg *queue.Message) {
 		mainHash:   b.MainHash,
 		mainHeight: b.MainHeight,
 		parentHash: b.ParentHash,
+		baseFee:    b.BaseFee,
 	}
 	var localdb dbm.KVDB
 	if !exec.disableLocal {
@@ -418,10 +418,11 @@ func TestExecLocalSameTime1(t *testing.T) {
 	for i, receipt := range detail.Receipts {
 		assert.Equal(t, receipt.GetTy(), int32(2), fmt.Sprint(i))
 	}
+	//前两条日志为手续费日志和燃烧手续费日志
 	receipt1 := detail.Receipts[1]
-	assert.Equal(t, receipt1.Logs[1].Log, types.Encode(&types.Int64{Data: 1}))
+	assert.Equal(t, receipt1.Logs[2].Log, types.Encode(&types.Int64{Data: 1}))
 	receipt2 := detail.Receipts[2]
-	assert.Equal(t, receipt2.Logs[1].Log, types.Encode(&types.Int64{Data: 2}))
+	assert.Equal(t, receipt2.Logs[2].Log, types.Encode(&types.Int64{Data: 2}))
 }
 
 func TestExecLocalSameTime0(t *testing.T) {
@@ -447,9 +448,9 @@ func TestExecLocalSameTime0(t *testing.T) {
 	}
 	assert.Equal(t, detail.Receipts[0].GetTy(), int32(2))
 	receipt1 := detail.Receipts[1]
-	assert.Equal(t, receipt1.Logs[1].Log, []byte(types.ErrDisableRead.Error()))
+	assert.Equal(t, receipt1.Logs[2].Log, []byte(types.ErrDisableRead.Error()))
 	receipt2 := detail.Receipts[2]
-	assert.Equal(t, receipt2.Logs[1].Log, []byte(types.ErrDisableRead.Error()))
+	assert.Equal(t, receipt2.Logs[2].Log, []byte(types.ErrDisableRead.Error()))
 }
 
 var seterrkey = false
@@ -482,8 +483,9 @@ func TestExecLocalSameTimeSetErrKey(t *testing.T) {
 		}
 		if i >= 1 {
 			assert.Equal(t, receipt.GetTy(), int32(1))
-			assert.Equal(t, len(receipt.Logs), 2)
-			assert.Equal(t, receipt.Logs[1].Ty, int32(1))
+			assert.Equal(t, len(receipt.Logs), 3)
+			assert.Equal(t, receipt.Logs[1].Ty, int32(types.TyLogBurn))
+			assert.Equal(t, receipt.Logs[2].Ty, int32(1))
 		}
 	}
 }
@@ -0,0 +1,116 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/33cn/chain33/client/api"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+)
+
+//乐观并行执行：
+//1. 区块中可以并行的单笔交易，各自在父区块状态的快照上并发执行，同时记录StateDB读写的key
+//2. 按区块顺序依次确认执行结果，交易读取的key没有被前面的交易修改过，并且交易的index和预期一致时，
+//   直接采用并行执行的结果，否则在主StateDB上重新执行
+//交易读取的状态和串行执行时完全一致，所以执行结果和串行执行相同
+
+//只有执行结果只依赖StateDB的执行器才能并行执行，ExecLocalSameTime的执行器在执行时会读写localdb，不能并行
+var parallelExecers = map[string]bool{
+	types.NoneX:            true,
+	types.DefaultCoinsExec: true,
+}
+
+// RegisterParallelExecer 注册可以并行执行的执行器，执行器的执行结果只能依赖StateDB中的数据
+func RegisterParallelExecer(name string) {
+	if _, ok := parallelExecers[name]; ok {
+		panic("parallel execer exist " + name)
+	}
+	parallelExecers[name] = true
+}
+
+//parallelResult 交易在快照上的执行结果
+type parallelResult struct {
+	index   int
+	receipt *types.Receipt
+	err     error
+	rwset   *rwSet
+}
+
+//isParallelExec 并行执行依赖执行期间禁止读写localdb以及StateDB的事务回滚
+func (e *executor) isParallelExec(txs []*types.Transaction) bool {
+	return len(txs) > 1 && e.height > 0 && !e.cfg.IsPara() &&
+		e.cfg.IsFork(e.height, "ForkParallelExec") &&
+		e.cfg.IsFork(e.height, "ForkLocalDBAccess") &&
+		e.cfg.IsFork(e.height, "ForkExecRollback")
+}
+
+//execTxsParallel 并发执行可以并行的交易，返回的结果和交易一一对应，不能并行的交易结果为nil
+func (e *executor) execTxsParallel(txs []*types.Transaction) []*parallelResult {
+	results := make([]*parallelResult, len(txs))
+	//交易的index按前面的交易全部执行成功计算
+	jobs := make(chan int, len(txs))
+	for i, tx := range txs {
+		if tx.GroupCount == 0 && parallelExecers[types.Bytes2Str(tx.Execer)] {
+			jobs <- i
+		}
+	}
+	close(jobs)
+	workers := runtime.NumCPU()
+	if workers > len(jobs) {
+		workers = len(jobs)
+	}
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var localdb dbm.KVDB
+			if !e.exec.disableLocal {
+				localdb = NewLocalDB(e.exec.client, e.exec.qclient, true)
+				defer localdb.(*LocalDB).Close()
+			}
+			for i := range jobs {
+				results[i] = e.execTxSnapshot(localdb, txs[i], i)
+			}
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+//execTxSnapshot 在父区块状态的快照上执行交易，执行出现panic时返回nil，由主流程重新执行
+func (e *executor) execTxSnapshot(localdb dbm.KVDB, tx *types.Transaction, index int) (result *parallelResult) {
+	defer func() {
+		if r := recover(); r != nil {
+			elog.Error("execTxSnapshot panic", "index", index, "err", r)
+			result = nil
+		}
+	}()
+	snapshot := newExecutor(e.ctx, e.exec, localdb, e.txs, e.receipts)
+	snapshot.enableMVCC(nil)
+	if snapshot.isExecLocalSameTime(tx, index) {
+		return nil
+	}
+	state := snapshot.stateDB.(*StateDB)
+	state.enableRWSet()
+	receipt, err := snapshot.execTx(e.exec, tx, index)
+	return &parallelResult{index: index, receipt: receipt, err: err, rwset: state.rwset}
+}
+
+//acceptParallel 检查并行执行的结果是否和串行执行一致，一致时把写入合并到主StateDB
+func (e *executor) acceptParallel(result *parallelResult, index int) bool {
+	if result == nil || result.index != index || api.IsAPIEnvError(result.err) {
+		return false
+	}
+	state := e.stateDB.(*StateDB)
+	if result.rwset.readConflict(state.rwset.writes) {
+		return false
+	}
+	state.applyWrites(result.rwset.writes)
+	return true
+}
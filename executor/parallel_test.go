@@ -0,0 +1,115 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/store"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParallelExec(t *testing.T) {
+	exec, q := initEnv(types.GetDefaultCfgstring())
+	exec.disableLocal = true
+	cfg := exec.client.GetConfig()
+	execInit(cfg)
+	s := store.New(cfg)
+	s.SetQueueClient(q.Client())
+	defer s.Close()
+
+	genkey := util.TestPrivkeyList[0]
+	genaddr := address.PubKeyToAddr(address.DefaultID, genkey.PubKey().Bytes())
+	addr2, priv2 := util.Genaddress()
+	addr3, priv3 := util.Genaddress()
+	addr4, priv4 := util.Genaddress()
+	addr5, priv5 := util.Genaddress()
+	_, priv6 := util.Genaddress()
+	addr7, _ := util.Genaddress()
+	addr8, _ := util.Genaddress()
+	coins := account.NewCoinsAccount(cfg)
+	var kvs []*types.KeyValue
+	for _, addr := range []string{genaddr, addr2, addr3, addr4, addr5} {
+		kvs = append(kvs, coins.GetKVSet(&types.Account{Addr: addr, Balance: 100 * types.DefaultCoinPrecision})...)
+	}
+	stateHash, err := util.ExecKVMemSet(exec.client, nil, 0, kvs, true, false)
+	require.Nil(t, err)
+	require.Nil(t, util.ExecKVSetCommit(exec.client, stateHash, false))
+
+	txs := []*types.Transaction{
+		util.CreateCoinsTx(cfg, genkey, addr2, types.DefaultCoinPrecision),
+		//同一个账户的交易冲突
+		util.CreateCoinsTx(cfg, genkey, addr7, types.DefaultCoinPrecision),
+		//读取前面交易修改的账户
+		util.CreateCoinsTx(cfg, priv2, addr7, types.DefaultCoinPrecision),
+		//余额不足，只扣除手续费
+		util.CreateCoinsTx(cfg, priv3, addr8, 200*types.DefaultCoinPrecision),
+		util.CreateNoneTx(cfg, priv4),
+		//手续费不足，交易执行失败，后面交易的index改变
+		util.CreateCoinsTx(cfg, priv6, addr7, types.DefaultCoinPrecision),
+		util.CreateCoinsTx(cfg, priv5, addr7, types.DefaultCoinPrecision),
+	}
+	ctx := &executorCtx{
+		stateHash:  stateHash,
+		height:     1,
+		blocktime:  time.Now().Unix(),
+		difficulty: 1,
+	}
+
+	//串行执行的结果
+	serial := newExecutor(ctx, exec, nil, txs, nil)
+	var expect []*types.Receipt
+	index := 0
+	for _, tx := range txs {
+		receipt, err := serial.execTx(exec, tx, index)
+		if err != nil {
+			expect = append(expect, types.NewErrReceipt(err))
+			continue
+		}
+		expect = append(expect, receipt)
+		index++
+	}
+
+	parallel := newExecutor(ctx, exec, nil, txs, nil)
+	require.True(t, parallel.isParallelExec(txs))
+	results := parallel.execTxsParallel(txs)
+	parallel.stateDB.(*StateDB).enableRWSet()
+	require.True(t, parallel.acceptParallel(results[0], 0))
+	require.False(t, parallel.acceptParallel(results[1], 1))
+	_, err = parallel.execTx(exec, txs[1], 1)
+	require.Nil(t, err)
+	require.False(t, parallel.acceptParallel(results[2], 2))
+	_, err = parallel.execTx(exec, txs[2], 2)
+	require.Nil(t, err)
+	require.True(t, parallel.acceptParallel(results[3], 3))
+	require.True(t, parallel.acceptParallel(results[4], 4))
+	require.True(t, parallel.acceptParallel(results[5], 5))
+	require.Equal(t, types.ErrNoBalance, results[5].err)
+	require.False(t, parallel.acceptParallel(results[6], 5))
+
+	msg := queue.NewMessage(0, "", 1, &types.ExecTxList{
+		StateHash:  ctx.stateHash,
+		Height:     ctx.height,
+		BlockTime:  ctx.blocktime,
+		Difficulty: ctx.difficulty,
+		Txs:        txs,
+	})
+	exec.procExecTxList(msg)
+	resp, err := exec.client.WaitTimeout(msg, 10*time.Second)
+	require.Nil(t, err)
+	receipts := resp.GetData().(*types.Receipts).Receipts
+	require.Equal(t, len(expect), len(receipts))
+	for i := range expect {
+		require.Equal(t, types.Encode(expect[i]), types.Encode(receipts[i]))
+	}
+	require.Equal(t, int32(types.ExecPack), receipts[3].Ty)
+	require.Equal(t, int32(types.ExecErr), receipts[5].Ty)
+}
@@ -24,6 +24,39 @@ type StateDB struct {
 	height    int64
 	local     *db.SimpleMVCC
 	opt       *StateDBOption
+	//并行执行时记录读写的key，用于冲突检测
+	rwset *rwSet
+}
+
+//rwSet 记录读取过的key，以及最终写入的key value(回滚的写入不记录)
+type rwSet struct {
+	reads  map[string]struct{}
+	writes map[string][]byte
+}
+
+func newRWSet() *rwSet {
+	return &rwSet{
+		reads:  make(map[string]struct{}),
+		writes: make(map[string][]byte),
+	}
+}
+
+//readConflict 读取过的key是否被writes中的写入修改过
+func (rw *rwSet) readConflict(writes map[string][]byte) bool {
+	if len(rw.reads) > len(writes) {
+		for key := range writes {
+			if _, ok := rw.reads[key]; ok {
+				return true
+			}
+		}
+		return false
+	}
+	for key := range rw.reads {
+		if _, ok := writes[key]; ok {
+			return true
+		}
+	}
+	return false
 }
 
 // StateDBOption state db option enable mvcc
@@ -69,6 +102,21 @@ func (s *StateDB) enableMVCC(hash []byte) {
 	}
 }
 
+//enableRWSet 开启读写集合的记录
+func (s *StateDB) enableRWSet() {
+	s.rwset = newRWSet()
+}
+
+//applyWrites 合并其他StateDB的写入结果
+func (s *StateDB) applyWrites(writes map[string][]byte) {
+	for key, value := range writes {
+		s.cache.data[key] = value
+		if s.rwset != nil {
+			s.rwset.writes[key] = value
+		}
+	}
+}
+
 // Begin 开启内存事务处理
 func (s *StateDB) Begin() {
 	s.intx = true
@@ -94,6 +142,11 @@ func (s *StateDB) ResetCache() {
 // Commit canche tx
 func (s *StateDB) Commit() error {
 	s.cache.Merge(s.txcache)
+	if s.rwset != nil {
+		for key, value := range s.txcache.data {
+			s.rwset.writes[key] = value
+		}
+	}
 	s.intx = false
 	s.keys = s.keys[:0]
 	types.AssertConfig(s.client)
@@ -118,7 +171,9 @@ func (s *StateDB) Get(key []byte) ([]byte, error) {
 }
 
 func (s *StateDB) get(key []byte) ([]byte, error) {
-
+	if s.rwset != nil {
+		s.rwset.reads[string(key)] = struct{}{}
+	}
 	if s.intx {
 		if value, exist, err := s.txcache.Get(key); exist {
 			return value, err
@@ -192,6 +247,9 @@ func (s *StateDB) Set(key []byte, value []byte) error {
 		s.txcache.Set(key, value)
 	} else {
 		s.cache.Set(key, value)
+		if s.rwset != nil {
+			s.rwset.writes[string(key)] = value
+		}
 	}
 	return nil
 }
@@ -1,10 +1,14 @@
 package metrics
 
 import (
+	"net/http"
+	"sort"
+	"strings"
 	"time"
 
 	chain33log "github.com/33cn/chain33/common/log/log15"
 	"github.com/33cn/chain33/metrics/influxdb"
+	"github.com/33cn/chain33/metrics/prometheus"
 	"github.com/33cn/chain33/types"
 	go_metrics "github.com/rcrowley/go-metrics"
 )
@@ -19,10 +23,38 @@ type influxDBPara struct {
 	Namespace string `json:"namespace,omitempty"`
 }
 
+type prometheusPara struct {
+	ListenAddr string `json:"listenAddr,omitempty"`
+	Path       string `json:"path,omitempty"`
+}
+
 var (
 	log = chain33log.New("module", "chain33 metrics")
 )
 
+//Name 生成带标签的指标名称，形如name{k1="v1",k2="v2"}，labels按key、value成对传入，
+//prometheus输出时解析为标签，其他输出方式作为普通名称使用
+func Name(name string, labels ...string) string {
+	if len(labels) < 2 {
+		return name
+	}
+	pairs := make([]string, 0, len(labels)/2)
+	for i := 0; i+1 < len(labels); i += 2 {
+		value := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(labels[i+1])
+		pairs = append(pairs, labels[i]+`="`+value+`"`)
+	}
+	sort.Strings(pairs)
+	return name + "{" + strings.Join(pairs, ",") + "}"
+}
+
+//Enabled 是否开启了metrics，没有开启时各模块不需要记录高频的指标
+func Enabled(cfg *types.Chain33Config) bool {
+	if cfg == nil || cfg.GetModuleConfig().Metrics == nil {
+		return false
+	}
+	return cfg.GetModuleConfig().Metrics.EnableMetrics
+}
+
 //StartMetrics 根据配置文件相关参数启动m
 func StartMetrics(cfg *types.Chain33Config) {
 	metrics := cfg.GetModuleConfig().Metrics
@@ -53,6 +85,20 @@ func StartMetrics(cfg *types.Chain33Config) {
 			influxdbcfg.Username,
 			influxdbcfg.Password,
 			"")
+	case "prometheus":
+		para := prometheusPara{ListenAddr: "localhost:9100", Path: "/metrics"}
+		if subcfg, ok := cfg.GetSubConfig().Metrics[metrics.DataEmitMode]; ok {
+			types.MustDecode(subcfg, &para)
+		}
+		log.Info("StartMetrics with prometheus", "listenAddr", para.ListenAddr, "path", para.Path,
+			"namespace", metrics.Namespace)
+		mux := http.NewServeMux()
+		mux.Handle(para.Path, prometheus.Handler(go_metrics.DefaultRegistry, metrics.Namespace))
+		go func() {
+			if err := http.ListenAndServe(para.ListenAddr, mux); err != nil {
+				log.Error("startMetrics", "prometheus ListenAndServe err", err)
+			}
+		}()
 	default:
 		log.Error("startMetrics", "The dataEmitMode set is not supported now ", metrics.DataEmitMode)
 		return
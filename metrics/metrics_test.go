@@ -0,0 +1,16 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestName(t *testing.T) {
+	assert.Equal(t, "mempool_size", Name("mempool_size"))
+	assert.Equal(t, "mempool_size", Name("mempool_size", "err"))
+	assert.Equal(t, `queue_depth{topic="mempool"}`, Name("queue_depth", "topic", "mempool"))
+	assert.Equal(t, `p2p_protocol_bytes{direction="in",protocol="/p2p/a"}`,
+		Name("p2p_protocol_bytes", "protocol", "/p2p/a", "direction", "in"))
+	assert.Equal(t, `mempool_rejected{err="a\"b\\c\n"}`, Name("mempool_rejected", "err", "a\"b\\c\n"))
+}
@@ -0,0 +1,135 @@
+// Package prometheus 以prometheus文本格式输出go-metrics registry中的指标
+package prometheus
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+//summary类型输出的分位数
+var quantiles = []float64{0.5, 0.75, 0.95, 0.99}
+
+type sample struct {
+	labels string
+	metric interface{}
+}
+
+// Handler 返回输出registry中所有指标的http handler，namespace不为空时作为指标名称的前缀
+func Handler(reg metrics.Registry, namespace string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		_, _ = w.Write(Format(reg, namespace))
+	})
+}
+
+// Format 按prometheus文本格式输出所有指标，名称形如name{k="v"}的指标解析为带标签的指标，同名指标合并输出
+func Format(reg metrics.Registry, namespace string) []byte {
+	families := make(map[string][]*sample)
+	reg.Each(func(name string, metric interface{}) {
+		base, labels := splitName(name)
+		base = sanitize(base)
+		if namespace != "" {
+			base = sanitize(namespace) + "_" + base
+		}
+		families[base] = append(families[base], &sample{labels: labels, metric: metric})
+	})
+	names := make([]string, 0, len(families))
+	for name := range families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var buf bytes.Buffer
+	for _, name := range names {
+		samples := families[name]
+		sort.Slice(samples, func(i, j int) bool {
+			return samples[i].labels < samples[j].labels
+		})
+		writeFamily(&buf, name, samples)
+	}
+	return buf.Bytes()
+}
+
+func writeFamily(buf *bytes.Buffer, name string, samples []*sample) {
+	typ := ""
+	for _, s := range samples {
+		switch m := s.metric.(type) {
+		case metrics.Counter:
+			typ = writeType(buf, name, "counter", typ)
+			writeSample(buf, name, s.labels, "", float64(m.Count()))
+		case metrics.Meter:
+			typ = writeType(buf, name, "counter", typ)
+			writeSample(buf, name, s.labels, "", float64(m.Count()))
+		case metrics.Gauge:
+			typ = writeType(buf, name, "gauge", typ)
+			writeSample(buf, name, s.labels, "", float64(m.Value()))
+		case metrics.GaugeFloat64:
+			typ = writeType(buf, name, "gauge", typ)
+			writeSample(buf, name, s.labels, "", m.Value())
+		case metrics.Histogram:
+			typ = writeType(buf, name, "summary", typ)
+			snap := m.Snapshot()
+			writeSummary(buf, name, s.labels, snap.Percentiles(quantiles), float64(snap.Sum()), snap.Count(), 1)
+		case metrics.Timer:
+			//timer以纳秒记录，输出为秒
+			typ = writeType(buf, name, "summary", typ)
+			snap := m.Snapshot()
+			writeSummary(buf, name, s.labels, snap.Percentiles(quantiles), float64(snap.Sum()), snap.Count(), float64(time.Second))
+		}
+	}
+}
+
+//writeType 同一个指标名称只输出一次类型，类型不一致时后面的指标仍按第一个类型输出
+func writeType(buf *bytes.Buffer, name, typ, written string) string {
+	if written != "" {
+		return written
+	}
+	fmt.Fprintf(buf, "# TYPE %s %s\n", name, typ)
+	return typ
+}
+
+func writeSummary(buf *bytes.Buffer, name, labels string, values []float64, sum float64, count int64, unit float64) {
+	for i, q := range quantiles {
+		writeSample(buf, name, joinLabels(labels, fmt.Sprintf(`quantile="%g"`, q)), "", values[i]/unit)
+	}
+	writeSample(buf, name, labels, "_sum", sum/unit)
+	writeSample(buf, name, labels, "_count", float64(count))
+}
+
+func writeSample(buf *bytes.Buffer, name, labels, suffix string, value float64) {
+	if labels != "" {
+		labels = "{" + labels + "}"
+	}
+	fmt.Fprintf(buf, "%s%s%s %g\n", name, suffix, labels, value)
+}
+
+func joinLabels(labels, label string) string {
+	if labels == "" {
+		return label
+	}
+	return labels + "," + label
+}
+
+//splitName 拆分name{k="v"}形式的名称
+func splitName(name string) (string, string) {
+	i := strings.IndexByte(name, '{')
+	if i < 0 || !strings.HasSuffix(name, "}") {
+		return name, ""
+	}
+	return name[:i], name[i+1 : len(name)-1]
+}
+
+//sanitize 指标名称只能包含字母、数字、下划线和冒号
+func sanitize(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' || r == ':' {
+			return r
+		}
+		return '_'
+	}, name)
+}
@@ -0,0 +1,52 @@
+package prometheus
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormat(t *testing.T) {
+	reg := metrics.NewRegistry()
+	metrics.GetOrRegisterCounter(`mempool_rejected{err="ErrTxExist"}`, reg).Inc(2)
+	metrics.GetOrRegisterCounter(`mempool_rejected{err="ErrNotSync"}`, reg).Inc(1)
+	metrics.GetOrRegisterGauge("mempool.size", reg).Update(10)
+	metrics.GetOrRegisterGaugeFloat64("rate", reg).Update(0.5)
+	timer := metrics.GetOrRegisterTimer(`queue_send_seconds{topic="mempool"}`, reg)
+	timer.Update(time.Second)
+	timer.Update(3 * time.Second)
+
+	lines := strings.Split(string(Format(reg, "chain33")), "\n")
+	assert.Equal(t, []string{
+		"# TYPE chain33_mempool_rejected counter",
+		`chain33_mempool_rejected{err="ErrNotSync"} 1`,
+		`chain33_mempool_rejected{err="ErrTxExist"} 2`,
+		"# TYPE chain33_mempool_size gauge",
+		"chain33_mempool_size 10",
+		"# TYPE chain33_queue_send_seconds summary",
+		`chain33_queue_send_seconds{topic="mempool",quantile="0.5"} 2`,
+		`chain33_queue_send_seconds{topic="mempool",quantile="0.75"} 3`,
+		`chain33_queue_send_seconds{topic="mempool",quantile="0.95"} 3`,
+		`chain33_queue_send_seconds{topic="mempool",quantile="0.99"} 3`,
+		`chain33_queue_send_seconds_sum{topic="mempool"} 4`,
+		`chain33_queue_send_seconds_count{topic="mempool"} 2`,
+		"# TYPE chain33_rate gauge",
+		"chain33_rate 0.5",
+		"",
+	}, lines)
+}
+
+func TestHandler(t *testing.T) {
+	reg := metrics.NewRegistry()
+	metrics.GetOrRegisterGauge("p2p_peers", reg).Update(3)
+	w := httptest.NewRecorder()
+	Handler(reg, "").ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/plain; version=0.0.4", w.Header().Get("Content-Type"))
+	assert.Equal(t, "# TYPE p2p_peers gauge\np2p_peers 3\n", w.Body.String())
+}
@@ -12,6 +12,7 @@ import (
 
 	"unsafe"
 
+	"github.com/33cn/chain33/common/trace"
 	"github.com/33cn/chain33/types"
 )
 
@@ -97,11 +98,18 @@ func (client *client) SendTimeout(msg *Message, waitReply bool, timeout time.Dur
 	if client.isClose() {
 		return ErrIsQueueClosed
 	}
+	msg.startSpan()
+	msg.waitReply = waitReply
 	if !waitReply {
 		//msg.chReply = nil
-		return client.q.sendLowTimeout(msg, timeout)
+		err = client.q.sendLowTimeout(msg, timeout)
+	} else {
+		err = client.q.send(msg, timeout)
 	}
-	return client.q.send(msg, timeout)
+	if err != nil {
+		msg.endSpan(err)
+	}
+	return err
 }
 
 //系统设计出两种优先级别的消息发送
@@ -116,6 +124,8 @@ func (client *client) NewMessage(topic string, ty int64, data interface{}) (msg
 	msg.Ty = ty
 	msg.Data = data
 	msg.Topic = topic
+	msg.Trace = trace.SpanContext{}
+	msg.span = nil
 	return
 }
 
@@ -126,6 +136,7 @@ func (client *client) FreeMessage(msgs ...*Message) {
 			continue
 		}
 		msg.Data = nil
+		msg.span = nil
 		client.q.msgPool.Put(msg)
 	}
 }
@@ -258,6 +269,7 @@ func (client *client) Sub(topic string) {
 					qlog.Info("unsub1", "topic", topic)
 					return
 				}
+				data.received()
 				client.Recv() <- data
 			default:
 				select {
@@ -266,12 +278,14 @@ func (client *client) Sub(topic string) {
 						qlog.Info("unsub2", "topic", topic)
 						return
 					}
+					data.received()
 					client.Recv() <- data
 				case data, ok := <-sub.low:
 					if client.isEnd(data, ok) {
 						qlog.Info("unsub3", "topic", topic)
 						return
 					}
+					data.received()
 					client.Recv() <- data
 				case <-client.done:
 					qlog.Error("unsub4", "topic", topic)
@@ -281,3 +295,23 @@ func (client *client) Sub(topic string) {
 		}
 	}()
 }
+
+type traceClient struct {
+	Client
+	parent trace.SpanContext
+}
+
+// WithTrace 返回的client新建的消息以parent为父span，用于在模块内部的调用链中传递追踪上下文
+func WithTrace(client Client, parent trace.SpanContext) Client {
+	if !parent.IsValid() {
+		return client
+	}
+	return &traceClient{Client: client, parent: parent}
+}
+
+// NewMessage 新建消息并设置父span
+func (client *traceClient) NewMessage(topic string, ty int64, data interface{}) *Message {
+	msg := client.Client.NewMessage(topic, ty, data)
+	msg.Trace = client.parent
+	return msg
+}
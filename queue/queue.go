@@ -6,6 +6,7 @@
 package queue
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"os"
@@ -15,7 +16,10 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/33cn/chain33/common/trace"
+	"github.com/33cn/chain33/metrics"
 	"github.com/33cn/chain33/types"
+	go_metrics "github.com/rcrowley/go-metrics"
 
 	log "github.com/33cn/chain33/common/log/log15"
 )
@@ -51,6 +55,8 @@ type chanSub struct {
 	low     chan *Message
 	isClose int32
 	done    chan struct{}
+	//消息发送到topic的耗时，没有开启metrics时为nil
+	sendTimer go_metrics.Timer
 }
 
 // Queue only one obj in project
@@ -180,12 +186,22 @@ func (q *queue) chanSub(topic string) *chanSub {
 	defer q.mu.Unlock()
 	_, ok := q.chanSubs[topic]
 	if !ok {
-		q.chanSubs[topic] = &chanSub{
+		sub := &chanSub{
 			high:    make(chan *Message, defaultChanBuffer),
 			low:     make(chan *Message, defaultLowChanBuffer),
 			isClose: 0,
 			done:    make(chan struct{}),
 		}
+		if metrics.Enabled(q.cfg) {
+			sub.sendTimer = go_metrics.GetOrRegisterTimer(metrics.Name("queue_send_seconds", "topic", topic), nil)
+			//同一进程中可能创建多个队列，topic的队列深度以最后创建的为准
+			depth := metrics.Name("queue_depth", "topic", topic)
+			go_metrics.Unregister(depth)
+			_ = go_metrics.Register(depth, go_metrics.NewFunctionalGauge(func() int64 {
+				return int64(len(sub.high) + len(sub.low))
+			}))
+		}
+		q.chanSubs[topic] = sub
 	}
 	return q.chanSubs[topic]
 }
@@ -216,6 +232,9 @@ func (q *queue) send(msg *Message, timeout time.Duration) (err error) {
 	if sub.isClose == 1 {
 		return types.ErrChannelClosed
 	}
+	if sub.sendTimer != nil {
+		defer sub.sendTimer.UpdateSince(time.Now())
+	}
 	if timeout == -1 {
 		select {
 		case sub.high <- msg:
@@ -275,6 +294,9 @@ func (q *queue) sendLowTimeout(msg *Message, timeout time.Duration) error {
 	if sub.isClose == 1 {
 		return types.ErrChannelClosed
 	}
+	if sub.sendTimer != nil {
+		defer sub.sendTimer.UpdateSince(time.Now())
+	}
 	if timeout == -1 {
 		sub.low <- msg
 		return nil
@@ -306,6 +328,12 @@ type Message struct {
 	Data     interface{}
 	chReply  chan *Message
 	callback func(msg *Message)
+	//追踪上下文，发送时作为父span，接收后为消息处理的span(不等待回复的消息为队列等待的span)，回复消息沿用请求消息的上下文
+	Trace    trace.SpanContext
+	span     *trace.Span
+	spanLock sync.Mutex
+	//发送方是否等待回复，远程模块只为等待回复的消息转发回复
+	waitReply bool
 }
 
 // NewMessage new message
@@ -348,6 +376,10 @@ func (msg *Message) Err() error {
 
 // Reply reply message to reply chan
 func (msg *Message) Reply(replyMsg *Message) {
+	if replyMsg != nil {
+		msg.endSpan(replyMsg.Err())
+		replyMsg.Trace = msg.Trace
+	}
 	if msg.chReply == nil {
 		qlog.Debug("reply a empty chreply", "msg", msg)
 		return
@@ -355,6 +387,58 @@ func (msg *Message) Reply(replyMsg *Message) {
 	msg.chReply <- replyMsg
 }
 
+//startSpan 开始消息在队列中等待的span
+func (msg *Message) startSpan() {
+	if !trace.Enabled() {
+		return
+	}
+	msg.span = trace.StartSpan("queue "+msg.Topic+" "+types.GetEventName(int(msg.Ty)), msg.Trace)
+	msg.Trace = msg.span.Context()
+	if !msg.span.IsRecording() {
+		return
+	}
+	switch data := msg.Data.(type) {
+	case *types.Transaction:
+		msg.span.SetAttribute(trace.AttrTxHash, "0x"+hex.EncodeToString(data.Hash()))
+	case *types.Block:
+		msg.span.SetAttribute(trace.AttrBlockHeight, fmt.Sprint(data.GetHeight()))
+	case *types.BlockDetail:
+		msg.span.SetAttribute(trace.AttrBlockHeight, fmt.Sprint(data.GetBlock().GetHeight()))
+	case *types.ExecTxList:
+		msg.span.SetAttribute(trace.AttrBlockHeight, fmt.Sprint(data.GetHeight()))
+	}
+}
+
+//received 结束队列等待的span，等待回复的消息开始处理的span，回复时结束；
+//不等待回复的消息无法得知处理函数何时返回，不开始处理的span，处理时发送的消息以队列span为父span
+func (msg *Message) received() {
+	if msg.span == nil {
+		return
+	}
+	msg.span.End()
+	msg.span = nil
+	if msg.waitReply {
+		msg.startHandleSpan()
+	}
+}
+
+func (msg *Message) startHandleSpan() {
+	msg.span = trace.StartSpan("handle "+msg.Topic+" "+types.GetEventName(int(msg.Ty)), msg.Trace)
+	msg.Trace = msg.span.Context()
+}
+
+func (msg *Message) endSpan(err error) {
+	msg.spanLock.Lock()
+	span := msg.span
+	msg.span = nil
+	msg.spanLock.Unlock()
+	if span == nil {
+		return
+	}
+	span.SetError(err)
+	span.End()
+}
+
 // String print the message information
 func (msg *Message) String() string {
 	return fmt.Sprintf("{topic:%s, Ty:%s, Id:%d, Err:%v, Ch:%v}", msg.Topic,
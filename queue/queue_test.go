@@ -6,10 +6,14 @@ package queue
 
 import (
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/33cn/chain33/common/trace"
+	"github.com/33cn/chain33/metrics"
 	"github.com/33cn/chain33/types"
+	go_metrics "github.com/rcrowley/go-metrics"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -533,3 +537,113 @@ func TestChannelClose(t *testing.T) {
 		}
 	}
 }
+
+func TestQueueMetrics(t *testing.T) {
+	//没有开启metrics时不记录
+	q := New("channel")
+	client := q.Client()
+	assert.Nil(t, client.Send(client.NewMessage("nometricstopic", types.EventTx, "a"), false))
+	assert.Nil(t, go_metrics.Get(metrics.Name("queue_send_seconds", "topic", "nometricstopic")))
+	assert.Nil(t, go_metrics.Get(metrics.Name("queue_depth", "topic", "nometricstopic")))
+	q.Close()
+
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	cfg.GetModuleConfig().Metrics = &types.Metrics{EnableMetrics: true}
+	q = New("channel")
+	q.SetConfig(cfg)
+	client = q.Client()
+	assert.Nil(t, client.Send(client.NewMessage("metricstopic", types.EventTx, "a"), false))
+	assert.Nil(t, client.SendTimeout(client.NewMessage("metricstopic", types.EventTx, "b"), false, time.Second))
+
+	depth := go_metrics.Get(metrics.Name("queue_depth", "topic", "metricstopic")).(go_metrics.Gauge)
+	assert.Equal(t, int64(2), depth.Value())
+	timer := go_metrics.Get(metrics.Name("queue_send_seconds", "topic", "metricstopic")).(go_metrics.Timer)
+	assert.Equal(t, int64(2), timer.Count())
+	q.Close()
+}
+
+type spanRecorder struct {
+	sync.Mutex
+	spans []*trace.SpanData
+}
+
+func (r *spanRecorder) ExportSpans(spans []*trace.SpanData) error {
+	r.Lock()
+	defer r.Unlock()
+	r.spans = append(r.spans, spans...)
+	return nil
+}
+
+func (r *spanRecorder) Shutdown() error { return nil }
+
+func TestMessageTrace(t *testing.T) {
+	recorder := &spanRecorder{}
+	trace.Start(recorder, 1)
+	q := New("channel")
+	defer q.Close()
+	var handled trace.SpanContext
+	go func() {
+		client := q.Client()
+		client.Sub("mempool")
+		for msg := range client.Recv() {
+			handled = msg.Trace
+			//处理消息时发送的消息属于同一个追踪
+			next := WithTrace(client, msg.Trace).NewMessage("p2p", types.EventTxBroadcast, nil)
+			assert.Equal(t, msg.Trace, next.Trace)
+			msg.Reply(client.NewMessage("", types.EventReply, types.ErrNotSync))
+		}
+	}()
+	client := q.Client()
+	tx := &types.Transaction{Execer: []byte("none")}
+	msg := client.NewMessage("mempool", types.EventTx, tx)
+	assert.Nil(t, client.Send(msg, true))
+	reply, err := client.Wait(msg)
+	assert.Equal(t, types.ErrNotSync, err)
+	assert.Equal(t, handled, reply.Trace)
+	trace.Stop()
+
+	assert.Equal(t, 2, len(recorder.spans))
+	queued, handle := recorder.spans[0], recorder.spans[1]
+	assert.Equal(t, "queue mempool EventTx", queued.Name)
+	assert.Equal(t, "", queued.ParentID)
+	assert.Equal(t, fmt.Sprintf("0x%x", tx.Hash()), queued.Attributes[trace.AttrTxHash])
+	assert.Equal(t, "handle mempool EventTx", handle.Name)
+	assert.Equal(t, queued.TraceID, handle.TraceID)
+	assert.Equal(t, queued.SpanID, handle.ParentID)
+	assert.Equal(t, types.ErrNotSync.Error(), handle.Error)
+
+	//未开启追踪时不设置上下文
+	msg = client.NewMessage("mempool", types.EventTx, tx)
+	assert.Nil(t, client.Send(msg, true))
+	reply, _ = client.Wait(msg)
+	assert.False(t, reply.Trace.IsValid())
+	assert.Equal(t, client, WithTrace(client, reply.Trace))
+}
+
+func TestMessageTraceNoReply(t *testing.T) {
+	recorder := &spanRecorder{}
+	trace.Start(recorder, 1)
+	q := New("channel")
+	defer q.Close()
+	handled := make(chan trace.SpanContext, 1)
+	go func() {
+		client := q.Client()
+		client.Sub("p2p")
+		for msg := range client.Recv() {
+			handled <- msg.Trace
+		}
+	}()
+	client := q.Client()
+	msg := client.NewMessage("p2p", types.EventTxBroadcast, &types.Transaction{})
+	assert.Nil(t, client.Send(msg, false))
+	//处理时开始的span以队列span为父span
+	trace.StartSpan("broadcast", <-handled).End()
+	trace.Stop()
+
+	//不等待回复的消息接收时结束span，不依赖处理函数调用
+	assert.Equal(t, 2, len(recorder.spans))
+	assert.Equal(t, "queue p2p EventTxBroadcast", recorder.spans[0].Name)
+	assert.Equal(t, "", recorder.spans[0].Error)
+	assert.Equal(t, "broadcast", recorder.spans[1].Name)
+	assert.Equal(t, recorder.spans[0].SpanID, recorder.spans[1].ParentID)
+}
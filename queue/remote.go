@@ -0,0 +1,940 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queue
+
+import (
+	"bufio"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/33cn/chain33/common/trace"
+	"github.com/33cn/chain33/types"
+	"github.com/golang/protobuf/proto"
+)
+
+//远程模块：
+//主进程通过Listen在unix socket或者tcp上提供队列服务，
+//在独立进程中运行的模块(如wallet, rpc)通过Dial得到的client替代本地client，
+//消息以帧的形式传输，payload必须是注册过的protobuf类型或者错误，回复通过Message.ID对应请求。
+//连接建立后远程模块需要用自己的凭证完成握手，只能订阅凭证中配置的topic，每个topic同时只能有一个远程模块订阅，
+//不能关闭主进程的队列，unix socket只允许当前用户访问，tcp监听非回环地址时必须开启TLS
+
+//远程模块的帧类型
+const (
+	frameSub int32 = iota + 1
+	frameMsg
+	frameReply
+	frameCloseQueue
+	frameAuth
+)
+
+const (
+	maxFrameSize = 256 * 1024 * 1024
+	//握手完成之前只接受很小的帧
+	maxHandshakeFrameSize = 64 * 1024
+	authNonceSize         = 32
+	handshakeTimeout      = 10 * time.Second
+)
+
+//远程模块的错误
+var (
+	ErrInvalidPayload = errors.New("ErrInvalidPayload")
+	ErrFrameTooLarge  = errors.New("ErrFrameTooLarge")
+	ErrAuthFailed     = errors.New("ErrAuthFailed")
+	ErrTLSRequired    = errors.New("ErrTLSRequired")
+	ErrTopicAttached  = errors.New("ErrTopicAttached")
+)
+
+var (
+	remoteErrsLock sync.RWMutex
+	remoteErrs     = make(map[string]error)
+)
+
+func init() {
+	RegisterError(ErrIsQueueClosed, ErrQueueTimeout, ErrQueueChannelFull, ErrInvalidPayload, ErrFrameTooLarge, ErrAuthFailed, ErrTopicAttached,
+		types.ErrChannelClosed, types.ErrNotFound, types.ErrNotSupport, types.ErrInvalidParam, types.ErrTimeout,
+		types.ErrEmpty, types.ErrNotInited, types.ErrNotSync, types.ErrTxExist, types.ErrDupTx, types.ErrMemFull,
+		types.ErrNoBalance, types.ErrBlockNotFound, types.ErrHashNotFound, types.ErrInvalidAddress,
+		types.ErrActionNotSupport, types.ErrTypeAsset, types.ErrConsensusHashErr, types.ErrAPIKeyInvalid,
+		types.ErrWalletIsLocked, types.ErrSaveSeedFirst, types.ErrVerifyOldpasswdFail, types.ErrSeedWordNum,
+		types.ErrSeedWord, types.ErrSeedExist, types.ErrPrivkeyExist, types.ErrOnlyTicketUnLocked, types.ErrInputPassword)
+}
+
+// RegisterError 注册跨进程传递的错误，接收方还原为同一个错误变量，err == types.ErrXXX 的判断依然成立
+func RegisterError(errs ...error) {
+	remoteErrsLock.Lock()
+	defer remoteErrsLock.Unlock()
+	for _, err := range errs {
+		remoteErrs[err.Error()] = err
+	}
+}
+
+func lookupError(s string) error {
+	remoteErrsLock.RLock()
+	defer remoteErrsLock.RUnlock()
+	if err, ok := remoteErrs[s]; ok {
+		return err
+	}
+	return errors.New(s)
+}
+
+func encodePayload(frame *types.QueueFrame, data interface{}) error {
+	switch v := data.(type) {
+	case nil:
+	case error:
+		frame.Err = v.Error()
+	case proto.Message:
+		name := proto.MessageName(v)
+		if name == "" {
+			return ErrInvalidPayload
+		}
+		frame.TypeName = name
+		frame.Data = types.Encode(v)
+	default:
+		qlog.Error("encodePayload", "type", reflect.TypeOf(data), "err", ErrInvalidPayload)
+		return ErrInvalidPayload
+	}
+	return nil
+}
+
+func decodePayload(frame *types.QueueFrame) (interface{}, error) {
+	if frame.Err != "" {
+		return lookupError(frame.Err), nil
+	}
+	if frame.TypeName == "" {
+		return nil, nil
+	}
+	ty := proto.MessageType(frame.TypeName)
+	if ty == nil || ty.Kind() != reflect.Ptr {
+		qlog.Error("decodePayload", "type", frame.TypeName, "err", ErrInvalidPayload)
+		return nil, ErrInvalidPayload
+	}
+	data := reflect.New(ty.Elem()).Interface().(proto.Message)
+	if err := types.Decode(frame.Data, data); err != nil {
+		return nil, err
+	}
+	//空的参数编码后解码为nil，还原为空参数，由接收模块按照函数的参数类型解码
+	if exec, ok := data.(*types.ChainExecutor); ok && exec.Param == nil {
+		exec.Param = []byte{}
+	}
+	return data, nil
+}
+
+//parseAddr 解析 unix:///path/to/file.sock 或者 tcp://host:port 格式的地址
+func parseAddr(addr string) (string, string, error) {
+	for _, network := range []string{"unix", "tcp"} {
+		if strings.HasPrefix(addr, network+"://") {
+			return network, strings.TrimPrefix(addr, network+"://"), nil
+		}
+	}
+	return "", "", fmt.Errorf("invalid queue address %s", addr)
+}
+
+//isLoopback tcp地址是否为回环地址，没有指定host时监听所有地址
+func isLoopback(address string) bool {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+//authMAC 握手时用凭证的密钥对服务端的随机数签名
+func authMAC(secret string, nonce []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(nonce)
+	return mac.Sum(nil)
+}
+
+//frameConn 以4字节长度前缀传输帧，写入可以并发
+type frameConn struct {
+	conn net.Conn
+	r    *bufio.Reader
+	mu   sync.Mutex
+}
+
+func newFrameConn(conn net.Conn) *frameConn {
+	return &frameConn{conn: conn, r: bufio.NewReader(conn)}
+}
+
+func (c *frameConn) read() (*types.QueueFrame, error) {
+	return c.readLimit(maxFrameSize)
+}
+
+//readLimit 读取一帧，帧的长度超过limit时返回ErrFrameTooLarge
+func (c *frameConn) readLimit(limit uint32) (*types.QueueFrame, error) {
+	var head [4]byte
+	if _, err := io.ReadFull(c.r, head[:]); err != nil {
+		return nil, err
+	}
+	size := binary.BigEndian.Uint32(head[:])
+	if size > limit {
+		return nil, ErrFrameTooLarge
+	}
+	buf := make([]byte, size)
+	if _, err := io.ReadFull(c.r, buf); err != nil {
+		return nil, err
+	}
+	frame := &types.QueueFrame{}
+	if err := types.Decode(buf, frame); err != nil {
+		return nil, err
+	}
+	return frame, nil
+}
+
+func (c *frameConn) write(frame *types.QueueFrame) error {
+	data := types.Encode(frame)
+	if len(data) > maxFrameSize {
+		return ErrFrameTooLarge
+	}
+	buf := make([]byte, 4+len(data))
+	binary.BigEndian.PutUint32(buf, uint32(len(data)))
+	copy(buf[4:], data)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, err := c.conn.Write(buf)
+	return err
+}
+
+//reply 回复请求id对应的消息，payload无法编码时回复编码错误
+func (c *frameConn) reply(id int64, topic string, ty int64, data interface{}) error {
+	frame := &types.QueueFrame{Kind: frameReply, Topic: topic, Ty: ty, Id: id}
+	if err := encodePayload(frame, data); err != nil {
+		frame.Err = err.Error()
+	}
+	return c.write(frame)
+}
+
+// Server 主进程的远程模块服务，远程模块订阅的topic由服务端的client代为订阅并转发消息
+type Server struct {
+	q        Queue
+	creds    map[string]*credential
+	listener net.Listener
+	done     chan struct{}
+	wg       sync.WaitGroup
+	mu       sync.Mutex
+	conns    map[*serverConn]struct{}
+	subs     map[string]*remoteSub
+}
+
+//remoteSub 远程模块订阅的topic，远程模块断开重连后继续接收消息
+type remoteSub struct {
+	client   Client
+	mu       sync.Mutex
+	conn     *serverConn
+	attached chan struct{}
+}
+
+//credential 远程模块的凭证以及允许订阅的topic
+type credential struct {
+	secret string
+	topics map[string]bool
+}
+
+type serverConn struct {
+	*frameConn
+	s       *Server
+	client  Client
+	name    string
+	topics  map[string]bool
+	mu      sync.Mutex
+	pending map[int64]*Message
+	once    sync.Once
+}
+
+// Listen 在cfg.ListenAddr上为远程模块提供队列服务，地址格式为 unix:///path/to/file.sock 或者 tcp://host:port，
+//远程模块只能订阅自己的凭证中配置的topic，凭证的topic必须是cfg.Sidecars中的模块
+func Listen(q Queue, cfg *types.Queue) (*Server, error) {
+	network, address, err := parseAddr(cfg.ListenAddr)
+	if err != nil {
+		return nil, err
+	}
+	creds, err := loadCredentials(cfg)
+	if err != nil {
+		return nil, err
+	}
+	useTLS := network == "tcp" && cfg.CertFile != "" && cfg.KeyFile != ""
+	if network == "tcp" && !useTLS && !isLoopback(address) {
+		return nil, ErrTLSRequired
+	}
+	if network == "unix" {
+		//清理上次异常退出残留的socket文件
+		_ = os.Remove(address)
+	}
+	listener, err := net.Listen(network, address)
+	if err != nil {
+		return nil, err
+	}
+	if network == "unix" {
+		if err = os.Chmod(address, 0600); err != nil {
+			listener.Close()
+			return nil, err
+		}
+	}
+	if useTLS {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			listener.Close()
+			return nil, err
+		}
+		listener = tls.NewListener(listener, &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12})
+	}
+	s := &Server{
+		q:        q,
+		creds:    creds,
+		listener: listener,
+		done:     make(chan struct{}),
+		conns:    make(map[*serverConn]struct{}),
+		subs:     make(map[string]*remoteSub),
+	}
+	s.wg.Add(1)
+	go s.accept()
+	qlog.Info("queue server listen", "addr", cfg.ListenAddr, "tls", useTLS)
+	return s, nil
+}
+
+//loadCredentials 检查凭证的配置，凭证的名称不能重复，密钥不能为空，只能订阅sidecars中的模块
+func loadCredentials(cfg *types.Queue) (map[string]*credential, error) {
+	if len(cfg.Credentials) == 0 {
+		return nil, fmt.Errorf("queue.credentials must be configured to listen on %s", cfg.ListenAddr)
+	}
+	sidecars := make(map[string]bool)
+	for _, name := range cfg.Sidecars {
+		sidecars[name] = true
+	}
+	creds := make(map[string]*credential)
+	for _, c := range cfg.Credentials {
+		if c.Name == "" || c.Secret == "" {
+			return nil, fmt.Errorf("queue credential name and secret must be configured")
+		}
+		if _, ok := creds[c.Name]; ok {
+			return nil, fmt.Errorf("queue credential %s is duplicated", c.Name)
+		}
+		cred := &credential{secret: c.Secret, topics: make(map[string]bool)}
+		for _, topic := range c.Topics {
+			if !sidecars[topic] {
+				return nil, fmt.Errorf("queue credential %s topic %s is not a sidecar", c.Name, topic)
+			}
+			cred.topics[topic] = true
+		}
+		creds[c.Name] = cred
+	}
+	return creds, nil
+}
+
+// Addr 返回服务的监听地址，格式和Listen的参数相同
+func (s *Server) Addr() string {
+	addr := s.listener.Addr()
+	return addr.Network() + "://" + addr.String()
+}
+
+// Close 关闭服务以及远程模块订阅的topic
+func (s *Server) Close() {
+	select {
+	case <-s.done:
+		return
+	default:
+	}
+	close(s.done)
+	s.listener.Close()
+	s.mu.Lock()
+	conns := make([]*serverConn, 0, len(s.conns))
+	for conn := range s.conns {
+		conns = append(conns, conn)
+	}
+	subs := make([]*remoteSub, 0, len(s.subs))
+	for _, sub := range s.subs {
+		subs = append(subs, sub)
+	}
+	s.mu.Unlock()
+	for _, conn := range conns {
+		conn.close()
+	}
+	for _, sub := range subs {
+		sub.client.Close()
+	}
+	s.wg.Wait()
+	qlog.Info("queue server closed")
+}
+
+func (s *Server) accept() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			select {
+			case <-s.done:
+				return
+			default:
+			}
+			qlog.Error("queue server accept", "err", err)
+			time.Sleep(time.Second)
+			continue
+		}
+		c := &serverConn{
+			frameConn: newFrameConn(conn),
+			s:         s,
+			client:    s.q.Client(),
+			pending:   make(map[int64]*Message),
+		}
+		s.mu.Lock()
+		s.conns[c] = struct{}{}
+		s.mu.Unlock()
+		s.wg.Add(1)
+		go c.serve()
+	}
+}
+
+//subscribe topic已经有其他连接订阅时返回ErrTopicAttached，不替换已有的订阅
+func (s *Server) subscribe(topic string, conn *serverConn) error {
+	s.mu.Lock()
+	sub, ok := s.subs[topic]
+	if !ok {
+		sub = &remoteSub{client: s.q.Client(), attached: make(chan struct{}, 1)}
+		sub.client.Sub(topic)
+		s.subs[topic] = sub
+		s.wg.Add(1)
+		go s.forward(sub)
+	}
+	s.mu.Unlock()
+	sub.mu.Lock()
+	if sub.conn != nil && sub.conn != conn {
+		sub.mu.Unlock()
+		return ErrTopicAttached
+	}
+	sub.conn = conn
+	sub.mu.Unlock()
+	select {
+	case sub.attached <- struct{}{}:
+	default:
+	}
+	qlog.Info("remote module subscribe", "topic", topic, "name", conn.name, "remote", conn.conn.RemoteAddr())
+	return nil
+}
+
+func (sub *remoteSub) getConn() *serverConn {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+	return sub.conn
+}
+
+func (sub *remoteSub) detach(conn *serverConn) {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+	if sub.conn == conn {
+		sub.conn = nil
+	}
+}
+
+//forward 把topic收到的消息转发给远程模块，远程模块未连接时等待连接
+func (s *Server) forward(sub *remoteSub) {
+	defer s.wg.Done()
+	for msg := range sub.client.Recv() {
+	retry:
+		for {
+			if conn := sub.getConn(); conn != nil {
+				if err := conn.forward(msg); err == nil {
+					break
+				}
+				sub.detach(conn)
+				continue
+			}
+			select {
+			case <-sub.attached:
+			case <-s.done:
+				if msg.waitReply {
+					msg.Reply(sub.client.NewMessage(msg.Topic, msg.Ty, types.ErrChannelClosed))
+				}
+				break retry
+			}
+		}
+	}
+}
+
+func (c *serverConn) forward(msg *Message) error {
+	frame := &types.QueueFrame{
+		Kind:        frameMsg,
+		Topic:       msg.Topic,
+		Ty:          msg.Ty,
+		Id:          msg.ID,
+		WaitReply:   msg.waitReply,
+		Traceparent: msg.Trace.Traceparent(),
+	}
+	if err := encodePayload(frame, msg.Data); err != nil {
+		if msg.waitReply {
+			msg.Reply(c.client.NewMessage(msg.Topic, msg.Ty, err))
+		}
+		return nil
+	}
+	if msg.waitReply {
+		c.mu.Lock()
+		c.pending[msg.ID] = msg
+		c.mu.Unlock()
+	}
+	err := c.write(frame)
+	if err != nil && msg.waitReply {
+		c.takePending(msg.ID)
+	}
+	return err
+}
+
+func (c *serverConn) takePending(id int64) *Message {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	msg, ok := c.pending[id]
+	if !ok {
+		return nil
+	}
+	delete(c.pending, id)
+	return msg
+}
+
+//handshake 发送随机数，远程模块返回凭证名称以及用凭证的密钥对随机数的签名，校验失败时断开连接
+func (c *serverConn) handshake() error {
+	nonce := make([]byte, authNonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+	_ = c.conn.SetDeadline(time.Now().Add(handshakeTimeout))
+	if err := c.write(&types.QueueFrame{Kind: frameAuth, Data: nonce}); err != nil {
+		return err
+	}
+	frame, err := c.readLimit(maxHandshakeFrameSize)
+	if err != nil {
+		return err
+	}
+	cred, ok := c.s.creds[frame.Topic]
+	if frame.Kind != frameAuth || !ok || !hmac.Equal(frame.Data, authMAC(cred.secret, nonce)) {
+		_ = c.write(&types.QueueFrame{Kind: frameAuth, Err: ErrAuthFailed.Error()})
+		return ErrAuthFailed
+	}
+	if err = c.write(&types.QueueFrame{Kind: frameAuth}); err != nil {
+		return err
+	}
+	c.name, c.topics = frame.Topic, cred.topics
+	return c.conn.SetDeadline(time.Time{})
+}
+
+func (c *serverConn) serve() {
+	defer c.s.wg.Done()
+	defer c.close()
+	if err := c.handshake(); err != nil {
+		qlog.Error("remote module handshake", "remote", c.conn.RemoteAddr(), "err", err)
+		return
+	}
+	for {
+		frame, err := c.read()
+		if err != nil {
+			if err != io.EOF {
+				qlog.Error("remote module read", "remote", c.conn.RemoteAddr(), "err", err)
+			}
+			return
+		}
+		switch frame.Kind {
+		case frameSub:
+			if !c.topics[frame.Topic] {
+				qlog.Error("remote module subscribe not allowed", "topic", frame.Topic, "name", c.name, "remote", c.conn.RemoteAddr())
+				return
+			}
+			if err = c.s.subscribe(frame.Topic, c); err != nil {
+				qlog.Error("remote module subscribe", "topic", frame.Topic, "name", c.name, "remote", c.conn.RemoteAddr(), "err", err)
+				return
+			}
+		case frameMsg:
+			c.send(frame)
+		case frameReply:
+			msg := c.takePending(frame.Id)
+			if msg == nil {
+				continue
+			}
+			data, err := decodePayload(frame)
+			if err != nil {
+				data = err
+			}
+			msg.Reply(c.client.NewMessage(frame.Topic, frame.Ty, data))
+		case frameCloseQueue:
+			qlog.Error("remote module close queue not allowed", "remote", c.conn.RemoteAddr())
+		default:
+			qlog.Error("remote module read", "kind", frame.Kind, "err", ErrInvalidPayload)
+		}
+	}
+}
+
+//send 把远程模块的消息发送到本地队列，需要回复时等待回复后发回远程模块
+func (c *serverConn) send(frame *types.QueueFrame) {
+	data, err := decodePayload(frame)
+	if err != nil {
+		if frame.WaitReply {
+			_ = c.reply(frame.Id, frame.Topic, frame.Ty, err)
+		}
+		return
+	}
+	msg := c.client.NewMessage(frame.Topic, frame.Ty, data)
+	if sc, err := trace.ParseTraceparent(frame.Traceparent); err == nil {
+		msg.Trace = sc
+	}
+	err = c.client.SendTimeout(msg, frame.WaitReply, -1)
+	if err != nil {
+		qlog.Error("remote module send", "msg", msg, "err", err)
+		if frame.WaitReply {
+			_ = c.reply(frame.Id, frame.Topic, frame.Ty, err)
+		}
+		return
+	}
+	if !frame.WaitReply {
+		return
+	}
+	go func(id int64) {
+		resp, err := c.client.WaitTimeout(msg, 0)
+		if err != nil {
+			_ = c.reply(id, frame.Topic, frame.Ty, err)
+			return
+		}
+		_ = c.reply(id, resp.Topic, resp.Ty, resp.Data)
+	}(frame.Id)
+}
+
+//close 断开连接，已转发未回复的消息回复ErrChannelClosed
+func (c *serverConn) close() {
+	c.once.Do(func() {
+		c.conn.Close()
+		c.s.mu.Lock()
+		delete(c.s.conns, c)
+		for _, sub := range c.s.subs {
+			sub.detach(c)
+		}
+		c.s.mu.Unlock()
+		c.mu.Lock()
+		pending := c.pending
+		c.pending = make(map[int64]*Message)
+		c.mu.Unlock()
+		for _, msg := range pending {
+			msg.Reply(c.client.NewMessage(msg.Topic, msg.Ty, types.ErrChannelClosed))
+		}
+	})
+}
+
+// RemoteClient 独立进程中的模块使用的client，消息通过连接转发到主进程的队列
+type RemoteClient struct {
+	*frameConn
+	cfg     *types.Chain33Config
+	recv    chan *Message
+	done    chan struct{}
+	once    sync.Once
+	wg      sync.WaitGroup
+	mu      sync.Mutex
+	pending map[int64]*Message
+}
+
+// Dial 连接主进程在qcfg.ListenAddr上的队列服务并用name对应的凭证完成握手，tcp连接配置了证书时使用TLS并用该证书校验主进程
+func Dial(qcfg *types.Queue, name string, cfg *types.Chain33Config) (*RemoteClient, error) {
+	network, address, err := parseAddr(qcfg.ListenAddr)
+	if err != nil {
+		return nil, err
+	}
+	var secret string
+	for _, c := range qcfg.Credentials {
+		if c.Name == name {
+			secret = c.Secret
+			break
+		}
+	}
+	if secret == "" {
+		return nil, fmt.Errorf("queue credential %s is not configured", name)
+	}
+	var conn net.Conn
+	if network == "tcp" && qcfg.CertFile != "" {
+		conn, err = dialTLS(address, qcfg.CertFile)
+	} else if network == "tcp" && !isLoopback(address) {
+		return nil, ErrTLSRequired
+	} else {
+		conn, err = net.Dial(network, address)
+	}
+	if err != nil {
+		return nil, err
+	}
+	fc := newFrameConn(conn)
+	if err = fc.authenticate(name, secret); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	client := &RemoteClient{
+		frameConn: fc,
+		cfg:       cfg,
+		recv:      make(chan *Message, defaultLowChanBuffer),
+		done:      make(chan struct{}),
+		pending:   make(map[int64]*Message),
+	}
+	client.wg.Add(1)
+	go client.readLoop()
+	return client, nil
+}
+
+func dialTLS(address, certFile string) (net.Conn, error) {
+	pem, err := ioutil.ReadFile(certFile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("invalid queue cert file %s", certFile)
+	}
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	return tls.Dial("tcp", address, &tls.Config{RootCAs: pool, ServerName: host, MinVersion: tls.VersionTLS12})
+}
+
+//authenticate 发送凭证名称以及用凭证的密钥对服务端随机数的签名，完成握手
+func (c *frameConn) authenticate(name, secret string) error {
+	_ = c.conn.SetDeadline(time.Now().Add(handshakeTimeout))
+	frame, err := c.readLimit(maxHandshakeFrameSize)
+	if err != nil {
+		return err
+	}
+	if frame.Kind != frameAuth || len(frame.Data) != authNonceSize {
+		return ErrAuthFailed
+	}
+	if err = c.write(&types.QueueFrame{Kind: frameAuth, Topic: name, Data: authMAC(secret, frame.Data)}); err != nil {
+		return err
+	}
+	frame, err = c.readLimit(maxHandshakeFrameSize)
+	if err != nil {
+		return err
+	}
+	if frame.Kind != frameAuth {
+		return ErrAuthFailed
+	}
+	if frame.Err != "" {
+		return lookupError(frame.Err)
+	}
+	return c.conn.SetDeadline(time.Time{})
+}
+
+// GetConfig return the Chain33Config
+func (client *RemoteClient) GetConfig() *types.Chain33Config {
+	if client.cfg == nil {
+		panic("Chain33Config is nil")
+	}
+	return client.cfg
+}
+
+// Done 连接断开或者client关闭时返回
+func (client *RemoteClient) Done() <-chan struct{} {
+	return client.done
+}
+
+// Send 发送消息到主进程的队列
+func (client *RemoteClient) Send(msg *Message, waitReply bool) error {
+	return client.SendTimeout(msg, waitReply, -1)
+}
+
+// SendTimeout 发送消息到主进程的队列，消息写入连接即返回，timeout由主进程的队列处理
+func (client *RemoteClient) SendTimeout(msg *Message, waitReply bool, timeout time.Duration) error {
+	if client.isClose() {
+		return ErrIsQueueClosed
+	}
+	frame := &types.QueueFrame{
+		Kind:        frameMsg,
+		Topic:       msg.Topic,
+		Ty:          msg.Ty,
+		Id:          msg.ID,
+		WaitReply:   waitReply,
+		Traceparent: msg.Trace.Traceparent(),
+	}
+	if err := encodePayload(frame, msg.Data); err != nil {
+		return err
+	}
+	if waitReply {
+		client.mu.Lock()
+		client.pending[msg.ID] = msg
+		client.mu.Unlock()
+	}
+	err := client.write(frame)
+	if err != nil {
+		client.takePending(msg.ID)
+	}
+	return err
+}
+
+// Wait 等待主进程的回复
+func (client *RemoteClient) Wait(msg *Message) (*Message, error) {
+	return client.WaitTimeout(msg, -1)
+}
+
+// WaitTimeout 等待主进程的回复，超时后不再接收该消息的回复
+func (client *RemoteClient) WaitTimeout(msg *Message, timeout time.Duration) (*Message, error) {
+	if msg.chReply == nil {
+		return &Message{}, errors.New("empty wait channel")
+	}
+	var t <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		t = timer.C
+	}
+	select {
+	case reply := <-msg.chReply:
+		return reply, reply.Err()
+	case <-client.done:
+		return nil, types.ErrChannelClosed
+	case <-t:
+		client.takePending(msg.ID)
+		return &Message{}, ErrQueueTimeout
+	}
+}
+
+// Recv 获取接受消息通道，连接断开后关闭
+func (client *RemoteClient) Recv() chan *Message {
+	return client.recv
+}
+
+// Reply 回复消息
+func (client *RemoteClient) Reply(msg *Message) {
+	if msg.chReply != nil {
+		msg.Reply(msg)
+	}
+}
+
+// Sub 订阅主进程队列的topic
+func (client *RemoteClient) Sub(topic string) {
+	if err := client.write(&types.QueueFrame{Kind: frameSub, Topic: topic}); err != nil {
+		qlog.Error("remote sub", "topic", topic, "err", err)
+	}
+}
+
+// Close 断开连接
+func (client *RemoteClient) Close() {
+	client.shutdown()
+	client.wg.Wait()
+}
+
+// CloseQueue 远程模块不允许关闭主进程的队列
+func (client *RemoteClient) CloseQueue() (*types.Reply, error) {
+	return nil, types.ErrNotSupport
+}
+
+// NewMessage 新建消息 topic模块名称 ty消息类型 data 数据
+func (client *RemoteClient) NewMessage(topic string, ty int64, data interface{}) *Message {
+	return NewMessage(atomic.AddInt64(&gid, 1), topic, ty, data)
+}
+
+// FreeMessage 远程client的消息不使用内存池
+func (client *RemoteClient) FreeMessage(msgs ...*Message) {
+}
+
+func (client *RemoteClient) isClose() bool {
+	select {
+	case <-client.done:
+		return true
+	default:
+		return false
+	}
+}
+
+func (client *RemoteClient) shutdown() {
+	client.once.Do(func() {
+		close(client.done)
+		client.conn.Close()
+	})
+}
+
+func (client *RemoteClient) takePending(id int64) *Message {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	msg, ok := client.pending[id]
+	if !ok {
+		return nil
+	}
+	delete(client.pending, id)
+	return msg
+}
+
+func (client *RemoteClient) readLoop() {
+	defer client.wg.Done()
+	defer close(client.recv)
+	defer client.shutdown()
+	for {
+		frame, err := client.read()
+		if err != nil {
+			if !client.isClose() {
+				qlog.Error("remote client read", "err", err)
+			}
+			return
+		}
+		switch frame.Kind {
+		case frameMsg:
+			client.receive(frame)
+		case frameReply:
+			msg := client.takePending(frame.Id)
+			if msg == nil {
+				continue
+			}
+			data, err := decodePayload(frame)
+			if err != nil {
+				data = err
+			}
+			msg.chReply <- NewMessage(0, frame.Topic, frame.Ty, data)
+		default:
+			qlog.Error("remote client read", "kind", frame.Kind, "err", ErrInvalidPayload)
+		}
+	}
+}
+
+//receive 把主进程转发的消息交给模块处理，需要回复的消息等待模块回复后发回主进程
+func (client *RemoteClient) receive(frame *types.QueueFrame) {
+	data, err := decodePayload(frame)
+	if err != nil {
+		if frame.WaitReply {
+			_ = client.reply(frame.Id, frame.Topic, frame.Ty, err)
+		}
+		return
+	}
+	msg := NewMessage(frame.Id, frame.Topic, frame.Ty, data)
+	if sc, err := trace.ParseTraceparent(frame.Traceparent); err == nil {
+		msg.Trace = sc
+		if trace.Enabled() && frame.WaitReply {
+			msg.startHandleSpan()
+		}
+	}
+	if frame.WaitReply {
+		go func() {
+			select {
+			case reply := <-msg.chReply:
+				if reply == nil {
+					reply = &Message{}
+				}
+				_ = client.reply(frame.Id, reply.Topic, reply.Ty, reply.Data)
+			case <-client.done:
+			}
+		}()
+	} else {
+		msg.chReply = nil
+	}
+	select {
+	case client.recv <- msg:
+	case <-client.done:
+	}
+}
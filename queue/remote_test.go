@@ -0,0 +1,364 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queue
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"encoding/pem"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+//startRemoteWallet 在远程client上运行一个回复WalletGetAccountList的wallet模块
+func startRemoteWallet(client Client) {
+	client.Sub("wallet")
+	go func() {
+		for msg := range client.Recv() {
+			if msg.Ty == types.EventWalletExecutor {
+				msg.Reply(client.NewMessage("", types.EventWalletAccountList, &types.WalletAccounts{
+					Wallets: []*types.WalletAccount{{Label: "remote"}}}))
+				continue
+			}
+			msg.Reply(client.NewMessage("", types.EventReply, types.ErrActionNotSupport))
+		}
+	}()
+}
+
+const testSecret = "chain33-queue-secret"
+
+//remoteConfig 远程模块的测试配置，wallet凭证只允许订阅wallet
+func remoteConfig(addr string) *types.Queue {
+	return &types.Queue{ListenAddr: addr, Sidecars: []string{"wallet"},
+		Credentials: []*types.QueueCredential{{Name: "wallet", Secret: testSecret, Topics: []string{"wallet"}}}}
+}
+
+func TestRemoteClient(t *testing.T) {
+	dir, err := ioutil.TempDir("", "queue")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	q := New("channel")
+	server, err := Listen(q, remoteConfig("unix://"+filepath.Join(dir, "queue.sock")))
+	require.Nil(t, err)
+	defer server.Close()
+
+	//主进程的blockchain模块
+	go func() {
+		client := q.Client()
+		client.Sub("blockchain")
+		for msg := range client.Recv() {
+			if msg.Ty == types.EventGetBlockHeight {
+				msg.Reply(client.NewMessage("", types.EventReplyBlockHeight, &types.ReplyBlockHeight{Height: 10}))
+				continue
+			}
+			msg.Reply(client.NewMessage("", types.EventReply, types.ErrNotFound))
+		}
+	}()
+
+	remote, err := Dial(remoteConfig(server.Addr()), "wallet", nil)
+	require.Nil(t, err)
+	startRemoteWallet(remote)
+
+	//主进程 -> 远程模块
+	local := q.Client()
+	msg := local.NewMessage("wallet", types.EventWalletExecutor, &types.ChainExecutor{FuncName: "WalletGetAccountList"})
+	require.Nil(t, local.Send(msg, true))
+	reply, err := local.WaitTimeout(msg, 5*time.Second)
+	require.Nil(t, err)
+	assert.Equal(t, int64(types.EventWalletAccountList), reply.Ty)
+	assert.Equal(t, "remote", reply.GetData().(*types.WalletAccounts).Wallets[0].Label)
+
+	msg = local.NewMessage("wallet", types.EventWalletAccount, nil)
+	require.Nil(t, local.Send(msg, true))
+	_, err = local.WaitTimeout(msg, 5*time.Second)
+	assert.Equal(t, types.ErrActionNotSupport, err)
+
+	//远程模块 -> 主进程
+	msg = remote.NewMessage("blockchain", types.EventGetBlockHeight, nil)
+	require.Nil(t, remote.Send(msg, true))
+	reply, err = remote.WaitTimeout(msg, 5*time.Second)
+	require.Nil(t, err)
+	assert.Equal(t, int64(10), reply.GetData().(*types.ReplyBlockHeight).Height)
+
+	msg = remote.NewMessage("blockchain", types.EventGetBlocks, &types.ReqBlocks{})
+	require.Nil(t, remote.Send(msg, true))
+	_, err = remote.WaitTimeout(msg, 5*time.Second)
+	assert.Equal(t, types.ErrNotFound, err)
+
+	//非protobuf的payload不能跨进程传递
+	msg = remote.NewMessage("blockchain", types.EventGetBlockHeight, "height")
+	assert.Equal(t, ErrInvalidPayload, remote.Send(msg, true))
+
+	//远程模块重连后继续接收消息
+	remote.Close()
+	_, ok := <-remote.Recv()
+	assert.False(t, ok)
+	assert.Equal(t, ErrIsQueueClosed, remote.Send(remote.NewMessage("blockchain", types.EventGetBlockHeight, nil), false))
+	server.mu.Lock()
+	sub := server.subs["wallet"]
+	server.mu.Unlock()
+	for sub.getConn() != nil {
+		time.Sleep(time.Millisecond)
+	}
+	msg = local.NewMessage("wallet", types.EventWalletExecutor, &types.ChainExecutor{FuncName: "WalletGetAccountList"})
+	require.Nil(t, local.Send(msg, true))
+	remote, err = Dial(remoteConfig(server.Addr()), "wallet", nil)
+	require.Nil(t, err)
+	defer remote.Close()
+	startRemoteWallet(remote)
+	reply, err = local.WaitTimeout(msg, 5*time.Second)
+	require.Nil(t, err)
+	assert.Equal(t, int64(types.EventWalletAccountList), reply.Ty)
+}
+
+func TestRemoteServerClose(t *testing.T) {
+	q := New("channel")
+	server, err := Listen(q, remoteConfig("tcp://127.0.0.1:0"))
+	require.Nil(t, err)
+	remote, err := Dial(remoteConfig(server.Addr()), "wallet", nil)
+	require.Nil(t, err)
+	remote.Sub("wallet")
+
+	//远程模块未回复时关闭服务，等待回复的消息返回ErrChannelClosed
+	local := q.Client()
+	msg := local.NewMessage("wallet", types.EventWalletExecutor, &types.ChainExecutor{FuncName: "WalletGetAccountList"})
+	require.Nil(t, local.Send(msg, true))
+	<-remote.Recv()
+	server.Close()
+	_, err = local.WaitTimeout(msg, 5*time.Second)
+	assert.Equal(t, types.ErrChannelClosed, err)
+
+	select {
+	case <-remote.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("remote client not closed")
+	}
+	msg = remote.NewMessage("blockchain", types.EventGetBlockHeight, nil)
+	assert.Equal(t, ErrIsQueueClosed, remote.Send(msg, true))
+
+	_, err = Listen(q, remoteConfig("127.0.0.1:0"))
+	assert.NotNil(t, err)
+	_, err = Dial(remoteConfig("unix://"+filepath.Join(os.TempDir(), "chain33-not-exist.sock")), "wallet", nil)
+	assert.NotNil(t, err)
+}
+
+func TestRemoteSecurity(t *testing.T) {
+	dir, err := ioutil.TempDir("", "queue")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	q := New("channel")
+	cfg := remoteConfig("unix://" + filepath.Join(dir, "queue.sock"))
+	cfg.Credentials = nil
+	_, err = Listen(q, cfg)
+	assert.NotNil(t, err)
+	//凭证只能订阅sidecars中的模块
+	cfg.Credentials = []*types.QueueCredential{{Name: "wallet", Secret: testSecret, Topics: []string{"blockchain"}}}
+	_, err = Listen(q, cfg)
+	assert.NotNil(t, err)
+	cfg = remoteConfig(cfg.ListenAddr)
+	server, err := Listen(q, cfg)
+	require.Nil(t, err)
+	defer server.Close()
+
+	//unix socket只允许当前用户访问
+	info, err := os.Stat(filepath.Join(dir, "queue.sock"))
+	require.Nil(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	//密钥错误时握手失败
+	wrong := remoteConfig(server.Addr())
+	wrong.Credentials[0].Secret = "wrong"
+	_, err = Dial(wrong, "wallet", nil)
+	assert.Equal(t, ErrAuthFailed, err)
+	wrong.Credentials[0].Name = "rpc"
+	_, err = Dial(wrong, "rpc", nil)
+	assert.Equal(t, ErrAuthFailed, err)
+	_, err = Dial(remoteConfig(server.Addr()), "rpc", nil)
+	assert.NotNil(t, err)
+
+	//不能关闭主进程的队列
+	remote, err := Dial(remoteConfig(server.Addr()), "wallet", nil)
+	require.Nil(t, err)
+	defer remote.Close()
+	_, err = remote.CloseQueue()
+	assert.Equal(t, types.ErrNotSupport, err)
+	require.Nil(t, remote.write(&types.QueueFrame{Kind: frameCloseQueue}))
+
+	startRemoteWallet(remote)
+	local := q.Client()
+	msg := local.NewMessage("wallet", types.EventWalletExecutor, &types.ChainExecutor{FuncName: "WalletGetAccountList"})
+	require.Nil(t, local.Send(msg, true))
+	_, err = local.WaitTimeout(msg, 5*time.Second)
+	require.Nil(t, err)
+	assert.False(t, q.(*queue).isClosed())
+
+	//只能订阅配置为独立进程运行的模块的topic
+	remote.Sub("blockchain")
+	select {
+	case <-remote.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("remote client not closed")
+	}
+
+	//tcp监听非回环地址时必须开启TLS
+	_, err = Listen(q, remoteConfig("tcp://0.0.0.0:0"))
+	assert.Equal(t, ErrTLSRequired, err)
+	_, err = Dial(remoteConfig("tcp://192.168.1.1:8805"), "wallet", nil)
+	assert.Equal(t, ErrTLSRequired, err)
+}
+
+func TestRemoteCredentials(t *testing.T) {
+	dir, err := ioutil.TempDir("", "queue")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	q := New("channel")
+	cfg := &types.Queue{ListenAddr: "unix://" + filepath.Join(dir, "queue.sock"), Sidecars: []string{"wallet", "rpc"},
+		Credentials: []*types.QueueCredential{
+			{Name: "wallet", Secret: testSecret, Topics: []string{"wallet"}},
+			{Name: "rpc", Secret: "chain33-rpc-secret", Topics: []string{"rpc"}},
+		}}
+	server, err := Listen(q, cfg)
+	require.Nil(t, err)
+	defer server.Close()
+
+	waitClosed := func(remote *RemoteClient) {
+		select {
+		case <-remote.Done():
+		case <-time.After(5 * time.Second):
+			t.Fatal("remote client not closed")
+		}
+	}
+	//凭证只能订阅自己的topic
+	rpc, err := Dial(cfg, "rpc", nil)
+	require.Nil(t, err)
+	rpc.Sub("wallet")
+	waitClosed(rpc)
+
+	//topic已经有远程模块订阅时，后连接的订阅被拒绝，不影响已有的订阅
+	remote, err := Dial(cfg, "wallet", nil)
+	require.Nil(t, err)
+	defer remote.Close()
+	startRemoteWallet(remote)
+	server.mu.Lock()
+	sub := server.subs["wallet"]
+	server.mu.Unlock()
+	for sub == nil || sub.getConn() == nil {
+		time.Sleep(time.Millisecond)
+		server.mu.Lock()
+		sub = server.subs["wallet"]
+		server.mu.Unlock()
+	}
+	other, err := Dial(cfg, "wallet", nil)
+	require.Nil(t, err)
+	other.Sub("wallet")
+	waitClosed(other)
+	local := q.Client()
+	msg := local.NewMessage("wallet", types.EventWalletExecutor, &types.ChainExecutor{FuncName: "WalletGetAccountList"})
+	require.Nil(t, local.Send(msg, true))
+	reply, err := local.WaitTimeout(msg, 5*time.Second)
+	require.Nil(t, err)
+	assert.Equal(t, "remote", reply.GetData().(*types.WalletAccounts).Wallets[0].Label)
+
+	//握手完成之前不接受大的帧
+	conn, err := net.Dial("unix", filepath.Join(dir, "queue.sock"))
+	require.Nil(t, err)
+	defer conn.Close()
+	fc := newFrameConn(conn)
+	frame, err := fc.read()
+	require.Nil(t, err)
+	assert.Equal(t, frameAuth, frame.Kind)
+	var head [4]byte
+	binary.BigEndian.PutUint32(head[:], maxHandshakeFrameSize+1)
+	_, err = conn.Write(head[:])
+	require.Nil(t, err)
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, err = fc.read()
+	assert.Equal(t, io.EOF, err)
+}
+
+//writeTestCert 生成127.0.0.1的自签名证书
+func writeTestCert(t *testing.T, dir string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "chain33"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.Nil(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.Nil(t, err)
+	certFile, keyFile := filepath.Join(dir, "queue.crt"), filepath.Join(dir, "queue.key")
+	require.Nil(t, ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.Nil(t, ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	return certFile, keyFile
+}
+
+func TestRemoteTLS(t *testing.T) {
+	dir, err := ioutil.TempDir("", "queue")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	q := New("channel")
+	cfg := remoteConfig("tcp://127.0.0.1:0")
+	cfg.CertFile, cfg.KeyFile = writeTestCert(t, dir)
+	server, err := Listen(q, cfg)
+	require.Nil(t, err)
+	defer server.Close()
+
+	//证书不匹配时连接失败
+	other, err := ioutil.TempDir("", "queue")
+	require.Nil(t, err)
+	defer os.RemoveAll(other)
+	wrong := remoteConfig(server.Addr())
+	wrong.CertFile, _ = writeTestCert(t, other)
+	_, err = Dial(wrong, "wallet", nil)
+	assert.NotNil(t, err)
+
+	dcfg := remoteConfig(server.Addr())
+	dcfg.CertFile = cfg.CertFile
+	remote, err := Dial(dcfg, "wallet", nil)
+	require.Nil(t, err)
+	defer remote.Close()
+	startRemoteWallet(remote)
+	local := q.Client()
+	msg := local.NewMessage("wallet", types.EventWalletExecutor, &types.ChainExecutor{FuncName: "WalletGetAccountList"})
+	require.Nil(t, local.Send(msg, true))
+	reply, err := local.WaitTimeout(msg, 5*time.Second)
+	require.Nil(t, err)
+	assert.Equal(t, "remote", reply.GetData().(*types.WalletAccounts).Wallets[0].Label)
+}
+
+func TestDecodeChainExecutor(t *testing.T) {
+	//空参数跨进程传递后还原为空参数，而不是nil
+	frame := &types.QueueFrame{}
+	require.Nil(t, encodePayload(frame, &types.ChainExecutor{FuncName: "WalletGetAccountList", Param: []byte{}}))
+	data, err := decodePayload(frame)
+	require.Nil(t, err)
+	assert.NotNil(t, data.(*types.ChainExecutor).Param)
+	assert.Equal(t, 0, len(data.(*types.ChainExecutor).Param))
+}
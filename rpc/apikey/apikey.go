@@ -0,0 +1,308 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package apikey rpc的api key认证，每个key有单独的方法权限、令牌桶限流以及使用统计，
+// jrpc、grpc以及eth rpc共用同一套策略，key的配置文件修改后自动重新加载
+package apikey
+
+import (
+	"io/ioutil"
+	"os"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/types"
+	tml "github.com/BurntSushi/toml"
+)
+
+var (
+	log = log15.New("module", "rpc.apikey")
+	//检查配置文件是否修改的间隔
+	reloadInterval = 10 * time.Second
+)
+
+// Header http请求头以及grpc metadata中携带api key的字段
+const Header = "X-Api-Key"
+
+// Key api key的配置
+type Key struct {
+	//名称，使用统计按名称记录
+	Name string `json:"name"`
+	Key  string `json:"key"`
+	//允许调用的方法，"*"表示允许所有方法，为空时不允许调用任何方法
+	Methods []string `json:"methods"`
+	//禁止调用的方法，优先于Methods
+	Blacklist []string `json:"blacklist"`
+	//每秒的请求数以及允许的突发请求数，为0时不限制
+	RequestsPerSecond int64 `json:"requestsPerSecond"`
+	RequestBurst      int64 `json:"requestBurst"`
+	//每秒的请求和返回的字节数以及允许的突发字节数，为0时不限制
+	BytesPerSecond int64 `json:"bytesPerSecond"`
+	BytesBurst     int64 `json:"bytesBurst"`
+}
+
+// Config api key配置文件的内容
+type Config struct {
+	Keys []*Key `json:"keys"`
+}
+
+// Usage api key的使用统计
+type Usage struct {
+	Name     string `json:"name"`
+	Requests int64  `json:"requests"`
+	Rejected int64  `json:"rejected"`
+	Bytes    int64  `json:"bytes"`
+}
+
+//tokenBucket 令牌桶，rate为0时不限制；返回数据的大小在处理之后才知道，允许令牌数为负，下次请求时拒绝
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate, burst int64) *tokenBucket {
+	if burst < rate {
+		burst = rate
+	}
+	return &tokenBucket{rate: float64(rate), burst: float64(burst), tokens: float64(burst), last: time.Now()}
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+}
+
+//allow 令牌足够时取出n个令牌
+func (b *tokenBucket) allow(n float64) bool {
+	if b.rate <= 0 {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill(time.Now())
+	if b.tokens < n {
+		return false
+	}
+	b.tokens -= n
+	return true
+}
+
+//take 强制取出n个令牌
+func (b *tokenBucket) take(n float64) {
+	if b.rate <= 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill(time.Now())
+	b.tokens -= n
+}
+
+// Client api key对应的客户端
+type Client struct {
+	key       *Key
+	methods   map[string]bool
+	blacklist map[string]bool
+	requests  *tokenBucket
+	bytes     *tokenBucket
+	usage     *Usage
+}
+
+func newClient(key *Key, usage *Usage) *Client {
+	c := &Client{
+		key:       key,
+		methods:   make(map[string]bool),
+		blacklist: make(map[string]bool),
+		requests:  newTokenBucket(key.RequestsPerSecond, key.RequestBurst),
+		bytes:     newTokenBucket(key.BytesPerSecond, key.BytesBurst),
+		usage:     usage,
+	}
+	for _, method := range key.Methods {
+		c.methods[method] = true
+	}
+	for _, method := range key.Blacklist {
+		c.blacklist[method] = true
+	}
+	return c
+}
+
+// Name api key的名称
+func (c *Client) Name() string {
+	return c.key.Name
+}
+
+// Allow 检查方法的权限以及请求数和字节数的限制，method为空时只做限流检查
+func (c *Client) Allow(method string, size int) error {
+	atomic.AddInt64(&c.usage.Requests, 1)
+	atomic.AddInt64(&c.usage.Bytes, int64(size))
+	if method != "" && (c.blacklist[method] || (!c.methods["*"] && !c.methods[method])) {
+		atomic.AddInt64(&c.usage.Rejected, 1)
+		return types.ErrAPIKeyMethodDenied
+	}
+	if !c.requests.allow(1) || !c.bytes.allow(float64(size)) {
+		atomic.AddInt64(&c.usage.Rejected, 1)
+		return types.ErrRateLimited
+	}
+	return nil
+}
+
+// AddBytes 记录返回数据的字节数
+func (c *Client) AddBytes(size int) {
+	atomic.AddInt64(&c.usage.Bytes, int64(size))
+	c.bytes.take(float64(size))
+}
+
+// Manager api key管理，配置文件修改后重新加载，同名key的使用统计保留
+type Manager struct {
+	file     string
+	required bool
+	mu       sync.RWMutex
+	clients  map[string]*Client //key --> client
+	usages   map[string]*Usage  //name --> usage
+	modTime  time.Time
+	quit     chan struct{}
+	once     sync.Once
+}
+
+// New 从配置文件加载api key，required为true时非本地请求必须携带api key
+func New(file string, required bool) (*Manager, error) {
+	m := &Manager{
+		file:     file,
+		required: required,
+		clients:  make(map[string]*Client),
+		usages:   make(map[string]*Usage),
+		quit:     make(chan struct{}),
+	}
+	err := m.reload()
+	if err != nil {
+		return nil, err
+	}
+	go m.reloadRoutine()
+	return m, nil
+}
+
+// Close 停止重新加载配置文件
+func (m *Manager) Close() {
+	if m == nil {
+		return
+	}
+	m.once.Do(func() {
+		close(m.quit)
+	})
+}
+
+func (m *Manager) reloadRoutine() {
+	ticker := time.NewTicker(reloadInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.quit:
+			return
+		case <-ticker.C:
+			info, err := os.Stat(m.file)
+			if err != nil {
+				log.Error("reloadRoutine", "file", m.file, "err", err)
+				continue
+			}
+			if info.ModTime().Equal(m.modTime) {
+				continue
+			}
+			err = m.reload()
+			if err != nil {
+				log.Error("reloadRoutine", "file", m.file, "err", err)
+			}
+		}
+	}
+}
+
+//reload 加载配置文件，加载失败时继续使用之前的配置
+func (m *Manager) reload() error {
+	info, err := os.Stat(m.file)
+	if err != nil {
+		return err
+	}
+	data, err := ioutil.ReadFile(m.file)
+	if err != nil {
+		return err
+	}
+	var cfg Config
+	_, err = tml.Decode(string(data), &cfg)
+	if err != nil {
+		return err
+	}
+	clients := make(map[string]*Client)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, key := range cfg.Keys {
+		if key.Key == "" || key.Name == "" {
+			log.Error("reload empty api key", "name", key.Name)
+			continue
+		}
+		if _, ok := clients[key.Key]; ok {
+			log.Error("reload duplicate api key", "name", key.Name)
+			continue
+		}
+		usage, ok := m.usages[key.Name]
+		if !ok {
+			usage = &Usage{Name: key.Name}
+			m.usages[key.Name] = usage
+		}
+		clients[key.Key] = newClient(key, usage)
+	}
+	m.clients = clients
+	m.modTime = info.ModTime()
+	log.Info("reload api keys", "file", m.file, "count", len(clients))
+	return nil
+}
+
+// Client 获取api key对应的客户端，没有携带api key时返回nil，由调用者使用原有的白名单检查
+func (m *Manager) Client(key string, isLoopback bool) (*Client, error) {
+	if m == nil {
+		return nil, nil
+	}
+	if key == "" {
+		if m.required && !isLoopback {
+			return nil, types.ErrAPIKeyRequired
+		}
+		return nil, nil
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	c, ok := m.clients[key]
+	if !ok {
+		return nil, types.ErrAPIKeyInvalid
+	}
+	return c, nil
+}
+
+// Usages 所有api key的使用统计，按名称排序
+func (m *Manager) Usages() []*Usage {
+	if m == nil {
+		return nil
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	usages := make([]*Usage, 0, len(m.usages))
+	for _, usage := range m.usages {
+		usages = append(usages, &Usage{
+			Name:     usage.Name,
+			Requests: atomic.LoadInt64(&usage.Requests),
+			Rejected: atomic.LoadInt64(&usage.Rejected),
+			Bytes:    atomic.LoadInt64(&usage.Bytes),
+		})
+	}
+	sort.Slice(usages, func(i, j int) bool {
+		return usages[i].Name < usages[j].Name
+	})
+	return usages
+}
@@ -0,0 +1,114 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package apikey
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+)
+
+const testKeys = `
+[[keys]]
+name="partnerA"
+key="keyA"
+methods=["*"]
+blacklist=["CloseQueue"]
+requestsPerSecond=1
+requestBurst=2
+
+[[keys]]
+name="partnerB"
+key="keyB"
+methods=["Version"]
+bytesPerSecond=10
+`
+
+func TestAPIKey(t *testing.T) {
+	reloadInterval = time.Millisecond * 10
+	defer func() {
+		reloadInterval = 10 * time.Second
+	}()
+	dir, err := ioutil.TempDir("", "apikey")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "apikey.toml")
+	require.Nil(t, ioutil.WriteFile(file, []byte(testKeys), 0600))
+	_, err = New(filepath.Join(dir, "notexist.toml"), false)
+	require.NotNil(t, err)
+
+	m, err := New(file, true)
+	require.Nil(t, err)
+	defer m.Close()
+
+	//没有携带api key时，只有本地请求允许使用原有的检查
+	cli, err := m.Client("", true)
+	require.Nil(t, err)
+	require.Nil(t, cli)
+	_, err = m.Client("", false)
+	require.Equal(t, types.ErrAPIKeyRequired, err)
+	_, err = m.Client("keyC", true)
+	require.Equal(t, types.ErrAPIKeyInvalid, err)
+
+	//方法权限以及请求数限制
+	a, err := m.Client("keyA", false)
+	require.Nil(t, err)
+	require.Equal(t, "partnerA", a.Name())
+	require.Equal(t, types.ErrAPIKeyMethodDenied, a.Allow("CloseQueue", 0))
+	require.Nil(t, a.Allow("GetBlocks", 10))
+	require.Nil(t, a.Allow("GetBlocks", 10))
+	require.Equal(t, types.ErrRateLimited, a.Allow("GetBlocks", 10))
+
+	//字节数限制，返回数据的字节数计入之后的请求
+	b, err := m.Client("keyB", false)
+	require.Nil(t, err)
+	require.Equal(t, types.ErrAPIKeyMethodDenied, b.Allow("GetBlocks", 0))
+	require.Nil(t, b.Allow("Version", 5))
+	b.AddBytes(10)
+	require.Equal(t, types.ErrRateLimited, b.Allow("Version", 1))
+
+	usages := m.Usages()
+	require.Equal(t, 2, len(usages))
+	require.Equal(t, &Usage{Name: "partnerA", Requests: 4, Rejected: 2, Bytes: 30}, usages[0])
+	require.Equal(t, &Usage{Name: "partnerB", Requests: 3, Rejected: 2, Bytes: 16}, usages[1])
+
+	//配置文件修改后重新加载，同名key的统计保留
+	m2, err := New(file, false)
+	require.Nil(t, err)
+	defer m2.Close()
+	_, err = m2.Client("", false)
+	require.Nil(t, err)
+	a, err = m2.Client("keyA", false)
+	require.Nil(t, err)
+	require.Nil(t, a.Allow("GetBlocks", 0))
+	require.Nil(t, ioutil.WriteFile(file, []byte(`
+[[keys]]
+name="partnerA"
+key="keyA2"
+methods=["Version"]
+`), 0600))
+	future := time.Now().Add(time.Second)
+	require.Nil(t, os.Chtimes(file, future, future))
+	require.Eventually(t, func() bool {
+		_, err := m2.Client("keyA", false)
+		return err == types.ErrAPIKeyInvalid
+	}, time.Second*5, time.Millisecond*10)
+	a, err = m2.Client("keyA2", false)
+	require.Nil(t, err)
+	require.Equal(t, types.ErrAPIKeyMethodDenied, a.Allow("GetBlocks", 0))
+	require.Equal(t, int64(2), m2.Usages()[0].Requests)
+
+	//nil manager不做api key检查
+	var nilManager *Manager
+	cli, err = nilManager.Client("keyA", false)
+	require.Nil(t, err)
+	require.Nil(t, cli)
+	require.Nil(t, nilManager.Usages())
+}
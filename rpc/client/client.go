@@ -26,6 +26,9 @@ import (
 
 var log = log15.New("module", "rpc_client")
 
+//单次获取状态证明的最大key数量
+const maxStateProofKeys = 100
+
 //ChannelClient ...
 type ChannelClient struct {
 	client.QueueProtocolAPI
@@ -386,6 +389,141 @@ func (c *ChannelClient) GetTotalCoins(in *types.ReqGetTotalCoins) (*types.ReplyG
 	return resp, nil
 }
 
+// GetStateProof 获取状态数据及其mavl证明，未指定stateHash时使用指定高度区块头中的stateHash，并返回该区块头
+func (c *ChannelClient) GetStateProof(in *types.ReqStateProof) (*types.ReplyStateProof, error) {
+	if in == nil || len(in.GetKeys()) == 0 {
+		return nil, types.ErrInvalidParam
+	}
+	if len(in.GetKeys()) > maxStateProofKeys {
+		return nil, types.ErrMaxCountPerTime
+	}
+	reply := &types.ReplyStateProof{}
+	stateHash := in.GetStateHash()
+	if len(stateHash) == 0 {
+		headers, err := c.GetHeaders(&types.ReqBlocks{Start: in.GetHeight(), End: in.GetHeight()})
+		if err != nil {
+			log.Error("GetStateProof", "height", in.GetHeight(), "err", err)
+			return nil, err
+		}
+		if len(headers.GetItems()) != 1 {
+			return nil, types.ErrBlockNotFound
+		}
+		reply.Header = headers.GetItems()[0]
+		stateHash = reply.Header.GetStateHash()
+	}
+	proof, err := c.StoreGetProof(&types.StoreGet{StateHash: stateHash, Keys: in.GetKeys()})
+	if err != nil {
+		log.Error("GetStateProof", "stateHash", common.ToHex(stateHash), "err", err)
+		return nil, err
+	}
+	reply.Proof = proof
+	return reply, nil
+}
+
+// SimulateTransaction 模拟执行交易或交易组，在指定状态上按最新区块的下一个区块执行，返回回执、状态数据修改前后的值以及手续费
+func (c *ChannelClient) SimulateTransaction(in *types.ReqSimulateTx) (*types.ReplySimulateTx, error) {
+	if in == nil || in.GetTx() == nil {
+		return nil, types.ErrInvalidParam
+	}
+	header, err := c.GetLastHeader()
+	if err != nil {
+		log.Error("SimulateTransaction", "GetLastHeader err", err)
+		return nil, err
+	}
+	stateHash := in.GetStateHash()
+	if len(stateHash) == 0 {
+		stateHash = header.GetStateHash()
+	}
+	txs := []*types.Transaction{in.GetTx()}
+	if in.GetTx().GetGroupCount() > 0 {
+		group, err := in.GetTx().GetTxGroup()
+		if err != nil {
+			return nil, err
+		}
+		txs = group.GetTxs()
+	}
+	for i, tx := range txs {
+		//未签名的交易使用指定的公钥计算发送地址，不修改调用者的交易
+		if len(tx.GetSignature().GetPubkey()) == 0 {
+			if len(in.GetPubKey()) == 0 {
+				return nil, types.ErrInvalidParam
+			}
+			signType := in.GetSignType()
+			if signType == 0 {
+				signType = types.SECP256K1
+			}
+			tx = tx.Clone()
+			tx.Signature = &types.Signature{Ty: signType, Pubkey: in.GetPubKey()}
+			txs[i] = tx
+		}
+	}
+	height := header.GetHeight() + 1
+	if !in.GetSkipSign() {
+		group := &types.Transactions{Txs: txs}
+		if !group.CheckSign(height) {
+			return nil, types.ErrSign
+		}
+	}
+	receipts, err := c.ExecTxList(&types.ExecTxList{
+		StateHash:  stateHash,
+		ParentHash: header.GetHash(),
+		Txs:        txs,
+		BlockTime:  types.Now().Unix(),
+		Height:     height,
+		Difficulty: uint64(header.GetDifficulty()),
+		BaseFee:    header.GetBaseFee(),
+	})
+	if err != nil {
+		log.Error("SimulateTransaction", "ExecTxList err", err)
+		return nil, err
+	}
+	if len(receipts.GetReceipts()) != len(txs) {
+		return nil, types.ErrInvalidParam
+	}
+	reply := &types.ReplySimulateTx{StateHash: stateHash, Height: height}
+	//交易组中之后的交易以之前交易修改后的值为修改前的值
+	values := make(map[string][]byte)
+	for i, receipt := range receipts.GetReceipts() {
+		result := &types.SimulateTxResult{
+			Hash:    txs[i].Hash(),
+			Receipt: &types.ReceiptData{Ty: receipt.GetTy(), Logs: receipt.GetLogs()},
+		}
+		var keys [][]byte
+		for _, kv := range receipt.GetKV() {
+			if _, ok := values[string(kv.GetKey())]; !ok {
+				keys = append(keys, kv.GetKey())
+			}
+		}
+		if len(keys) > 0 {
+			before, err := c.StoreGet(&types.StoreGet{StateHash: stateHash, Keys: keys})
+			if err != nil {
+				log.Error("SimulateTransaction", "StoreGet err", err)
+				return nil, err
+			}
+			for j, key := range keys {
+				if j < len(before.GetValues()) {
+					values[string(key)] = before.GetValues()[j]
+				}
+			}
+		}
+		for _, kv := range receipt.GetKV() {
+			result.Changes = append(result.Changes, &types.StateChange{Key: kv.GetKey(), Before: values[string(kv.GetKey())], After: kv.GetValue()})
+			values[string(kv.GetKey())] = kv.GetValue()
+		}
+		for _, l := range receipt.GetLogs() {
+			if l.GetTy() != types.TyLogFee {
+				continue
+			}
+			var fee types.ReceiptAccountTransfer
+			if err := types.Decode(l.GetLog(), &fee); err == nil {
+				result.Fee += fee.GetPrev().GetBalance() - fee.GetCurrent().GetBalance()
+			}
+		}
+		reply.Results = append(reply.Results, result)
+	}
+	return reply, nil
+}
+
 // DecodeRawTransaction decode rawtransaction
 func (c *ChannelClient) DecodeRawTransaction(param *types.ReqDecodeRawTransaction) (*types.Transaction, error) {
 	var tx types.Transaction
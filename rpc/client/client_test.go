@@ -325,6 +325,86 @@ func TestChannelClient_GetBalance(t *testing.T) {
 	testChannelClientGetBalanceOther(t)
 }
 
+func TestChannelClient_SimulateTransaction(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := &mocks.QueueProtocolAPI{}
+	client := &ChannelClient{QueueProtocolAPI: api}
+	_, err := client.SimulateTransaction(&types.ReqSimulateTx{})
+	assert.Equal(t, types.ErrInvalidParam, err)
+	head := &types.Header{Height: 9, StateHash: []byte("state9"), Hash: []byte("hash9")}
+	api.On("GetLastHeader").Return(head, nil)
+
+	//未签名的交易需要指定公钥，签名错误的交易不能执行
+	_, priv := util.Genaddress()
+	tx1 := util.CreateNoneTx(cfg, nil)
+	_, err = client.SimulateTransaction(&types.ReqSimulateTx{Tx: tx1})
+	assert.Equal(t, types.ErrInvalidParam, err)
+	tx1.Sign(types.SECP256K1, priv)
+	tx1.Signature.Signature[4]++
+	_, err = client.SimulateTransaction(&types.ReqSimulateTx{Tx: tx1})
+	assert.Equal(t, types.ErrSign, err)
+
+	//交易组中第二笔交易修改前的值为第一笔交易修改后的值
+	tx2 := util.CreateNoneTx(cfg, nil)
+	group, err := types.CreateTxGroup([]*types.Transaction{util.CreateNoneTx(cfg, nil), tx2}, cfg.GetMinTxFeeRate())
+	assert.Nil(t, err)
+	fee := &types.ReceiptAccountTransfer{Prev: &types.Account{Balance: 100}, Current: &types.Account{Balance: 90}}
+	receipts := &types.Receipts{Receipts: []*types.Receipt{
+		{Ty: types.ExecOk, KV: []*types.KeyValue{{Key: []byte("k1"), Value: []byte("v1")}, {Key: []byte("k2"), Value: []byte("v2")}},
+			Logs: []*types.ReceiptLog{{Ty: types.TyLogFee, Log: types.Encode(fee)}}},
+		{Ty: types.ExecOk, KV: []*types.KeyValue{{Key: []byte("k1"), Value: []byte("v3")}}},
+	}}
+	api.On("ExecTxList", mock.MatchedBy(func(list *types.ExecTxList) bool {
+		return string(list.StateHash) == "state9" && string(list.ParentHash) == "hash9" && list.Height == 10 && len(list.Txs) == 2 &&
+			string(list.Txs[1].GetSignature().GetPubkey()) == string(priv.PubKey().Bytes())
+	})).Return(receipts, nil)
+	api.On("StoreGet", &types.StoreGet{StateHash: []byte("state9"), Keys: [][]byte{[]byte("k1"), []byte("k2")}}).Return(
+		&types.StoreReplyValue{Values: [][]byte{[]byte("v0"), nil}}, nil)
+	reply, err := client.SimulateTransaction(&types.ReqSimulateTx{Tx: group.Tx(), SkipSign: true, PubKey: priv.PubKey().Bytes()})
+	assert.Nil(t, err)
+	assert.Nil(t, tx2.GetSignature())
+	assert.Equal(t, int64(10), reply.Height)
+	assert.Equal(t, head.StateHash, reply.StateHash)
+	assert.Equal(t, 2, len(reply.Results))
+	assert.Equal(t, tx2.Hash(), reply.Results[1].Hash)
+	assert.Equal(t, int64(10), reply.Results[0].Fee)
+	assert.Equal(t, int64(0), reply.Results[1].Fee)
+	assert.Equal(t, []*types.StateChange{
+		{Key: []byte("k1"), Before: []byte("v0"), After: []byte("v1")},
+		{Key: []byte("k2"), After: []byte("v2")},
+	}, reply.Results[0].Changes)
+	assert.Equal(t, []*types.StateChange{{Key: []byte("k1"), Before: []byte("v1"), After: []byte("v3")}}, reply.Results[1].Changes)
+
+	//签名检查失败
+	_, err = client.SimulateTransaction(&types.ReqSimulateTx{Tx: group.Tx(), PubKey: priv.PubKey().Bytes()})
+	assert.Equal(t, types.ErrSign, err)
+}
+
+func TestChannelClient_GetStateProof(t *testing.T) {
+	api := &mocks.QueueProtocolAPI{}
+	client := &ChannelClient{QueueProtocolAPI: api}
+	_, err := client.GetStateProof(&types.ReqStateProof{})
+	assert.Equal(t, types.ErrInvalidParam, err)
+	_, err = client.GetStateProof(&types.ReqStateProof{Keys: make([][]byte, maxStateProofKeys+1)})
+	assert.Equal(t, types.ErrMaxCountPerTime, err)
+
+	keys := [][]byte{[]byte("k1")}
+	head := &types.Header{Height: 5, StateHash: []byte("state5")}
+	api.On("GetHeaders", &types.ReqBlocks{Start: 5, End: 5}).Return(&types.Headers{Items: []*types.Header{head}}, nil)
+	api.On("GetHeaders", &types.ReqBlocks{Start: 6, End: 6}).Return(&types.Headers{}, nil)
+	api.On("StoreGetProof", &types.StoreGet{StateHash: head.StateHash, Keys: keys}).Return(&types.StateProof{StateHash: head.StateHash}, nil)
+	api.On("StoreGetProof", &types.StoreGet{StateHash: []byte("state"), Keys: keys}).Return(nil, types.ErrActionNotSupport)
+
+	reply, err := client.GetStateProof(&types.ReqStateProof{Height: 5, Keys: keys})
+	assert.Nil(t, err)
+	assert.Equal(t, head, reply.Header)
+	assert.Equal(t, head.StateHash, reply.Proof.StateHash)
+	_, err = client.GetStateProof(&types.ReqStateProof{Height: 6, Keys: keys})
+	assert.Equal(t, types.ErrBlockNotFound, err)
+	_, err = client.GetStateProof(&types.ReqStateProof{StateHash: []byte("state"), Keys: keys})
+	assert.Equal(t, types.ErrActionNotSupport, err)
+}
+
 func TestChannelClient_GetTotalCoins(t *testing.T) {
 	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
 	client := new(ChannelClient)
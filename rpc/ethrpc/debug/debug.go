@@ -0,0 +1,141 @@
+package debug
+
+import (
+	"github.com/33cn/chain33/client"
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/queue"
+	rpcclient "github.com/33cn/chain33/rpc/client"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	ctypes "github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	ecommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+var (
+	log = log15.New("module", "ethrpc_debug")
+)
+
+type debugHandler struct {
+	cli    rpcclient.ChannelClient
+	client queue.Client
+	cfg    *ctypes.Chain33Config
+}
+
+//KV 交易执行写入的状态
+type KV struct {
+	Key   hexutil.Bytes `json:"key"`
+	Value hexutil.Bytes `json:"value"`
+}
+
+//TxTrace 交易在父区块状态上重新执行的结果
+type TxTrace struct {
+	TxHash           ecommon.Hash                `json:"txHash"`
+	BlockHash        ecommon.Hash                `json:"blockHash"`
+	BlockNumber      hexutil.Uint64              `json:"blockNumber"`
+	TransactionIndex hexutil.Uint64              `json:"transactionIndex"`
+	Failed           bool                        `json:"failed"`
+	StateWrites      []*KV                       `json:"stateWrites"`
+	Receipt          *rpctypes.ReceiptDataResult `json:"receipt"`
+}
+
+//NewDebugAPI create a debug api
+func NewDebugAPI(cfg *ctypes.Chain33Config, c queue.Client, api client.QueueProtocolAPI) interface{} {
+	d := &debugHandler{}
+	d.cli.Init(c, api)
+	d.client = c
+	d.cfg = cfg
+	return d
+}
+
+//TraceTransaction debug_traceTransaction
+//以交易所在区块的父区块状态为起点，重新执行区块中该交易及之前的交易，返回该交易写入的状态和回执日志；
+//交易组内的交易需要整组执行，localdb为当前状态，依赖localdb的执行结果可能和上链时不同
+func (d *debugHandler) TraceTransaction(txhash ecommon.Hash) (*TxTrace, error) {
+	detail, err := d.cli.QueryTx(&ctypes.ReqHash{Hash: txhash.Bytes()})
+	if err != nil {
+		log.Error("TraceTransaction", "txHash", txhash.String(), "QueryTx err", err)
+		return nil, err
+	}
+	height := detail.GetHeight()
+	if height <= 0 {
+		return nil, ctypes.ErrInvalidParam
+	}
+	blocks, err := d.cli.GetBlocks(&ctypes.ReqBlocks{Start: height - 1, End: height})
+	if err != nil {
+		log.Error("TraceTransaction", "height", height, "GetBlocks err", err)
+		return nil, err
+	}
+	if len(blocks.GetItems()) != 2 {
+		return nil, ctypes.ErrBlockNotFound
+	}
+	parent, block := blocks.Items[0].GetBlock(), blocks.Items[1].GetBlock()
+	index := int(detail.GetIndex())
+	end := groupEnd(block.GetTxs(), index)
+	if end < 0 {
+		return nil, ctypes.ErrTxGroupCount
+	}
+	receipts, err := util.ExecTx(d.client, parent.GetStateHash(), &ctypes.Block{
+		ParentHash: block.ParentHash,
+		MainHash:   block.MainHash,
+		MainHeight: block.MainHeight,
+		Txs:        block.Txs[:end],
+		BlockTime:  block.BlockTime,
+		Height:     block.Height,
+		Difficulty: block.Difficulty,
+		BaseFee:    block.BaseFee,
+	})
+	if err != nil {
+		log.Error("TraceTransaction", "height", height, "ExecTx err", err)
+		return nil, err
+	}
+	if len(receipts.GetReceipts()) != end {
+		return nil, ctypes.ErrInvalidParam
+	}
+	receipt := receipts.Receipts[index]
+	trace := &TxTrace{
+		TxHash:           txhash,
+		BlockHash:        ecommon.BytesToHash(block.Hash(d.cfg)),
+		BlockNumber:      hexutil.Uint64(height),
+		TransactionIndex: hexutil.Uint64(index),
+		Failed:           receipt.GetTy() != ctypes.ExecOk,
+		StateWrites:      make([]*KV, 0, len(receipt.GetKV())),
+	}
+	for _, kv := range receipt.GetKV() {
+		trace.StateWrites = append(trace.StateWrites, &KV{Key: kv.GetKey(), Value: kv.GetValue()})
+	}
+	rdata := &rpctypes.ReceiptData{Ty: receipt.GetTy()}
+	for _, l := range receipt.GetLogs() {
+		rdata.Logs = append(rdata.Logs, &rpctypes.ReceiptLog{Ty: l.GetTy(), Log: common.ToHex(l.GetLog())})
+	}
+	trace.Receipt, err = rpctypes.DecodeLog(block.Txs[index].GetExecer(), rdata)
+	if err != nil {
+		return nil, err
+	}
+	return trace, nil
+}
+
+//groupEnd 执行到index处交易需要的交易数，index处于交易组内时包含整个交易组，交易组越界时返回-1
+func groupEnd(txs []*ctypes.Transaction, index int) int {
+	if index < 0 || index >= len(txs) {
+		return -1
+	}
+	for i := 0; i < len(txs); i++ {
+		count := int(txs[i].GetGroupCount())
+		if count <= 1 {
+			if i >= index {
+				return i + 1
+			}
+			continue
+		}
+		if i+count > len(txs) {
+			return -1
+		}
+		if i+count > index {
+			return i + count
+		}
+		i += count - 1
+	}
+	return -1
+}
@@ -0,0 +1,81 @@
+package debug
+
+import (
+	"testing"
+
+	clientMocks "github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/queue"
+	ctypes "github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDebugHandler_TraceTransaction(t *testing.T) {
+	cfg := ctypes.NewChain33Config(ctypes.GetDefaultCfgstring())
+	q := queue.New("test")
+	q.SetConfig(cfg)
+	defer q.Close()
+	qapi := &clientMocks.QueueProtocolAPI{}
+	d := NewDebugAPI(cfg, q.Client(), qapi).(*debugHandler)
+
+	_, priv := util.Genaddress()
+	var txs []*ctypes.Transaction
+	for i := 0; i < 3; i++ {
+		txs = append(txs, util.CreateNoneTx(cfg, priv))
+	}
+	parent := &ctypes.Block{Height: 1, StateHash: []byte("parentState")}
+	block := &ctypes.Block{Height: 2, ParentHash: parent.Hash(cfg), BlockTime: 100, Txs: txs}
+	target := txs[1].Hash()
+	qapi.On("QueryTx", &ctypes.ReqHash{Hash: target}).Return(&ctypes.TransactionDetail{Height: 2, Index: 1}, nil)
+	qapi.On("GetBlocks", &ctypes.ReqBlocks{Start: 1, End: 2}).Return(&ctypes.BlockDetails{
+		Items: []*ctypes.BlockDetail{{Block: parent}, {Block: block}}}, nil)
+
+	//模拟执行器，在父区块状态上执行到目标交易为止
+	execs := q.Client()
+	execs.Sub("execs")
+	go func() {
+		for msg := range execs.Recv() {
+			list := msg.GetData().(*ctypes.ExecTxList)
+			assert.Equal(t, parent.StateHash, list.StateHash)
+			assert.Equal(t, block.BlockTime, list.BlockTime)
+			assert.Equal(t, 2, len(list.Txs))
+			fee := &ctypes.ReceiptAccountTransfer{Prev: &ctypes.Account{Balance: 10}, Current: &ctypes.Account{Balance: 9}}
+			receipts := &ctypes.Receipts{Receipts: []*ctypes.Receipt{
+				{Ty: ctypes.ExecOk},
+				{Ty: ctypes.ExecOk, KV: []*ctypes.KeyValue{{Key: []byte("mavl-none-key"), Value: []byte("value")}},
+					Logs: []*ctypes.ReceiptLog{{Ty: ctypes.TyLogFee, Log: ctypes.Encode(fee)}}},
+			}}
+			msg.Reply(execs.NewMessage("", ctypes.EventReceipts, receipts))
+		}
+	}()
+
+	trace, err := d.TraceTransaction(common.BytesToHash(target))
+	assert.Nil(t, err)
+	assert.Equal(t, common.BytesToHash(block.Hash(cfg)), trace.BlockHash)
+	assert.Equal(t, uint64(2), uint64(trace.BlockNumber))
+	assert.Equal(t, uint64(1), uint64(trace.TransactionIndex))
+	assert.False(t, trace.Failed)
+	assert.Equal(t, 1, len(trace.StateWrites))
+	assert.Equal(t, "mavl-none-key", string(trace.StateWrites[0].Key))
+	assert.Equal(t, "value", string(trace.StateWrites[0].Value))
+	assert.Equal(t, "ExecOk", trace.Receipt.TyName)
+	assert.Equal(t, 1, len(trace.Receipt.Logs))
+	assert.Equal(t, "LogFee", trace.Receipt.Logs[0].TyName)
+
+	//创世区块中的交易没有父区块
+	qapi.On("QueryTx", &ctypes.ReqHash{Hash: txs[0].Hash()}).Return(&ctypes.TransactionDetail{Height: 0}, nil)
+	_, err = d.TraceTransaction(common.BytesToHash(txs[0].Hash()))
+	assert.Equal(t, ctypes.ErrInvalidParam, err)
+}
+
+func TestGroupEnd(t *testing.T) {
+	txs := []*ctypes.Transaction{{}, {GroupCount: 3}, {GroupCount: 3}, {GroupCount: 3}, {}}
+	assert.Equal(t, 1, groupEnd(txs, 0))
+	assert.Equal(t, 4, groupEnd(txs, 1))
+	assert.Equal(t, 4, groupEnd(txs, 2))
+	assert.Equal(t, 4, groupEnd(txs, 3))
+	assert.Equal(t, 5, groupEnd(txs, 4))
+	assert.Equal(t, -1, groupEnd(txs, 5))
+	assert.Equal(t, -1, groupEnd(txs[:3], 1))
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/big"
 	"net"
+	"strings"
 	"sync"
 	"time"
 
@@ -44,6 +45,12 @@ var (
 	log = log15.New("module", "ethrpc_eth")
 )
 
+//eth_feeHistory 最多查询的区块数量
+const maxFeeHistory = 1024
+
+//evm合约存储槽在localdb中的key前缀，完整的key为前缀+合约地址+":"+存储槽
+const evmStatePrefix = "LODB-evm-state:"
+
 //NewEthAPI new eth api
 func NewEthAPI(cfg *ctypes.Chain33Config, c queue.Client, api client.QueueProtocolAPI) interface{} {
 	e := &ethHandler{}
@@ -247,6 +254,138 @@ func (e *ethHandler) GetBlockTransactionCountByHash(hash common.Hash) (hexutil.U
 	return hexutil.Uint64(len(blockdetails.GetItems()[0].GetBlock().GetTxs())), nil
 }
 
+//GetTransactionByBlockNumberAndIndex eth_getTransactionByBlockNumberAndIndex
+//parameters: 区块高度或者"latest","earliest","pending"，交易在区块中的序号
+func (e *ethHandler) GetTransactionByBlockNumberAndIndex(tag string, index hexutil.Uint) (*types.Transaction, error) {
+	log.Debug("GetTransactionByBlockNumberAndIndex", "tag", tag, "index", index)
+	detail, err := e.blockDetailByNumber(tag)
+	if err != nil {
+		log.Error("GetTransactionByBlockNumberAndIndex", "err", err)
+		return nil, err
+	}
+	return e.blockTxByIndex(detail, uint64(index))
+}
+
+//GetTransactionByBlockHashAndIndex eth_getTransactionByBlockHashAndIndex
+//parameters: 区块哈希，交易在区块中的序号
+func (e *ethHandler) GetTransactionByBlockHashAndIndex(hash common.Hash, index hexutil.Uint) (*types.Transaction, error) {
+	log.Debug("GetTransactionByBlockHashAndIndex", "hash", hash, "index", index)
+	detail, err := e.blockDetailByHash(hash)
+	if err != nil {
+		log.Error("GetTransactionByBlockHashAndIndex", "err", err)
+		return nil, err
+	}
+	return e.blockTxByIndex(detail, uint64(index))
+}
+
+//GetBlockReceipts eth_getBlockReceipts 获取区块中所有交易的回执
+//parameters: 区块哈希、区块高度或者"latest","earliest","pending"
+func (e *ethHandler) GetBlockReceipts(in string) ([]*types.Receipt, error) {
+	log.Debug("GetBlockReceipts", "param", in)
+	var detail *ctypes.BlockDetail
+	var err error
+	if len(common.FromHex(in)) == common.HashLength {
+		detail, err = e.blockDetailByHash(common.HexToHash(in))
+	} else {
+		detail, err = e.blockDetailByNumber(in)
+	}
+	if err != nil {
+		log.Error("GetBlockReceipts", "err", err)
+		return nil, err
+	}
+	//区块不存在时返回空，与ethereum 保持一致
+	if detail == nil {
+		return nil, nil
+	}
+	block := detail.GetBlock()
+	if len(detail.GetReceipts()) != len(block.GetTxs()) {
+		return nil, errors.New("block receipts not match txs")
+	}
+	var txdetails ctypes.TransactionDetails
+	for i, tx := range block.GetTxs() {
+		txdetails.Txs = append(txdetails.Txs, &ctypes.TransactionDetail{
+			Tx:        tx,
+			Receipt:   detail.GetReceipts()[i],
+			Height:    block.GetHeight(),
+			Index:     int64(i),
+			Blocktime: block.GetBlockTime(),
+		})
+	}
+	_, receipts, err := types.TxDetailsToEthReceipts(&txdetails, common.BytesToHash(block.Hash(e.cfg)), e.cfg)
+	if err != nil {
+		return nil, err
+	}
+	if receipts == nil {
+		receipts = []*types.Receipt{}
+	}
+	return receipts, nil
+}
+
+//GetUncleCountByBlockNumber eth_getUncleCountByBlockNumber chain33没有叔块，固定返回0
+func (e *ethHandler) GetUncleCountByBlockNumber(tag string) (hexutil.Uint, error) {
+	return 0, nil
+}
+
+//GetUncleCountByBlockHash eth_getUncleCountByBlockHash chain33没有叔块，固定返回0
+func (e *ethHandler) GetUncleCountByBlockHash(hash common.Hash) (hexutil.Uint, error) {
+	return 0, nil
+}
+
+//blockDetailByNumber 根据区块高度或者"latest","earliest","pending"获取区块详情
+func (e *ethHandler) blockDetailByNumber(tag string) (*ctypes.BlockDetail, error) {
+	var height int64
+	switch tag {
+	case "earliest":
+	case "latest", "pending", "":
+		header, err := e.cli.GetLastHeader()
+		if err != nil {
+			return nil, err
+		}
+		height = header.GetHeight()
+	default:
+		num, err := hexutil.DecodeUint64(tag)
+		if err != nil {
+			return nil, err
+		}
+		height = int64(num)
+	}
+	details, err := e.cli.GetBlocks(&ctypes.ReqBlocks{Start: height, End: height, IsDetail: true})
+	if err != nil {
+		return nil, err
+	}
+	if len(details.GetItems()) == 0 {
+		return nil, nil
+	}
+	return details.GetItems()[0], nil
+}
+
+//blockDetailByHash 根据区块哈希获取区块详情，区块不存在时返回nil
+func (e *ethHandler) blockDetailByHash(hash common.Hash) (*ctypes.BlockDetail, error) {
+	details, err := e.cli.GetBlockByHashes(&ctypes.ReqHashes{Hashes: [][]byte{hash.Bytes()}})
+	if err != nil {
+		return nil, err
+	}
+	if len(details.GetItems()) == 0 || details.GetItems()[0].GetBlock() == nil {
+		return nil, nil
+	}
+	return details.GetItems()[0], nil
+}
+
+//blockTxByIndex 区块中指定序号的交易，区块不存在或者序号超出范围时返回空
+func (e *ethHandler) blockTxByIndex(detail *ctypes.BlockDetail, index uint64) (*types.Transaction, error) {
+	block := detail.GetBlock()
+	if index >= uint64(len(block.GetTxs())) {
+		return nil, nil
+	}
+	txs, _, err := types.TxsToEthTxs(common.BytesToHash(block.Hash(e.cfg)), block.GetHeight(), block.GetTxs()[index:index+1], e.cfg, true)
+	if err != nil || len(txs) == 0 {
+		return nil, err
+	}
+	tx := txs[0].(*types.Transaction)
+	tx.TransactionIndex = (*hexutil.Uint64)(&index)
+	return tx, nil
+}
+
 //Accounts eth_accounts
 func (e *ethHandler) Accounts() ([]string, error) {
 	log.Debug("Accounts", "Accounts", "")
@@ -612,10 +751,25 @@ func (e *ethHandler) EstimateGas(callMsg *types.CallMsg) (hexutil.Uint64, error)
 
 }
 
-//GasPrice  eth_gasPrice default 10 gwei
+//GasPrice  eth_gasPrice 最小交易费率对应10 gwei，开启基础手续费之后按下一个区块的基础手续费换算
 func (e *ethHandler) GasPrice() (*hexutil.Big, error) {
 	log.Debug("GasPrice", "eth_gasPrice ", "")
-	return (*hexutil.Big)(new(big.Int).Div(big.NewInt(1e18), big.NewInt(e.cfg.GetCoinPrecision()))), nil
+	header, err := e.cli.GetLastHeader()
+	if err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(e.feeRateToWei(ctypes.CalcBaseFee(e.cfg, header))), nil
+}
+
+//feeRateToWei 把交易费率换算成eth的gasPrice，费率不低于最小交易费率
+func (e *ethHandler) feeRateToWei(feeRate int64) *big.Int {
+	price := new(big.Int).Div(big.NewInt(1e18), big.NewInt(e.cfg.GetCoinPrecision()))
+	minFee := e.cfg.GetMinTxFeeRate()
+	if minFee <= 0 || feeRate <= minFee {
+		return price
+	}
+	price.Mul(price, big.NewInt(feeRate))
+	return price.Div(price, big.NewInt(minFee))
 }
 
 //Hashrate
@@ -691,6 +845,26 @@ func (e *ethHandler) GetCode(addr *common.Address, tag string) (*hexutil.Bytes,
 
 }
 
+//GetStorageAt eth_getStorageAt 获取合约存储槽的值，evm合约的状态存储在localdb中，只支持查询最新的状态
+func (e *ethHandler) GetStorageAt(addr common.Address, key string, tag *string) (hexutil.Bytes, error) {
+	log.Debug("eth_getStorageAt", "addr", addr, "key", key)
+	if tag != nil && *tag != "latest" {
+		return nil, errors.New("only latest block is supported")
+	}
+	storageKey := evmStatePrefix + strings.ToLower(addr.Hex()) + ":" + common.HexToHash(key).Hex()
+	reply, err := e.cli.LocalGet(&ctypes.LocalDBGet{Keys: [][]byte{[]byte(storageKey)}})
+	if err != nil {
+		log.Error("eth_getStorageAt", "LocalGet err", err)
+		return nil, err
+	}
+	//没有设置过的存储槽返回0
+	var value common.Hash
+	if values := reply.GetValues(); len(values) != 0 {
+		value = common.BytesToHash(values[0])
+	}
+	return value.Bytes(), nil
+}
+
 //HistoryParam ...
 type HistoryParam struct {
 	BlockCount  hexutil.Uint64
@@ -706,15 +880,58 @@ func (e *ethHandler) FeeHistory(BlockCount, tag string, options []interface{}) (
 		return nil, err
 	}
 	latestBlockNum := header.GetHeight()
+	newest := latestBlockNum
+	if len(common.FromHex(tag)) != 0 {
+		newest = new(big.Int).SetBytes(common.FromHex(tag)).Int64()
+		if newest > latestBlockNum {
+			newest = latestBlockNum
+		}
+	}
+	count := new(big.Int).SetBytes(common.FromHex(BlockCount)).Int64()
+	if count > maxFeeHistory {
+		count = maxFeeHistory
+	}
 	var result struct {
-		OldestBlock   hexutil.Uint64 `json:"oldestBlock,omitempty"`
-		Reward        []interface{}  `json:"reward,omitempty"`
+		OldestBlock   hexutil.Uint64 `json:"oldestBlock"`
+		Reward        [][]string     `json:"reward,omitempty"`
 		BaseFeePerGas []string       `json:"baseFeePerGas,omitempty"`
-		GasUsedRatio  []float64      `json:"gasUsedRatio,omitempty"`
+		GasUsedRatio  []float64      `json:"gasUsedRatio"`
+	}
+	result.GasUsedRatio = []float64{}
+	if count <= 0 {
+		result.OldestBlock = hexutil.Uint64(newest)
+		return &result, nil
+	}
+	oldest := newest - count + 1
+	if oldest < 0 {
+		oldest = 0
+	}
+	headers, err := e.cli.GetHeaders(&ctypes.ReqBlocks{Start: oldest, End: newest})
+	if err != nil {
+		return nil, err
+	}
+	result.OldestBlock = hexutil.Uint64(oldest)
+	//chain33的交易没有小费，reward按请求的百分位全部为0
+	for _, item := range headers.GetItems() {
+		result.BaseFeePerGas = append(result.BaseFeePerGas, hexutil.EncodeBig(e.feeRateToWei(item.GetBaseFee())))
+		var ratio float64
+		if maxTxNumber := e.cfg.GetP(item.GetHeight()).MaxTxNumber; maxTxNumber > 0 {
+			ratio = float64(item.GetTxCount()) / float64(maxTxNumber)
+		}
+		result.GasUsedRatio = append(result.GasUsedRatio, ratio)
+		if len(options) > 0 {
+			reward := make([]string, len(options))
+			for i := range reward {
+				reward[i] = "0x0"
+			}
+			result.Reward = append(result.Reward, reward)
+		}
+	}
+	//最后一个元素为下一个区块的基础手续费
+	if items := headers.GetItems(); len(items) > 0 {
+		next := ctypes.CalcBaseFee(e.cfg, items[len(items)-1])
+		result.BaseFeePerGas = append(result.BaseFeePerGas, hexutil.EncodeBig(e.feeRateToWei(next)))
 	}
-	result.OldestBlock = hexutil.Uint64(latestBlockNum)
-	result.BaseFeePerGas = []string{"0x12", "0x10", "0x10", "0x10", "0x10"}
-	result.GasUsedRatio = []float64{0.99, 0.99, 0.99, 0.99, 0.99}
 	return &result, nil
 }
 
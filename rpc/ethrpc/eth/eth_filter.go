@@ -7,6 +7,7 @@ import (
 
 	"github.com/33cn/chain33/rpc/ethrpc/types"
 	ctypes "github.com/33cn/chain33/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
@@ -18,6 +19,10 @@ const (
 	UnknownSubscription Type = iota
 	// LogsSubscription queries for new or removed (chain reorg) logs
 	LogsSubscription
+	// BlocksSubscription queries hashes for blocks that are imported
+	BlocksSubscription
+	// PendingTransactionsSubscription queries hashes for pending transactions entering the pending state
+	PendingTransactionsSubscription
 )
 
 type filter struct {
@@ -25,8 +30,11 @@ type filter struct {
 	deadline *time.Timer // filter is inactiv when deadline triggers
 	crit     *types.FilterQuery
 	logs     []*types.EvmLog
+	hashes   []common.Hash
 	done     chan struct{}
 	timeout  time.Duration
+	//区块filter已经返回的最大区块高度
+	height int64
 }
 
 //NewFilter eth_newFilter
@@ -59,6 +67,93 @@ func (e *ethHandler) NewFilter(options *types.FilterQuery) (*rpc.ID, error) {
 	return &id, nil
 }
 
+//NewBlockFilter eth_newBlockFilter 创建新区块的filter，通过eth_getFilterChanges获取filter创建之后新增区块的哈希，
+//新增区块在查询时从本地区块链获取，不占用blockchain的推送订阅
+func (e *ethHandler) NewBlockFilter() (*rpc.ID, error) {
+	header, err := e.cli.GetLastHeader()
+	if err != nil {
+		return nil, err
+	}
+	id := rpc.NewID()
+	e.filtersMu.Lock()
+	e.filters[id] = &filter{typ: BlocksSubscription, timeout: e.filterTimeout, deadline: time.NewTimer(e.filterTimeout),
+		height: header.GetHeight(), done: make(chan struct{})}
+	e.filtersMu.Unlock()
+	return &id, nil
+}
+
+//newBlockHashes 获取区块filter上次查询之后新增的区块哈希，每次最多返回MaxHeaderCountPerTime个区块
+func (e *ethHandler) newBlockHashes(f *filter) ([]common.Hash, error) {
+	header, err := e.cli.GetLastHeader()
+	if err != nil {
+		return nil, err
+	}
+	hashes := make([]common.Hash, 0)
+	end := header.GetHeight()
+	if end <= f.height {
+		return hashes, nil
+	}
+	if end-f.height > ctypes.MaxHeaderCountPerTime {
+		end = f.height + ctypes.MaxHeaderCountPerTime
+	}
+	headers, err := e.cli.GetHeaders(&ctypes.ReqBlocks{Start: f.height + 1, End: end})
+	if err != nil {
+		return nil, err
+	}
+	for _, header := range headers.GetItems() {
+		hashes = append(hashes, common.BytesToHash(header.GetHash()))
+	}
+	f.height = end
+	return hashes, nil
+}
+
+//NewPendingTransactionFilter eth_newPendingTransactionFilter 创建mempool交易的filter，通过eth_getFilterChanges获取新加入mempool的交易哈希
+func (e *ethHandler) NewPendingTransactionFilter() (*rpc.ID, error) {
+	in := &ctypes.ReqSubscribe{Type: PendingTxEvent}
+	return e.newHashFilter(PendingTransactionsSubscription, in, func(data *ctypes.PushData) (hashes []common.Hash) {
+		for _, tx := range data.GetMempoolTxs().GetTxs() {
+			hashes = append(hashes, common.BytesToHash(tx.Hash()))
+		}
+		return hashes
+	})
+}
+
+//newHashFilter 通过grpc订阅推送，推送的数据转换成区块或者交易哈希缓存在filter中，filter删除时取消订阅
+func (e *ethHandler) newHashFilter(typ Type, in *ctypes.ReqSubscribe, toHashes func(*ctypes.PushData) []common.Hash) (*rpc.ID, error) {
+	id := rpc.NewID()
+	in.Name = string(id)
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := e.grpcCli.SubEvent(ctx, in)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	f := &filter{typ: typ, timeout: e.filterTimeout, deadline: time.NewTimer(e.filterTimeout),
+		hashes: make([]common.Hash, 0), done: make(chan struct{})}
+	e.filtersMu.Lock()
+	e.filters[id] = f
+	e.filtersMu.Unlock()
+	go func() {
+		<-f.done
+		e.grpcCli.UnSubEvent(context.Background(), &ctypes.ReqString{Data: string(id)})
+		cancel()
+	}()
+	go func() {
+		for {
+			msg, err := stream.Recv()
+			if err != nil {
+				log.Debug("newHashFilter read", "id", id, "err", err)
+				return
+			}
+			hashes := toHashes(msg)
+			e.filtersMu.Lock()
+			f.hashes = append(f.hashes, hashes...)
+			e.filtersMu.Unlock()
+		}
+	}()
+	return &id, nil
+}
+
 //UninstallFilter 取消filter eth_uninstallFilter
 func (e *ethHandler) UninstallFilter(id rpc.ID) bool {
 	e.filtersMu.Lock()
@@ -112,6 +207,12 @@ func (e *ethHandler) GetFilterChanges(id rpc.ID) (interface{}, error) {
 			logs := f.logs
 			f.logs = nil
 			return returnLogs(logs), nil
+		case BlocksSubscription:
+			return e.newBlockHashes(f)
+		case PendingTransactionsSubscription:
+			hashes := f.hashes
+			f.hashes = make([]common.Hash, 0)
+			return hashes, nil
 
 		default:
 			return nil, fmt.Errorf("no support")
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	"github.com/33cn/chain33/rpc/ethrpc/types"
@@ -17,6 +18,8 @@ const (
 	HeadEvent = 1
 	//EvmEvent 获取evm 事件
 	EvmEvent = 4
+	//PendingTxEvent 获取mempool新加入的交易
+	PendingTxEvent = 5
 )
 
 //NewHeads ...
@@ -65,6 +68,47 @@ func (e *ethHandler) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
 	return subscription, nil
 }
 
+//NewPendingTransactions ...
+//eth_subscribe
+//params:["newPendingTransactions"]
+//推送新加入mempool的交易哈希
+func (e *ethHandler) NewPendingTransactions(ctx context.Context) (*rpc.Subscription, error) {
+	log.Info("eth_subscribe", "NewPendingTransactions ", "")
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return nil, rpc.ErrNotificationsUnsupported
+	}
+	subscription := notifier.CreateSubscription()
+	var in ctypes.ReqSubscribe
+	in.Name = string(subscription.ID)
+	in.Type = PendingTxEvent
+	stream, err := e.grpcCli.SubEvent(context.Background(), &in)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		<-subscription.Err()
+		//取消订阅，grpc服务端结束推送
+		e.grpcCli.UnSubEvent(context.Background(), &ctypes.ReqString{Data: string(subscription.ID)})
+	}()
+	go func() {
+		for {
+			msg, err := stream.Recv()
+			if err != nil {
+				log.Debug("NewPendingTransactions read", "err", err)
+				return
+			}
+			for _, tx := range msg.GetMempoolTxs().GetTxs() {
+				if err := notifier.Notify(subscription.ID, common.BytesToHash(tx.Hash())); err != nil {
+					log.Error("NewPendingTransactions notify", "err", err)
+					return
+				}
+			}
+		}
+	}()
+	return subscription, nil
+}
+
 //Logs ...
 //eth_subscribe
 //params:["logs",{"address":"","topics":[""]}]
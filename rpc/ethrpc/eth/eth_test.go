@@ -1,25 +1,33 @@
 package eth
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"math/rand"
+	"net/http/httptest"
 
 	clientMocks "github.com/33cn/chain33/client/mocks"
 	"github.com/33cn/chain33/queue"
 	etypes "github.com/33cn/chain33/rpc/ethrpc/types"
 	"github.com/33cn/chain33/system/dapp/coins/types"
 	ctypes "github.com/33cn/chain33/types"
+	ctypesMocks "github.com/33cn/chain33/types/mocks"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 
 	"math/big"
 	"strings"
@@ -472,3 +480,220 @@ func TestEthHandler_SendRawTransaction(t *testing.T) {
 	_, err = ethCli.SendRawTransaction(metamaskRawTx)
 	assert.NotNil(t, err)
 }
+
+func TestEthHandler_FeeHistory(t *testing.T) {
+	api := &clientMocks.QueueProtocolAPI{}
+	ethCli.cli.Init(q.Client(), api)
+	defer ethCli.cli.Init(q.Client(), qapi)
+	minFee := ethCli.cfg.GetMinTxFeeRate()
+	target := ethCli.cfg.GetP(10).MaxTxNumber / ctypes.BaseFeeElasticity
+	headers := &ctypes.Headers{Items: []*ctypes.Header{
+		{Height: 8, BaseFee: minFee},
+		{Height: 9, BaseFee: 2 * minFee, TxCount: target},
+		{Height: 10, BaseFee: 2 * minFee, TxCount: 2 * target},
+	}}
+	api.On("GetLastHeader", mock.Anything).Return(headers.Items[2], nil)
+	api.On("GetHeaders", &ctypes.ReqBlocks{Start: 8, End: 10}).Return(headers, nil)
+
+	//下一个区块的基础手续费提高1/8
+	price, err := ethCli.GasPrice()
+	require.Nil(t, err)
+	require.Equal(t, "0x53d1ac100", price.String())
+
+	result, err := ethCli.FeeHistory("0x3", "latest", []interface{}{25.0, 75.0})
+	require.Nil(t, err)
+	data, err := json.Marshal(result)
+	require.Nil(t, err)
+	var history struct {
+		OldestBlock   hexutil.Uint64 `json:"oldestBlock"`
+		Reward        [][]string     `json:"reward"`
+		BaseFeePerGas []string       `json:"baseFeePerGas"`
+		GasUsedRatio  []float64      `json:"gasUsedRatio"`
+	}
+	require.Nil(t, json.Unmarshal(data, &history))
+	require.Equal(t, hexutil.Uint64(8), history.OldestBlock)
+	require.Equal(t, []string{"0x2540be400", "0x4a817c800", "0x4a817c800", "0x53d1ac100"}, history.BaseFeePerGas)
+	require.Equal(t, []float64{0, 0.5, 1}, history.GasUsedRatio)
+	require.Equal(t, 3, len(history.Reward))
+	require.Equal(t, []string{"0x0", "0x0"}, history.Reward[0])
+}
+
+//testSubStream 模拟grpc SubEvent的推送流
+type testSubStream struct {
+	grpc.ClientStream
+	data chan *ctypes.PushData
+}
+
+func (s *testSubStream) Recv() (*ctypes.PushData, error) {
+	data, ok := <-s.data
+	if !ok {
+		return nil, io.EOF
+	}
+	return data, nil
+}
+
+func newTestEthHandler(api *clientMocks.QueueProtocolAPI, gcli *ctypesMocks.Chain33Client) *ethHandler {
+	e := &ethHandler{cfg: ethCli.cfg, grpcCli: gcli, evmChainID: ethCli.evmChainID, filterTimeout: time.Minute}
+	e.filters = make(map[rpc.ID]*filter)
+	e.cli.Init(q.Client(), api)
+	return e
+}
+
+//TestEthHandler_Conformance 按照testdata中记录的请求和返回检查eth rpc接口的输出格式
+func TestEthHandler_Conformance(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/conformance.json")
+	require.Nil(t, err)
+	var fixture struct {
+		BlockDetail json.RawMessage `json:"blockDetail"`
+		Calls       []struct {
+			Request  json.RawMessage `json:"request"`
+			Response json.RawMessage `json:"response"`
+		} `json:"calls"`
+	}
+	require.Nil(t, json.Unmarshal(data, &fixture))
+	//交易签名的v值依赖配置的evmChainID
+	chain33crypto.Init(ethCli.cfg.GetModuleConfig().Crypto, ethCli.cfg.GetSubConfig().Crypto)
+	var detail ctypes.BlockDetail
+	require.Nil(t, ctypes.JSONToPB(fixture.BlockDetail, &detail))
+	details := &ctypes.BlockDetails{Items: []*ctypes.BlockDetail{&detail}}
+	hash := detail.GetBlock().Hash(ethCli.cfg)
+
+	api := &clientMocks.QueueProtocolAPI{}
+	api.On("GetLastHeader").Return(detail.GetBlock().GetHeader(ethCli.cfg), nil)
+	api.On("GetBlocks", &ctypes.ReqBlocks{Start: 70, End: 70, IsDetail: true}).Return(details, nil)
+	api.On("GetBlockByHashes", &ctypes.ReqHashes{Hashes: [][]byte{hash}}).Return(details, nil)
+	api.On("GetBlockByHashes", mock.Anything).Return(&ctypes.BlockDetails{Items: []*ctypes.BlockDetail{nil}}, nil)
+	storageKey := "LODB-evm-state:0x0a2b8d95ce94afb49c54741b01175692619a8e73:0xf7ff7017e1007009ec4701c3d681fc9b981d6967bb46f508120402926c6503a7"
+	value := common.FromHex("0xe86f511f00")
+	api.On("LocalGet", &ctypes.LocalDBGet{Keys: [][]byte{[]byte(storageKey)}}).Return(&ctypes.LocalReplyValue{Values: [][]byte{value}}, nil)
+	api.On("LocalGet", mock.Anything).Return(&ctypes.LocalReplyValue{Values: [][]byte{nil}}, nil)
+
+	server := rpc.NewServer()
+	defer server.Stop()
+	require.Nil(t, server.RegisterName("eth", newTestEthHandler(api, &ctypesMocks.Chain33Client{})))
+	for _, call := range fixture.Calls {
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(call.Request))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		server.ServeHTTP(w, req)
+		require.JSONEq(t, string(call.Response), w.Body.String(), string(call.Request))
+	}
+}
+
+func TestEthHandler_NewBlockFilter(t *testing.T) {
+	api := &clientMocks.QueueProtocolAPI{}
+	api.On("GetLastHeader").Return(&ctypes.Header{Height: 70}, nil).Once()
+	gcli := &ctypesMocks.Chain33Client{}
+	e := newTestEthHandler(api, gcli)
+
+	//区块filter不占用blockchain的推送订阅
+	id, err := e.NewBlockFilter()
+	require.Nil(t, err)
+	require.Equal(t, 0, len(gcli.Calls))
+	api.On("GetLastHeader").Return(&ctypes.Header{Height: 70}, nil).Once()
+	changes, err := e.GetFilterChanges(*id)
+	require.Nil(t, err)
+	require.Equal(t, []common.Hash{}, changes)
+
+	//返回上次查询之后新增的区块
+	hash1, hash2 := common.HexToHash("0x01"), common.HexToHash("0x02")
+	api.On("GetLastHeader").Return(&ctypes.Header{Height: 72}, nil).Once()
+	api.On("GetHeaders", &ctypes.ReqBlocks{Start: 71, End: 72}).Return(&ctypes.Headers{Items: []*ctypes.Header{
+		{Height: 71, Hash: hash1.Bytes()}, {Height: 72, Hash: hash2.Bytes()}}}, nil).Once()
+	changes, err = e.GetFilterChanges(*id)
+	require.Nil(t, err)
+	require.Equal(t, []common.Hash{hash1, hash2}, changes)
+	api.On("GetLastHeader").Return(&ctypes.Header{Height: 72}, nil).Once()
+	changes, err = e.GetFilterChanges(*id)
+	require.Nil(t, err)
+	require.Equal(t, []common.Hash{}, changes)
+	_, err = e.GetFilterLogs(context.Background(), *id)
+	require.NotNil(t, err)
+
+	//每次最多返回MaxHeaderCountPerTime个区块
+	api.On("GetLastHeader").Return(&ctypes.Header{Height: 72 + ctypes.MaxHeaderCountPerTime + 1}, nil).Once()
+	api.On("GetHeaders", &ctypes.ReqBlocks{Start: 73, End: 72 + ctypes.MaxHeaderCountPerTime}).Return(&ctypes.Headers{}, nil).Once()
+	_, err = e.GetFilterChanges(*id)
+	require.Nil(t, err)
+	e.filtersMu.Lock()
+	require.Equal(t, 72+ctypes.MaxHeaderCountPerTime, e.filters[*id].height)
+	e.filtersMu.Unlock()
+
+	require.True(t, e.UninstallFilter(*id))
+	_, err = e.GetFilterChanges(*id)
+	require.NotNil(t, err)
+	api.AssertExpectations(t)
+}
+
+func TestEthHandler_NewPendingTransactionFilter(t *testing.T) {
+	gcli := &ctypesMocks.Chain33Client{}
+	stream := &testSubStream{data: make(chan *ctypes.PushData, 1)}
+	gcli.On("SubEvent", mock.Anything, mock.Anything).Return(stream, nil)
+	gcli.On("UnSubEvent", mock.Anything, mock.Anything).Return(&ctypes.Reply{IsOk: true}, nil)
+	e := newTestEthHandler(&clientMocks.QueueProtocolAPI{}, gcli)
+
+	id, err := e.NewPendingTransactionFilter()
+	require.Nil(t, err)
+	require.Equal(t, int32(PendingTxEvent), gcli.Calls[0].Arguments.Get(1).(*ctypes.ReqSubscribe).GetType())
+	txs := []*ctypes.Transaction{{Execer: []byte("coins"), Nonce: 1}, {Execer: []byte("evm"), Nonce: 2}}
+	stream.data <- &ctypes.PushData{Value: &ctypes.PushData_MempoolTxs{MempoolTxs: &ctypes.Transactions{Txs: txs}}}
+	require.Eventually(t, func() bool {
+		e.filtersMu.Lock()
+		defer e.filtersMu.Unlock()
+		return len(e.filters[*id].hashes) == 2
+	}, time.Second*5, time.Millisecond*10)
+	changes, err := e.GetFilterChanges(*id)
+	require.Nil(t, err)
+	require.Equal(t, []common.Hash{common.BytesToHash(txs[0].Hash()), common.BytesToHash(txs[1].Hash())}, changes)
+
+	//超时的filter被删除
+	e.filtersMu.Lock()
+	e.filters[*id].deadline.Reset(time.Millisecond)
+	e.filtersMu.Unlock()
+	go e.timeoutLoop(time.Millisecond * 10)
+	require.Eventually(t, func() bool {
+		e.filtersMu.Lock()
+		defer e.filtersMu.Unlock()
+		return e.filters[*id] == nil
+	}, time.Second*5, time.Millisecond*10)
+	close(stream.data)
+}
+
+func TestEthHandler_NewPendingTransactions(t *testing.T) {
+	gcli := &ctypesMocks.Chain33Client{}
+	stream := &testSubStream{data: make(chan *ctypes.PushData, 1)}
+	gcli.On("SubEvent", mock.Anything, mock.Anything).Return(stream, nil)
+	unsub := make(chan string, 1)
+	gcli.On("UnSubEvent", mock.Anything, mock.Anything).Return(&ctypes.Reply{IsOk: true}, nil).Run(func(args mock.Arguments) {
+		unsub <- args.Get(1).(*ctypes.ReqString).GetData()
+	})
+	server := rpc.NewServer()
+	defer server.Stop()
+	require.Nil(t, server.RegisterName("eth", newTestEthHandler(&clientMocks.QueueProtocolAPI{}, gcli)))
+	client := rpc.DialInProc(server)
+	defer client.Close()
+
+	hashes := make(chan common.Hash, 1)
+	sub, err := client.EthSubscribe(context.Background(), hashes, "newPendingTransactions")
+	require.Nil(t, err)
+	in := gcli.Calls[0].Arguments.Get(1).(*ctypes.ReqSubscribe)
+	require.Equal(t, int32(PendingTxEvent), in.GetType())
+	tx := &ctypes.Transaction{Execer: []byte("coins"), Nonce: 1}
+	stream.data <- &ctypes.PushData{Name: in.GetName(), Value: &ctypes.PushData_MempoolTxs{MempoolTxs: &ctypes.Transactions{Txs: []*ctypes.Transaction{tx}}}}
+	select {
+	case hash := <-hashes:
+		require.Equal(t, common.BytesToHash(tx.Hash()), hash)
+	case <-time.After(time.Second * 5):
+		t.Fatal("wait pending transaction timeout")
+	}
+
+	//取消订阅时通知grpc服务端
+	sub.Unsubscribe()
+	select {
+	case name := <-unsub:
+		require.Equal(t, in.GetName(), name)
+	case <-time.After(time.Second * 5):
+		t.Fatal("wait unsubscribe timeout")
+	}
+	close(stream.data)
+}
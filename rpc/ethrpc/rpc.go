@@ -1,8 +1,11 @@
 package ethrpc
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"net/http"
 	"strings"
@@ -12,10 +15,13 @@ import (
 	"github.com/33cn/chain33/common/log/log15"
 	"github.com/33cn/chain33/common/utils"
 	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/rpc/apikey"
 	"github.com/33cn/chain33/rpc/ethrpc/admin"
+	"github.com/33cn/chain33/rpc/ethrpc/debug"
 	"github.com/33cn/chain33/rpc/ethrpc/eth"
 	rpcNet "github.com/33cn/chain33/rpc/ethrpc/net"
 	"github.com/33cn/chain33/rpc/ethrpc/personal"
+	"github.com/33cn/chain33/rpc/ethrpc/txpool"
 	"github.com/33cn/chain33/rpc/ethrpc/web3"
 	ctypes "github.com/33cn/chain33/types"
 	"github.com/ethereum/go-ethereum/node"
@@ -28,6 +34,8 @@ const (
 	personalNameSpace   = "personal"
 	adminNameSpace      = "admin"
 	web3NameSpace       = "web3"
+	txpoolNameSpace     = "txpool"
+	debugNameSpace      = "debug"
 	subRpctype          = "eth"
 	defaultEthRPCPort   = 8545
 	defaultEthWsRPCPort = 8546
@@ -45,6 +53,8 @@ var (
 		personalNameSpace: personal.NewPersonalAPI,
 		adminNameSpace:    admin.NewAdminAPI,
 		web3NameSpace:     web3.NewWeb3API,
+		txpoolNameSpace:   txpool.NewTxpoolAPI,
+		debugNameSpace:    debug.NewDebugAPI,
 	}
 )
 
@@ -53,9 +63,9 @@ type subConfig struct {
 	Enable          bool     `json:"enable,omitempty"`
 	EnableRlpTxHash bool     `json:"enableRlpTxHash,omitempty"`
 	HTTPAddr        string   `json:"httpAddr,omitempty"`
-	HTTPAPI         []string `json:"httpApi,omitempty"` //eth,admin,net,web3/personal
+	HTTPAPI         []string `json:"httpApi,omitempty"` //eth,admin,net,web3/personal/txpool/debug
 	WsAddr          string   `json:"wsAddr,omitempty"`
-	WsAPI           []string `json:"wsApi,omitempty"` //eth,admin,net,web3/personal
+	WsAPI           []string `json:"wsApi,omitempty"` //eth,admin,net,web3/personal/txpool/debug
 	Web3CliVer      string   `json:"web3CliVer,omitempty"`
 }
 
@@ -63,6 +73,7 @@ type subConfig struct {
 type ServerAPI interface {
 	EnableRPC()
 	EnableWS()
+	SetAPIKeys(keys *apikey.Manager)
 	Start() (int, error)
 	Close()
 }
@@ -79,6 +90,8 @@ type httpServer struct {
 	subCfg      *subConfig
 	qclient     queue.Client
 	api         client.QueueProtocolAPI
+	apiKeys     *apikey.Manager
+	blacklist   map[string]bool
 }
 
 // isWebsocket checks the header of an http request for a websocket upgrade request.
@@ -112,16 +125,35 @@ func NewHTTPServer(c queue.Client, api client.QueueProtocolAPI) ServerAPI {
 	ctypes.MustDecode(c.GetConfig().GetSubConfig().RPC[subRpctype], &subcfg)
 
 	log.Debug("NewHttpServer", "subcfg", subcfg)
+	blacklist := make(map[string]bool)
+	for _, method := range c.GetConfig().GetModuleConfig().RPC.ErpcFuncBlacklist {
+		blacklist[method] = true
+	}
 	return &httpServer{
-		timeouts: rpc.DefaultHTTPTimeouts,
-		cfg:      c.GetConfig(),
-		subCfg:   &subcfg,
-		qclient:  c,
-		api:      api,
+		timeouts:  rpc.DefaultHTTPTimeouts,
+		cfg:       c.GetConfig(),
+		subCfg:    &subcfg,
+		qclient:   c,
+		api:       api,
+		blacklist: blacklist,
 	}
 
 }
 
+//isNamespaceBlocked 黑名单中配置"namespace_*"时整个命名空间不注册
+func (h *httpServer) isNamespaceBlocked(namespace string) bool {
+	return h.blacklist[namespace+"_*"]
+}
+
+//isMethodBlocked 方法在黑名单中，或者所属的命名空间被禁止
+func (h *httpServer) isMethodBlocked(method string) bool {
+	if h.blacklist[method] {
+		return true
+	}
+	index := strings.Index(method, "_")
+	return index > 0 && h.isNamespaceBlocked(method[:index])
+}
+
 //set listen addr
 func (h *httpServer) setEndPoint(listenAddr string) {
 	h.mu.Lock()
@@ -133,6 +165,9 @@ func (h *httpServer) setEndPoint(listenAddr string) {
 func (h *httpServer) EnableRPC() {
 	var apis = make(rpcAPIs)
 	for _, namespace := range h.subCfg.HTTPAPI {
+		if h.isNamespaceBlocked(namespace) {
+			continue
+		}
 		if api, ok := defaultApis[namespace]; ok {
 			apis[namespace] = api
 		}
@@ -152,13 +187,15 @@ func (h *httpServer) EnableRPC() {
 func (h *httpServer) EnableWS() {
 	var apis = make(rpcAPIs)
 	for _, namespace := range h.subCfg.WsAPI {
+		if h.isNamespaceBlocked(namespace) {
+			continue
+		}
 		if api, ok := defaultApis[namespace]; ok {
 			apis[namespace] = api
 		}
 	}
 
 	rpcHandler := initRPCHandler(apis, h.cfg, h.qclient, h.api)
-	rpcHandler.Handler = rpcHandler.server.WebsocketHandler([]string{"*"})
 	h.wsHander = rpcHandler
 	if h.subCfg.WsAddr == "" {
 		h.subCfg.WsAddr = fmt.Sprintf("localhost:%d", defaultEthWsRPCPort)
@@ -167,6 +204,11 @@ func (h *httpServer) EnableWS() {
 	log.Debug("EnableWS", "websocketaddr", h.endpoint)
 }
 
+//SetAPIKeys 携带api key的请求按key的方法权限和限流检查，websocket连接上的每个消息都检查
+func (h *httpServer) SetAPIKeys(keys *apikey.Manager) {
+	h.apiKeys = keys
+}
+
 //Start server start
 func (h *httpServer) Start() (int, error) {
 	h.mu.Lock()
@@ -224,14 +266,103 @@ func (h *httpServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	} else {
 		log.Debug("ServeHTTP", "remote client", r.RemoteAddr)
 	}
+	cli, err := h.apiKeys.Client(r.Header.Get(apikey.Header), net.ParseIP(ip).IsLoopback())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	var methods []string
+	var size int
+	if !isWebsocket(r) && (cli != nil || len(h.blacklist) != 0) {
+		methods, size, err = readMethods(r)
+		//没有api key时解析失败的请求交给rpc server返回错误
+		if err != nil && cli != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+	}
+	for _, method := range methods {
+		if h.isMethodBlocked(method) {
+			http.Error(w, fmt.Sprintf("The %s method is not authorized!", method), http.StatusForbidden)
+			return
+		}
+	}
+	if cli != nil {
+		err = allowAPIKey(cli, methods, size)
+		if err != nil {
+			status := http.StatusForbidden
+			if err == ctypes.ErrRateLimited {
+				status = http.StatusTooManyRequests
+			}
+			http.Error(w, err.Error(), status)
+			return
+		}
+		if !isWebsocket(r) {
+			out := &countResponseWriter{ResponseWriter: w}
+			w = out
+			defer func() {
+				cli.AddBytes(out.n)
+			}()
+		}
+	}
 	if h.httpHandler != nil {
 		h.httpHandler.ServeHTTP(w, r)
 		return
 	}
 	if h.wsHander != nil && isWebsocket(r) {
-		h.wsHander.ServeHTTP(w, r)
+		h.serveWebsocket(w, r, cli)
 		return
 	}
 
 	w.WriteHeader(http.StatusNotFound)
 }
+
+//countResponseWriter 记录返回数据的字节数，用于api key的流量统计
+type countResponseWriter struct {
+	http.ResponseWriter
+	n int
+}
+
+func (w *countResponseWriter) Write(d []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(d)
+	w.n += n
+	return n, err
+}
+
+type ethRequest struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+}
+
+//readMethods 读取http请求中的所有方法，请求体保留给之后的处理
+func readMethods(r *http.Request) ([]string, int, error) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, 0, err
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	reqs, _, err := parseRequests(body)
+	if err != nil {
+		return nil, 0, err
+	}
+	methods := make([]string, 0, len(reqs))
+	for _, req := range reqs {
+		methods = append(methods, req.Method)
+	}
+	return methods, len(body), nil
+}
+
+//allowAPIKey 检查请求中所有方法的权限，请求数按方法个数计算，建立websocket连接时没有方法只做限流检查
+func allowAPIKey(cli *apikey.Client, methods []string, size int) error {
+	if len(methods) == 0 {
+		return cli.Allow("", 0)
+	}
+	for _, method := range methods {
+		err := cli.Allow(method, size)
+		if err != nil {
+			return err
+		}
+		size = 0
+	}
+	return nil
+}
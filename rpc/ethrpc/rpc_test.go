@@ -0,0 +1,151 @@
+package ethrpc
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	clientMocks "github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/rpc/apikey"
+	ctypes "github.com/33cn/chain33/types"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPServer_Blacklist(t *testing.T) {
+	cfg := ctypes.NewChain33Config(ctypes.GetDefaultCfgstring())
+	cfg.GetModuleConfig().RPC.ErpcFuncBlacklist = []string{"web3_sha3", "debug_*"}
+	subcfg := cfg.GetSubConfig()
+	sub, _ := ctypes.ModifySubConfig(subcfg.RPC[subRpctype], "httpApi", []string{"web3", "txpool", "debug"})
+	subcfg.RPC[subRpctype] = sub
+	q := queue.New("test")
+	q.SetConfig(cfg)
+	defer q.Close()
+	qapi := &clientMocks.QueueProtocolAPI{}
+	qapi.On("GetMempool", &ctypes.ReqGetMempool{IsAll: true}).Return(&ctypes.ReplyTxList{}, nil)
+	qapi.On("GetFutureMempool").Return(&ctypes.ReplyTxList{}, nil)
+
+	h := NewHTTPServer(q.Client(), qapi).(*httpServer)
+	h.EnableRPC()
+	call := func(body string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		r.Header.Set("Content-Type", "application/json")
+		r.RemoteAddr = "127.0.0.1:10000"
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w
+	}
+
+	w := call(`{"jsonrpc":"2.0","id":1,"method":"txpool_status","params":[]}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"pending":"0x0"`)
+	//黑名单中的方法以及被禁止的命名空间
+	w = call(`{"jsonrpc":"2.0","id":1,"method":"web3_sha3","params":["0x00"]}`)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	w = call(`{"jsonrpc":"2.0","id":1,"method":"debug_traceTransaction","params":[]}`)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	//批量请求中包含黑名单方法时整个请求被拒绝
+	w = call(`[{"jsonrpc":"2.0","id":1,"method":"web3_clientVersion","params":[]},{"jsonrpc":"2.0","id":2,"method":"web3_sha3","params":["0x00"]}]`)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	w = call(`{"jsonrpc":"2.0","id":1,"method":"web3_clientVersion","params":[]}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	//解析失败的请求由rpc server返回错误
+	w = call(`invalid`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "parse error")
+	//被禁止的命名空间不注册，websocket同样无法调用
+	w = call(`{"jsonrpc":"2.0","id":1,"method":"rpc_modules","params":[]}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"txpool":"1.0"`)
+	assert.NotContains(t, w.Body.String(), "debug")
+}
+
+func TestHTTPServer_WebsocketAPIKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "apikey")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "apikey.toml")
+	assert.Nil(t, ioutil.WriteFile(file, []byte("[[keys]]\nname=\"partner\"\nkey=\"key1\"\nmethods=[\"web3_clientVersion\"]\n"), 0600))
+	keys, err := apikey.New(file, false)
+	assert.Nil(t, err)
+	defer keys.Close()
+
+	cfg := ctypes.NewChain33Config(ctypes.GetDefaultCfgstring())
+	subcfg := cfg.GetSubConfig()
+	sub, _ := ctypes.ModifySubConfig(subcfg.RPC[subRpctype], "wsApi", []string{"web3"})
+	subcfg.RPC[subRpctype] = sub
+	q := queue.New("test")
+	q.SetConfig(cfg)
+	defer q.Close()
+	h := NewHTTPServer(q.Client(), &clientMocks.QueueProtocolAPI{}).(*httpServer)
+	h.EnableWS()
+	h.SetAPIKeys(keys)
+	server := httptest.NewServer(h)
+	defer server.Close()
+
+	header := http.Header{}
+	header.Set(apikey.Header, "key1")
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), header)
+	assert.Nil(t, err)
+	defer conn.Close()
+	call := func(req string) string {
+		assert.Nil(t, conn.WriteMessage(websocket.TextMessage, []byte(req)))
+		_, data, err := conn.ReadMessage()
+		assert.Nil(t, err)
+		return string(data)
+	}
+	//建立连接之后每个消息都按key的方法权限检查
+	resp := call(`{"jsonrpc":"2.0","id":1,"method":"web3_clientVersion","params":[]}`)
+	assert.Contains(t, resp, `"result"`)
+	resp = call(`{"jsonrpc":"2.0","id":2,"method":"web3_sha3","params":["0x00"]}`)
+	assert.Contains(t, resp, `"id":2`)
+	assert.Contains(t, resp, ctypes.ErrAPIKeyMethodDenied.Error())
+	resp = call(`[{"jsonrpc":"2.0","id":3,"method":"web3_clientVersion","params":[]},{"jsonrpc":"2.0","id":4,"method":"web3_sha3","params":["0x00"]}]`)
+	assert.Contains(t, resp, `"id":3`)
+	assert.Contains(t, resp, ctypes.ErrAPIKeyMethodDenied.Error())
+	assert.NotContains(t, resp, `"result"`)
+	//被拒绝之后连接仍然可用
+	resp = call(`{"jsonrpc":"2.0","id":5,"method":"web3_clientVersion","params":[]}`)
+	assert.Contains(t, resp, `"result"`)
+	usage := keys.Usages()[0]
+	assert.Equal(t, int64(6), usage.Requests)
+	assert.Equal(t, int64(2), usage.Rejected)
+}
+
+func TestHTTPServer_WebsocketBlacklist(t *testing.T) {
+	cfg := ctypes.NewChain33Config(ctypes.GetDefaultCfgstring())
+	cfg.GetModuleConfig().RPC.ErpcFuncBlacklist = []string{"web3_sha3"}
+	subcfg := cfg.GetSubConfig()
+	sub, _ := ctypes.ModifySubConfig(subcfg.RPC[subRpctype], "wsApi", []string{"web3"})
+	subcfg.RPC[subRpctype] = sub
+	q := queue.New("test")
+	q.SetConfig(cfg)
+	defer q.Close()
+	h := NewHTTPServer(q.Client(), &clientMocks.QueueProtocolAPI{}).(*httpServer)
+	h.EnableWS()
+	server := httptest.NewServer(h)
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	assert.Nil(t, err)
+	defer conn.Close()
+	call := func(req string) string {
+		assert.Nil(t, conn.WriteMessage(websocket.TextMessage, []byte(req)))
+		_, data, err := conn.ReadMessage()
+		assert.Nil(t, err)
+		return string(data)
+	}
+	//websocket上的每个消息都检查方法的黑名单
+	resp := call(`{"jsonrpc":"2.0","id":1,"method":"web3_sha3","params":["0x00"]}`)
+	assert.Contains(t, resp, "The web3_sha3 method is not authorized!")
+	resp = call(`[{"jsonrpc":"2.0","id":2,"method":"web3_clientVersion","params":[]},{"jsonrpc":"2.0","id":3,"method":"web3_sha3","params":["0x00"]}]`)
+	assert.Contains(t, resp, "The web3_sha3 method is not authorized!")
+	assert.NotContains(t, resp, `"result"`)
+	resp = call(`{"jsonrpc":"2.0","id":4,"method":"web3_clientVersion","params":[]}`)
+	assert.Contains(t, resp, `"result"`)
+}
@@ -0,0 +1,195 @@
+package txpool
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/33cn/chain33/client"
+	"github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/queue"
+	rpcclient "github.com/33cn/chain33/rpc/client"
+	"github.com/33cn/chain33/rpc/ethrpc/types"
+	ctypes "github.com/33cn/chain33/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+var (
+	log = log15.New("module", "ethrpc_txpool")
+)
+
+type txpoolHandler struct {
+	cli rpcclient.ChannelClient
+	cfg *ctypes.Chain33Config
+}
+
+//NewTxpoolAPI create a txpool api
+func NewTxpoolAPI(cfg *ctypes.Chain33Config, c queue.Client, api client.QueueProtocolAPI) interface{} {
+	p := &txpoolHandler{}
+	p.cli.Init(c, api)
+	p.cfg = cfg
+	return p
+}
+
+//Content txpool_content
+//返回mempool中的交易，按发送地址和nonce分组；nonce不连续的交易暂存在mempool的future队列，作为queued返回
+func (p *txpoolHandler) Content() (map[string]map[string]map[string]*types.Transaction, error) {
+	txs, err := p.cli.GetMempool(&ctypes.ReqGetMempool{IsAll: true})
+	if err != nil {
+		log.Error("Content", "err", err)
+		return nil, err
+	}
+	future, err := p.cli.GetFutureMempool()
+	if err != nil {
+		log.Error("Content", "err", err)
+		return nil, err
+	}
+	return map[string]map[string]map[string]*types.Transaction{
+		"pending": p.groupByFrom(txs.GetTxs()),
+		"queued":  p.groupByFrom(future.GetTxs()),
+	}, nil
+}
+
+//ContentFrom txpool_contentFrom 返回mempool中指定地址发送的交易
+func (p *txpoolHandler) ContentFrom(addr string) (map[string]map[string]*types.Transaction, error) {
+	if common.IsHexAddress(addr) {
+		addr = strings.ToLower(common.HexToAddress(addr).Hex())
+	}
+	details, err := p.cli.GetTxListByAddr(&ctypes.ReqAddrs{Addrs: []string{addr}})
+	if err != nil {
+		log.Error("ContentFrom", "addr", addr, "err", err)
+		return nil, err
+	}
+	future, err := p.cli.GetFutureMempool()
+	if err != nil {
+		log.Error("ContentFrom", "addr", addr, "err", err)
+		return nil, err
+	}
+	pending := make(map[string]*types.Transaction)
+	for _, detail := range details.GetTxs() {
+		tx := p.toEthTx(detail.GetTx())
+		if tx == nil {
+			continue
+		}
+		pending[nonceKey(tx)] = tx
+	}
+	queued := make(map[string]*types.Transaction)
+	for _, ctx := range future.GetTxs() {
+		if ctx.From() != addr {
+			continue
+		}
+		if tx := p.toEthTx(ctx); tx != nil {
+			queued[nonceKey(tx)] = tx
+		}
+	}
+	return map[string]map[string]*types.Transaction{
+		"pending": pending,
+		"queued":  queued,
+	}, nil
+}
+
+//Status txpool_status 返回mempool以及future队列中的交易数量
+func (p *txpoolHandler) Status() (map[string]hexutil.Uint, error) {
+	txs, err := p.cli.GetMempool(&ctypes.ReqGetMempool{IsAll: true})
+	if err != nil {
+		log.Error("Status", "err", err)
+		return nil, err
+	}
+	future, err := p.cli.GetFutureMempool()
+	if err != nil {
+		log.Error("Status", "err", err)
+		return nil, err
+	}
+	return map[string]hexutil.Uint{
+		"pending": hexutil.Uint(len(txs.GetTxs())),
+		"queued":  hexutil.Uint(len(future.GetTxs())),
+	}, nil
+}
+
+//Inspect txpool_inspect 返回mempool中交易的摘要，格式与以太坊一致
+func (p *txpoolHandler) Inspect() (map[string]map[string]map[string]string, error) {
+	content, err := p.Content()
+	if err != nil {
+		return nil, err
+	}
+	inspect := make(map[string]map[string]map[string]string)
+	for status, accounts := range content {
+		inspect[status] = make(map[string]map[string]string)
+		for from, txs := range accounts {
+			inspect[status][from] = make(map[string]string)
+			for nonce, tx := range txs {
+				inspect[status][from][nonce] = formatTx(tx)
+			}
+		}
+	}
+	return inspect, nil
+}
+
+//Latest txpool_latest 返回最新加入mempool的交易
+func (p *txpoolHandler) Latest() ([]*types.Transaction, error) {
+	txs, err := p.cli.GetLastMempool()
+	if err != nil {
+		log.Error("Latest", "err", err)
+		return nil, err
+	}
+	latest := make([]*types.Transaction, 0, len(txs.GetTxs()))
+	for _, ctx := range txs.GetTxs() {
+		if tx := p.toEthTx(ctx); tx != nil {
+			latest = append(latest, tx)
+		}
+	}
+	return latest, nil
+}
+
+//groupByFrom 按发送地址和nonce分组
+func (p *txpoolHandler) groupByFrom(txs []*ctypes.Transaction) map[string]map[string]*types.Transaction {
+	accounts := make(map[string]map[string]*types.Transaction)
+	for _, ctx := range txs {
+		tx := p.toEthTx(ctx)
+		if tx == nil {
+			continue
+		}
+		from := fromAddr(ctx)
+		if _, ok := accounts[from]; !ok {
+			accounts[from] = make(map[string]*types.Transaction)
+		}
+		accounts[from][nonceKey(tx)] = tx
+	}
+	return accounts
+}
+
+//toEthTx 转换为eth格式的交易，mempool中的交易没有区块信息
+func (p *txpoolHandler) toEthTx(ctx *ctypes.Transaction) *types.Transaction {
+	txs, _, err := types.TxsToEthTxs(common.Hash{}, 0, []*ctypes.Transaction{ctx}, p.cfg, true)
+	if err != nil || len(txs) == 0 {
+		log.Error("toEthTx", "hash", common.Bytes2Hex(ctx.Hash()), "err", err)
+		return nil
+	}
+	tx := txs[0].(*types.Transaction)
+	tx.BlockHash = nil
+	tx.BlockNumber = nil
+	tx.TransactionIndex = nil
+	return tx
+}
+
+//fromAddr 交易的发送地址，eth格式的地址使用校验和格式
+func fromAddr(tx *ctypes.Transaction) string {
+	from := tx.From()
+	if common.IsHexAddress(from) {
+		return common.HexToAddress(from).Hex()
+	}
+	return from
+}
+
+func nonceKey(tx *types.Transaction) string {
+	return strconv.FormatUint(uint64(tx.Nonce), 10)
+}
+
+func formatTx(tx *types.Transaction) string {
+	to := "contract creation"
+	if tx.To != nil && *tx.To != (common.Address{}) {
+		to = tx.To.Hex()
+	}
+	return fmt.Sprintf("%s: %v wei + %v gas × %v wei", to, tx.Value.ToInt(), uint64(tx.Gas), tx.GasPrice.ToInt())
+}
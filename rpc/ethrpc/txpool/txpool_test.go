@@ -0,0 +1,74 @@
+package txpool
+
+import (
+	"testing"
+
+	clientMocks "github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/queue"
+	ctypes "github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTxpoolHandler(t *testing.T) {
+	cfg := ctypes.NewChain33Config(ctypes.GetDefaultCfgstring())
+	q := queue.New("test")
+	q.SetConfig(cfg)
+	qapi := &clientMocks.QueueProtocolAPI{}
+	p := NewTxpoolAPI(cfg, q.Client(), qapi).(*txpoolHandler)
+
+	_, priv := util.Genaddress()
+	tx := util.CreateCoinsTx(cfg, priv, "1JX6b8qpVFZ4FPqP4KT2HRTjYJrzRZGw7t", 1e8)
+	tx.Nonce = 3
+	tx.Sign(ctypes.SECP256K1, priv)
+	from := tx.From()
+	txs := &ctypes.ReplyTxList{Txs: []*ctypes.Transaction{tx}}
+	//nonce不连续的交易在future队列中
+	futureTx := util.CreateCoinsTx(cfg, priv, "1JX6b8qpVFZ4FPqP4KT2HRTjYJrzRZGw7t", 1e8)
+	futureTx.Nonce = 5
+	futureTx.Sign(ctypes.SECP256K1, priv)
+	qapi.On("GetMempool", &ctypes.ReqGetMempool{IsAll: true}).Return(txs, nil)
+	qapi.On("GetFutureMempool").Return(&ctypes.ReplyTxList{Txs: []*ctypes.Transaction{futureTx}}, nil)
+	qapi.On("GetLastMempool").Return(txs, nil)
+	qapi.On("GetTxListByAddr", &ctypes.ReqAddrs{Addrs: []string{from}}).Return(&ctypes.TransactionDetails{
+		Txs: []*ctypes.TransactionDetail{{Tx: tx}}}, nil)
+	qapi.On("GetTxListByAddr", mock.Anything).Return(&ctypes.TransactionDetails{}, nil)
+
+	status, err := p.Status()
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]hexutil.Uint{"pending": 1, "queued": 1}, status)
+
+	content, err := p.Content()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(content["queued"]))
+	assert.Equal(t, futureTx.Hash(), content["queued"][from]["5"].Hash.Bytes())
+	assert.Nil(t, content["pending"][from]["5"])
+	etx := content["pending"][from]["3"]
+	assert.NotNil(t, etx)
+	assert.Equal(t, tx.Hash(), etx.Hash.Bytes())
+	assert.Nil(t, etx.BlockHash)
+	assert.Nil(t, etx.BlockNumber)
+	assert.Nil(t, etx.TransactionIndex)
+
+	inspect, err := p.Inspect()
+	assert.Nil(t, err)
+	assert.Equal(t, formatTx(etx), inspect["pending"][from]["3"])
+	assert.Contains(t, inspect["pending"][from]["3"], "gas ×")
+	assert.Equal(t, formatTx(content["queued"][from]["5"]), inspect["queued"][from]["5"])
+
+	txsFrom, err := p.ContentFrom(from)
+	assert.Nil(t, err)
+	assert.Equal(t, etx.Hash, txsFrom["pending"]["3"].Hash)
+	assert.Equal(t, futureTx.Hash(), txsFrom["queued"]["5"].Hash.Bytes())
+	txsFrom, err = p.ContentFrom("0x0000000000000000000000000000000000000001")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(txsFrom["pending"]))
+	assert.Equal(t, 0, len(txsFrom["queued"]))
+
+	latest, err := p.Latest()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(latest))
+	assert.Equal(t, etx.Hash, latest[0].Hash)
+}
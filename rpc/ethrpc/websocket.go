@@ -0,0 +1,150 @@
+package ethrpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/33cn/chain33/rpc/apikey"
+	ctypes "github.com/33cn/chain33/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	wsReadBuffer  = 1024
+	wsWriteBuffer = 1024
+	//单个websocket消息的最大字节数，和go-ethereum保持一致
+	wsMessageSizeLimit = 15 * 1024 * 1024
+	//拒绝请求时返回的错误码
+	wsErrorCode = -32000
+)
+
+var wsUpgrader = websocket.Upgrader{
+	ReadBufferSize:  wsReadBuffer,
+	WriteBufferSize: wsWriteBuffer,
+	CheckOrigin:     func(r *http.Request) bool { return true },
+}
+
+type wsError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type wsErrorResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Error   *wsError        `json:"error"`
+}
+
+//wsConn websocket连接上的每个消息在交给rpc server处理之前检查方法的黑名单以及api key的方法权限和限流，
+//go-ethereum的websocket handler只能在建立连接时检查
+type wsConn struct {
+	*websocket.Conn
+	h     *httpServer
+	cli   *apikey.Client
+	wlock sync.Mutex
+}
+
+//serveWebsocket 建立websocket连接，使用按消息检查的codec
+func (h *httpServer) serveWebsocket(w http.ResponseWriter, r *http.Request, cli *apikey.Client) {
+	conn, err := wsUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Debug("serveWebsocket upgrade", "err", err)
+		return
+	}
+	conn.SetReadLimit(wsMessageSizeLimit)
+	c := &wsConn{Conn: conn, h: h, cli: cli}
+	h.wsHander.server.ServeCodec(rpc.NewFuncCodec(c, c.writeJSON, c.readJSON), 0)
+}
+
+func (c *wsConn) writeJSON(v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	c.wlock.Lock()
+	defer c.wlock.Unlock()
+	err = c.WriteMessage(websocket.TextMessage, data)
+	if err == nil && c.cli != nil {
+		c.cli.AddBytes(len(data))
+	}
+	return err
+}
+
+//readJSON 读取下一个允许处理的消息，被拒绝的消息直接回复错误
+func (c *wsConn) readJSON(v interface{}) error {
+	for {
+		_, data, err := c.ReadMessage()
+		if err != nil {
+			return err
+		}
+		resp := c.checkMessage(data)
+		if resp == nil {
+			return json.Unmarshal(data, v)
+		}
+		err = c.writeJSON(resp)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+//checkMessage 检查消息中所有方法的黑名单和权限，批量请求中有方法被拒绝时整个请求被拒绝，解析失败的消息交给rpc server返回错误
+func (c *wsConn) checkMessage(data []byte) interface{} {
+	if c.cli == nil && len(c.h.blacklist) == 0 {
+		return nil
+	}
+	reqs, batch, err := parseRequests(data)
+	if err != nil {
+		return nil
+	}
+	methods := make([]string, 0, len(reqs))
+	for _, req := range reqs {
+		if c.h.isMethodBlocked(req.Method) {
+			err = fmt.Errorf("The %s method is not authorized!", req.Method)
+			break
+		}
+		methods = append(methods, req.Method)
+	}
+	if err == nil && c.cli != nil {
+		err = allowAPIKey(c.cli, methods, len(data))
+	}
+	if err == nil {
+		return nil
+	}
+	if !batch {
+		return &wsErrorResponse{Version: "2.0", ID: reqs[0].ID, Error: &wsError{Code: wsErrorCode, Message: err.Error()}}
+	}
+	resps := make([]*wsErrorResponse, 0, len(reqs))
+	for _, req := range reqs {
+		resps = append(resps, &wsErrorResponse{Version: "2.0", ID: req.ID, Error: &wsError{Code: wsErrorCode, Message: err.Error()}})
+	}
+	return resps
+}
+
+//parseRequests 解析单个请求或者批量请求
+func parseRequests(data []byte) ([]*ethRequest, bool, error) {
+	var reqs []*ethRequest
+	var err error
+	data = bytes.TrimLeft(data, " \t\r\n")
+	batch := len(data) > 0 && data[0] == '['
+	if batch {
+		err = json.Unmarshal(data, &reqs)
+	} else {
+		req := &ethRequest{}
+		err = json.Unmarshal(data, req)
+		reqs = append(reqs, req)
+	}
+	if err != nil || len(reqs) == 0 {
+		return nil, batch, ctypes.ErrInvalidParam
+	}
+	for i, req := range reqs {
+		if req == nil {
+			reqs[i] = &ethRequest{}
+		}
+	}
+	return reqs, batch, nil
+}
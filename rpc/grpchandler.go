@@ -17,6 +17,9 @@ import (
 	"golang.org/x/net/context"
 )
 
+//outbox中没有新的推送记录时的轮询间隔
+const pushOutboxPollInterval = 500 * time.Millisecond
+
 // SendTransactionSync send transaction by network and query
 func (g *Grpc) SendTransactionSync(ctx context.Context, in *pb.Transaction) (*pb.Reply, error) {
 	reply, err := g.cli.SendTx(in)
@@ -647,15 +650,73 @@ func (g *Grpc) GetPushSeqLastNum(ctx context.Context, in *pb.ReqString) (*pb.Int
 	return g.cli.GetPushSeqLastNum(in)
 }
 
+// RemovePushSubscribe 删除推送订阅
+func (g *Grpc) RemovePushSubscribe(ctx context.Context, in *pb.ReqPushSubscribeAuth) (*pb.ReplySubscribePush, error) {
+	in.Operator = false
+	return g.cli.RemovePushSubscribe(in)
+}
+
+// PausePushSubscribe 暂停推送订阅
+func (g *Grpc) PausePushSubscribe(ctx context.Context, in *pb.ReqPushSubscribeAuth) (*pb.ReplySubscribePush, error) {
+	in.Operator = false
+	return g.cli.PausePushSubscribe(in)
+}
+
+// GetPushStats 获取推送服务的运行统计
+func (g *Grpc) GetPushStats(ctx context.Context, in *pb.ReqString) (*pb.PushStats, error) {
+	return g.cli.GetPushStats(in)
+}
+
+// ReadPushOutbox 从outbox读取推送记录
+func (g *Grpc) ReadPushOutbox(ctx context.Context, in *pb.ReqPushOutbox) (*pb.PushOutboxRecords, error) {
+	return g.cli.ReadPushOutbox(in)
+}
+
+// ConsumePushOutbox 从指定偏移开始持续消费outbox中的推送记录，没有新记录时定时轮询，直到客户端断开
+func (g *Grpc) ConsumePushOutbox(in *pb.ReqPushOutbox, resp pb.Chain33_ConsumePushOutboxServer) error {
+	req := &pb.ReqPushOutbox{Name: in.GetName(), Secret: in.GetSecret(), Offset: in.GetOffset(), Count: in.GetCount()}
+	for {
+		records, err := g.cli.ReadPushOutbox(req)
+		if err != nil {
+			log.Error("grpc ConsumePushOutbox", "name", req.Name, "offset", req.Offset, "err", err)
+			return err
+		}
+		for _, record := range records.GetRecords() {
+			if err = resp.Send(record); err != nil {
+				log.Error("grpc ConsumePushOutbox send", "err", err)
+				return err
+			}
+		}
+		req.Offset = records.GetNextOffset()
+		if len(records.GetRecords()) > 0 {
+			continue
+		}
+		select {
+		case <-resp.Context().Done():
+			return resp.Context().Err()
+		case <-time.After(pushOutboxPollInterval):
+		}
+	}
+}
+
+// GetStateProof 获取状态数据及其mavl证明
+func (g *Grpc) GetStateProof(ctx context.Context, in *pb.ReqStateProof) (*pb.ReplyStateProof, error) {
+	return g.cli.GetStateProof(in)
+}
+
+// SimulateTransaction 模拟执行交易，返回回执、状态数据修改前后的值以及手续费
+func (g *Grpc) SimulateTransaction(ctx context.Context, in *pb.ReqSimulateTx) (*pb.ReplySimulateTx, error) {
+	return g.cli.SimulateTransaction(in)
+}
+
 //SubEvent 订阅消息推送服务
 func (g *Grpc) SubEvent(in *pb.ReqSubscribe, resp pb.Chain33_SubEventServer) error {
+	if PushType(in.GetType()) == PushMempoolTx {
+		return g.subMempoolTx(in, resp)
+	}
 	sub := g.hashTopic(in.Name)
 	dataChan := make(chan *queue.Message, 128)
 	if sub == nil {
-		sub = &subInfo{topic: in.GetName(), subType: (PushType)(in.Type).string(), subChan: make(map[chan *queue.Message]string), since: time.Now()}
-		//相关订阅信息加入到缓存中
-		g.addSubInfo(sub)
-		g.addSubChan(in.GetName(), dataChan)
 		var subReq pb.PushSubscribeReq
 		subReq.Encode = "grpc"
 		subReq.Name = in.GetName()
@@ -674,16 +735,10 @@ func (g *Grpc) SubEvent(in *pb.ReqSubscribe, resp pb.Chain33_SubEventServer) err
 			subReq.LastBlockHash = common.HashHex(hash.Hash)
 			subReq.LastSequence = seqs.Data
 		}
-		reply, err := g.cli.AddPushSubscribe(&subReq)
+		err := g.addPushSubscribe(&subReq, dataChan, false)
 		if err != nil {
-			log.Error("grpc SubEvent", "AddPushSubscribe", err)
-			g.delSubInfo(in.GetName(), nil)
 			return err
 		}
-		if !reply.GetIsOk() {
-			g.delSubInfo(in.GetName(), nil)
-			return errors.New(reply.GetMsg())
-		}
 
 	} else {
 		g.addSubChan(in.GetName(), dataChan)
@@ -708,10 +763,64 @@ func (g *Grpc) SubEvent(in *pb.ReqSubscribe, resp pb.Chain33_SubEventServer) err
 	return err
 }
 
+//subMempoolTx 订阅mempool新加入的交易，contract指定时只推送对应执行器的交易，连接断开或者取消订阅时删除订阅者
+func (g *Grpc) subMempoolTx(in *pb.ReqSubscribe, resp pb.Chain33_SubEventServer) error {
+	if in.GetName() == "" {
+		return pb.ErrInvalidParam
+	}
+	filter := &pb.PushFilter{}
+	for execer := range in.GetContract() {
+		filter.Execers = append(filter.Execers, execer)
+	}
+	sub := &mempoolSub{name: in.GetName(), filter: filter, txChan: make(chan *pb.Transaction, 128), quit: make(chan struct{})}
+	g.addMempoolSub(sub)
+	defer g.delMempoolSub(sub)
+	for {
+		select {
+		case <-resp.Context().Done():
+			return resp.Context().Err()
+		case <-sub.quit:
+			return nil
+		case tx := <-sub.txChan:
+			err := resp.Send(&pb.PushData{Name: sub.name, Value: &pb.PushData_MempoolTxs{MempoolTxs: &pb.Transactions{Txs: []*pb.Transaction{tx}}}})
+			if err != nil {
+				log.Error("grpc subMempoolTx send", "err", err)
+				return err
+			}
+		}
+	}
+}
+
+//addPushSubscribe 相关订阅信息加入到缓存中，并向blockchain注册推送，blockchain通过queue推送给rpc模块，
+//ack为true时由订阅者发送成功之后回复blockchain
+func (g *Grpc) addPushSubscribe(subReq *pb.PushSubscribeReq, dataChan chan *queue.Message, ack bool) error {
+	sub := &subInfo{topic: subReq.GetName(), subType: (PushType)(subReq.GetType()).string(), subChan: make(map[chan *queue.Message]string), since: time.Now()}
+	g.addSubInfo(sub)
+	if ack {
+		g.addAckSubChan(subReq.GetName(), dataChan)
+	} else {
+		g.addSubChan(subReq.GetName(), dataChan)
+	}
+	reply, err := g.cli.AddPushSubscribe(subReq)
+	if err != nil {
+		log.Error("grpc SubEvent", "AddPushSubscribe", err)
+		g.delSubInfo(subReq.GetName(), nil)
+		return err
+	}
+	if !reply.GetIsOk() {
+		g.delSubInfo(subReq.GetName(), nil)
+		return errors.New(reply.GetMsg())
+	}
+	return nil
+}
+
 //UnSubEvent 取消订阅
 func (g *Grpc) UnSubEvent(ctx context.Context, in *pb.ReqString) (*pb.Reply, error) {
 	//删除缓存的TopicID
 	err := g.delSubInfo(in.GetData(), nil)
+	if g.quitMempoolSub(in.GetData()) {
+		err = nil
+	}
 	if err != nil {
 		return nil, err
 	}
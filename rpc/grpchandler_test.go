@@ -863,6 +863,51 @@ func TestGrpc_AddPushSubscribe(t *testing.T) {
 
 }
 
+type mockOutboxStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	records []*types.PushOutboxRecord
+}
+
+func (s *mockOutboxStream) Send(record *types.PushOutboxRecord) error {
+	s.records = append(s.records, record)
+	return nil
+}
+
+func (s *mockOutboxStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestGrpc_ConsumePushOutbox(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	g := Grpc{}
+	qapi = new(mocks.QueueProtocolAPI)
+	qapi.On("GetConfig", mock.Anything).Return(cfg)
+	g.cli.QueueProtocolAPI = qapi
+	qapi.On("ReadPushOutbox", &types.ReqPushOutbox{Name: "test", Secret: "123"}).Return(&types.PushOutboxRecords{
+		Records:    []*types.PushOutboxRecord{{Offset: 0, Seq: 10}, {Offset: 1, Seq: 20}},
+		NextOffset: 2,
+	}, nil)
+	qapi.On("ReadPushOutbox", &types.ReqPushOutbox{Name: "test", Secret: "123", Offset: 2}).Return(&types.PushOutboxRecords{NextOffset: 2}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &mockOutboxStream{ctx: ctx}
+	go func() {
+		time.Sleep(time.Second)
+		cancel()
+	}()
+	err := g.ConsumePushOutbox(&types.ReqPushOutbox{Name: "test", Secret: "123"}, stream)
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, 2, len(stream.records))
+	assert.Equal(t, int64(20), stream.records[1].Seq)
+
+	qapi.On("ReadPushOutbox", &types.ReqPushOutbox{Name: "none"}).Return(nil, types.ErrPushNotSubscribed)
+	err = g.ConsumePushOutbox(&types.ReqPushOutbox{Name: "none"}, &mockOutboxStream{ctx: context.Background()})
+	assert.Equal(t, types.ErrPushNotSubscribed, err)
+	_, err = g.ReadPushOutbox(getOkCtx(), &types.ReqPushOutbox{Name: "none"})
+	assert.Equal(t, types.ErrPushNotSubscribed, err)
+}
+
 func mockblockchain(t *testing.T, client queue.Client) {
 	go func() {
 		blockchainKey := "blockchain"
@@ -910,6 +955,9 @@ func TestGrpc_SubEvent(t *testing.T) {
 	go mockblockchain(t, c.Client())
 	rpcCfg = new(types.RPC)
 	rpcCfg.GrpcBindAddr = "127.0.0.1:18802"
+	rpcCfg.Whitelist = []string{"127.0.0.1"}
+	rpcCfg.GrpcFuncWhitelist = []string{"*"}
+	InitCfg(rpcCfg)
 
 	qcli := c.Client()
 	api, err := client.New(qcli, nil)
@@ -958,3 +1006,66 @@ func TestGrpc_SubEvent(t *testing.T) {
 	t.Log("data:", data)
 	gcli.UnSubEvent(context.Background(), &types.ReqString{Data: in.Name})
 }
+
+func TestGrpc_SubEventMempoolTx(t *testing.T) {
+	c := queue.New("mempooltx")
+	chain33Cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	c.SetConfig(chain33Cfg)
+	defer c.Close()
+	rpcCfg = new(types.RPC)
+	rpcCfg.GrpcBindAddr = "127.0.0.1:18803"
+	rpcCfg.Whitelist = []string{"127.0.0.1"}
+	rpcCfg.GrpcFuncWhitelist = []string{"*"}
+	InitCfg(rpcCfg)
+	qcli := c.Client()
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(chain33Cfg)
+	api.On("Close").Return()
+	gapi := NewGRpcServer(qcli, api)
+	rpc := &RPC{gapi: gapi, cli: qcli, api: api}
+	go rpc.handleSysEvent()
+	_, err := gapi.Listen()
+	require.Nil(t, err)
+	defer gapi.Close()
+
+	//模拟mempool接收推送开关
+	mempool := c.Client()
+	mempool.Sub("mempool")
+	pushSwitch := make(chan int32, 2)
+	go func() {
+		for msg := range mempool.Recv() {
+			pushSwitch <- msg.GetData().(*types.Int32).GetData()
+		}
+	}()
+
+	conn, err := grpc.Dial(rpcCfg.GrpcBindAddr, grpc.WithInsecure())
+	require.Nil(t, err)
+	defer conn.Close()
+	gcli := types.NewChain33Client(conn)
+	in := &types.ReqSubscribe{Name: "mempool", Type: int32(PushMempoolTx), Contract: map[string]bool{"coins": true}}
+	stream, err := gcli.SubEvent(context.Background(), in)
+	require.Nil(t, err)
+	require.Equal(t, int32(1), <-pushSwitch)
+
+	//只推送执行器为coins的交易
+	chain := c.Client()
+	for _, execer := range []string{"none", "coins"} {
+		tx := &types.Transaction{Execer: []byte(execer), Nonce: 1}
+		require.Nil(t, chain.Send(chain.NewMessage("rpc", types.EventPushMempoolTx, tx), false))
+	}
+	data, err := stream.Recv()
+	require.Nil(t, err)
+	require.Equal(t, "mempool", data.GetName())
+	require.Equal(t, 1, len(data.GetMempoolTxs().GetTxs()))
+	require.Equal(t, "coins", string(data.GetMempoolTxs().GetTxs()[0].GetExecer()))
+
+	//取消订阅后服务端结束推送，关闭mempool的推送
+	reply, err := gcli.UnSubEvent(context.Background(), &types.ReqString{Data: in.Name})
+	require.Nil(t, err)
+	require.True(t, reply.GetIsOk())
+	_, err = stream.Recv()
+	require.NotNil(t, err)
+	require.Equal(t, int32(0), <-pushSwitch)
+	_, err = gcli.UnSubEvent(context.Background(), &types.ReqString{Data: in.Name})
+	require.NotNil(t, err)
+}
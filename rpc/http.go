@@ -15,10 +15,14 @@ import (
 	"net/http"
 	"net/rpc/jsonrpc"
 	"strings"
+	"sync"
 
+	"github.com/33cn/chain33/rpc/apikey"
+	"github.com/golang/protobuf/proto"
 	"github.com/rs/cors"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	pr "google.golang.org/grpc/peer"
 )
 
@@ -46,6 +50,31 @@ func (c *HTTPConn) Write(d []byte) (n int, err error) { //添加支持gzip 发
 // Close rewrite the close of http
 func (c *HTTPConn) Close() error { return nil }
 
+//批量请求默认最多包含的请求个数
+const defaultJrpcMaxBatchSize = 100
+
+//batchConn 批量请求中单个请求的读写，结果写入缓存，所有请求处理完之后一起返回
+type batchConn struct {
+	in  io.Reader
+	out *bytes.Buffer
+}
+
+func (c *batchConn) Read(p []byte) (n int, err error)  { return c.in.Read(p) }
+func (c *batchConn) Write(d []byte) (n int, err error) { return c.out.Write(d) }
+func (c *batchConn) Close() error                      { return nil }
+
+//countWriter 记录返回数据的字节数，用于api key的流量统计
+type countWriter struct {
+	io.Writer
+	n int
+}
+
+func (w *countWriter) Write(d []byte) (int, error) {
+	n, err := w.Writer.Write(d)
+	w.n += n
+	return n, err
+}
+
 // Listen jsonsever listen
 func (j *JSONRPCServer) Listen() (int, error) {
 	listener, err := net.Listen("tcp", rpcCfg.JrpcBindAddr)
@@ -63,7 +92,13 @@ func (j *JSONRPCServer) Listen() (int, error) {
 			writeError(w, r, 0, fmt.Sprintf(`The %s Address is not authorized!`, ip))
 			return
 		}
-
+		isLoopback := net.ParseIP(ip).IsLoopback()
+		cli, err := apiKeys.Client(r.Header.Get(apikey.Header), isLoopback)
+		if err != nil {
+			writeError(w, r, 0, err.Error())
+			return
+		}
+		//携带api key的请求同样需要通过ip白名单和basic auth检查
 		if !checkIPWhitelist(ip) {
 			writeError(w, r, 0, fmt.Sprintf(`The %s Address is not authorized!`, ip))
 			return
@@ -79,6 +114,11 @@ func (j *JSONRPCServer) Listen() (int, error) {
 				writeError(w, r, 0, "Can't get request body!")
 				return
 			}
+			//json rpc 2.0 批量请求
+			if isBatchRequest(data) {
+				j.serveBatch(w, r, isLoopback, cli, data)
+				return
+			}
 			//格式做一个检查
 			client, err := parseJSONRpcParams(data)
 			if err != nil {
@@ -92,21 +132,22 @@ func (j *JSONRPCServer) Listen() (int, error) {
 				log.Debug("JSONRPCServer", "request", string(data))
 			}
 			//Release local request
-			ipaddr := net.ParseIP(ip)
-			if !ipaddr.IsLoopback() {
-				//funcName := strings.Split(client.Method, ".")[len(strings.Split(client.Method, "."))-1]
-				if checkJrpcFuncBlacklist(funcName) || !checkJrpcFuncWhitelist(funcName) {
-					writeError(w, r, client.ID, fmt.Sprintf(`The %s method is not authorized!`, funcName))
-					return
-				}
+			err = checkJrpcFunc(cli, funcName, len(data), isLoopback)
+			if err != nil {
+				writeError(w, r, client.ID, err.Error())
+				return
 			}
-			serverCodec := jsonrpc.NewServerCodec(&HTTPConn{in: ioutil.NopCloser(bytes.NewReader(data)), out: w, r: r})
+			out := &countWriter{Writer: w}
+			serverCodec := jsonrpc.NewServerCodec(&HTTPConn{in: ioutil.NopCloser(bytes.NewReader(data)), out: out, r: r})
 			w.Header().Set("Content-type", "application/json")
 			if strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
 				w.Header().Set("Content-Encoding", "gzip")
 			}
 			w.WriteHeader(200)
 			err = j.s.ServeRequest(serverCodec)
+			if cli != nil {
+				cli.AddBytes(out.n)
+			}
 			if err != nil {
 				log.Debug("Error while serving JSON request: %v", err)
 				return
@@ -123,6 +164,119 @@ func (j *JSONRPCServer) Listen() (int, error) {
 	return listener.Addr().(*net.TCPAddr).Port, nil
 }
 
+//isBatchRequest 请求体是json数组时为批量请求
+func isBatchRequest(data []byte) bool {
+	data = bytes.TrimLeft(data, " \t\r\n")
+	return len(data) > 0 && data[0] == '['
+}
+
+//jrpcMaxBatchSize 批量请求最多包含的请求个数
+func jrpcMaxBatchSize() int {
+	if rpcCfg.JrpcMaxBatchSize <= 0 {
+		return defaultJrpcMaxBatchSize
+	}
+	return rpcCfg.JrpcMaxBatchSize
+}
+
+//serveBatch 处理批量请求，每个请求单独做方法的黑白名单检查，结果按请求的顺序返回
+func (j *JSONRPCServer) serveBatch(w http.ResponseWriter, r *http.Request, isLoopback bool, cli *apikey.Client, data []byte) {
+	var reqs []json.RawMessage
+	err := json.Unmarshal(data, &reqs)
+	if err != nil {
+		writeError(w, r, 0, fmt.Sprintf(`invalid json request err:%s`, err.Error()))
+		return
+	}
+	maxBatchSize := jrpcMaxBatchSize()
+	if len(reqs) == 0 || len(reqs) > maxBatchSize {
+		writeError(w, r, 0, fmt.Sprintf(`invalid batch request size:%d, max:%d`, len(reqs), maxBatchSize))
+		return
+	}
+	resps := make([]json.RawMessage, len(reqs))
+	var wg sync.WaitGroup
+	for i, req := range reqs {
+		wg.Add(1)
+		go func(i int, req []byte) {
+			defer wg.Done()
+			resps[i] = j.serveBatchRequest(req, isLoopback, cli)
+		}(i, req)
+	}
+	wg.Wait()
+	resps = batchResponses(reqs, resps)
+	w.Header().Set("Content-type", "application/json")
+	if len(resps) == 0 {
+		w.WriteHeader(200)
+		return
+	}
+	resp, err := json.Marshal(resps)
+	if err != nil {
+		writeError(w, r, 0, err.Error())
+		return
+	}
+	if strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+		w.Header().Set("Content-Encoding", "gzip")
+	}
+	w.WriteHeader(200)
+	conn := &HTTPConn{out: w, r: r}
+	_, err = conn.Write(resp)
+	if err != nil {
+		log.Debug("serveBatch Write", "err", err)
+	}
+}
+
+//serveBatchRequest 处理批量请求中的单个请求，返回单个请求的结果
+func (j *JSONRPCServer) serveBatchRequest(data []byte, isLoopback bool, cli *apikey.Client) json.RawMessage {
+	client, err := parseJSONRpcParams(data)
+	if err != nil {
+		return errorResponse(0, fmt.Sprintf(`invalid json request err:%s`, err.Error()))
+	}
+	funcName := strings.Split(client.Method, ".")[len(strings.Split(client.Method, "."))-1]
+	if !checkFilterPrintFuncBlacklist(funcName) {
+		log.Debug("JSONRPCServer batch", "request", string(data))
+	}
+	err = checkJrpcFunc(cli, funcName, len(data), isLoopback)
+	if err != nil {
+		return errorResponse(client.ID, err.Error())
+	}
+	out := &bytes.Buffer{}
+	serverCodec := jsonrpc.NewServerCodec(&batchConn{in: bytes.NewReader(data), out: out})
+	err = j.s.ServeRequest(serverCodec)
+	if cli != nil {
+		cli.AddBytes(out.Len())
+	}
+	if err != nil {
+		log.Debug("Error while serving JSON batch request", "err", err)
+		return errorResponse(client.ID, err.Error())
+	}
+	return bytes.TrimSpace(out.Bytes())
+}
+
+//batchResponses 批量请求的结果按json rpc 2.0的格式返回，通知(没有id的请求)不返回结果
+func batchResponses(reqs, resps []json.RawMessage) []json.RawMessage {
+	var out []json.RawMessage
+	for i, resp := range resps {
+		if isNotification(reqs[i]) {
+			continue
+		}
+		if len(resp) > 2 && resp[0] == '{' {
+			resp = append([]byte(`{"jsonrpc":"2.0",`), resp[1:]...)
+		}
+		out = append(out, resp)
+	}
+	return out
+}
+
+//isNotification 有method但是没有id字段的请求为通知，格式错误的请求仍然返回错误
+func isNotification(data []byte) bool {
+	var req struct {
+		Method string           `json:"method"`
+		ID     *json.RawMessage `json:"id"`
+	}
+	if err := json.Unmarshal(data, &req); err != nil {
+		return false
+	}
+	return req.Method != "" && req.ID == nil
+}
+
 type serverResponse struct {
 	ID     uint64      `json:"id"`
 	Result interface{} `json:"result"`
@@ -133,18 +287,26 @@ func writeError(w http.ResponseWriter, r *http.Request, id uint64, errstr string
 	w.Header().Set("Content-type", "application/json")
 	//错误的请求也返回 200
 	w.WriteHeader(200)
-	resp, err := json.Marshal(&serverResponse{id, nil, errstr})
-	if err != nil {
-		log.Debug("json marshal error, nerver happen")
+	resp := errorResponse(id, errstr)
+	if resp == nil {
 		return
 	}
-	_, err = w.Write(resp)
+	_, err := w.Write(resp)
 	if err != nil {
 		log.Debug("Write", "err", err)
 		return
 	}
 }
 
+func errorResponse(id uint64, errstr string) json.RawMessage {
+	resp, err := json.Marshal(&serverResponse{id, nil, errstr})
+	if err != nil {
+		log.Debug("json marshal error, nerver happen")
+		return nil
+	}
+	return resp
+}
+
 // Listen grpcserver listen
 func (g *Grpcserver) Listen() (int, error) {
 	listener, err := net.Listen("tcp", rpcCfg.GrpcBindAddr)
@@ -163,7 +325,8 @@ func isLoopBackAddr(addr net.Addr) bool {
 	return false
 }
 
-func auth(ctx context.Context, info *grpc.UnaryServerInfo) error {
+//auth 检查ip白名单和全局方法黑名单，checkFunc为true时检查方法白名单，携带api key的请求由key的方法权限代替白名单
+func auth(ctx context.Context, fullMethod string, checkFunc bool) error {
 	getctx, ok := pr.FromContext(ctx)
 	if ok {
 		if isLoopBackAddr(getctx.Addr) {
@@ -179,8 +342,9 @@ func auth(ctx context.Context, info *grpc.UnaryServerInfo) error {
 			return fmt.Errorf("the %s Address is not authorized", ip)
 		}
 
-		funcName := strings.Split(info.FullMethod, "/")[len(strings.Split(info.FullMethod, "/"))-1]
-		if !checkGrpcFuncValidity(funcName) {
+		funcName := grpcFuncName(fullMethod)
+		//全局黑名单对携带api key的请求同样生效
+		if checkGrpcFuncBlacklist(funcName) || (checkFunc && !checkGrpcFuncValidity(funcName)) {
 			return fmt.Errorf("the %s method is not authorized", funcName)
 		}
 		return nil
@@ -188,6 +352,63 @@ func auth(ctx context.Context, info *grpc.UnaryServerInfo) error {
 	return fmt.Errorf("can't get remote ip")
 }
 
+//grpcAPIKeyClient 从grpc metadata中获取api key对应的客户端
+func grpcAPIKeyClient(ctx context.Context) (*apikey.Client, error) {
+	var key string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if keys := md.Get(apikey.Header); len(keys) > 0 {
+			key = keys[0]
+		}
+	}
+	var ip string
+	if getctx, ok := pr.FromContext(ctx); ok {
+		ip, _, _ = net.SplitHostPort(getctx.Addr.String())
+	}
+	return apiKeys.Client(key, net.ParseIP(ip).IsLoopback())
+}
+
+//grpcAuth 检查ip白名单和方法黑白名单，携带api key的请求再按key的方法权限和限流检查
+func grpcAuth(ctx context.Context, fullMethod string, size int) (*apikey.Client, error) {
+	cli, err := grpcAPIKeyClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	//携带api key的请求同样需要通过ip白名单检查
+	if err := auth(ctx, fullMethod, cli == nil); err != nil {
+		return nil, err
+	}
+	if cli != nil {
+		if err := cli.Allow(grpcFuncName(fullMethod), size); err != nil {
+			return nil, err
+		}
+	}
+	return cli, nil
+}
+
+func grpcFuncName(fullMethod string) string {
+	return fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+}
+
+//apiKeyServerStream 流式接口推送的每条数据都计入api key的请求数和字节数限制
+type apiKeyServerStream struct {
+	grpc.ServerStream
+	cli *apikey.Client
+}
+
+func (s *apiKeyServerStream) SendMsg(m interface{}) error {
+	if err := s.cli.Allow("", protoSize(m)); err != nil {
+		return err
+	}
+	return s.ServerStream.SendMsg(m)
+}
+
+func protoSize(msg interface{}) int {
+	if m, ok := msg.(proto.Message); ok {
+		return proto.Size(m)
+	}
+	return 0
+}
+
 type clientRequest struct {
 	Method string         `json:"method"`
 	Params [1]interface{} `json:"params"`
@@ -241,6 +241,7 @@ func (c *Chain33) GetLastHeader(in *types.ReqNil, result *interface{}) error {
 		header.Hash = common.ToHex(reply.GetHash())
 		header.TxCount = reply.TxCount
 		header.Difficulty = reply.GetDifficulty()
+		header.BaseFee = reply.GetBaseFee()
 		/* 空值，斩不显示
 		Signature: &Signature{
 			Ty:        reply.GetSignature().GetTy(),
@@ -659,6 +660,7 @@ func (c *Chain33) GetPeerInfo(in *types.P2PGetPeerReq, result *interface{}) erro
 			pr.RunningTime = peer.GetRunningTime()
 			pr.FullNode = peer.GetFullNode()
 			pr.Blocked = peer.GetBlocked()
+			pr.Score = peer.GetScore()
 			peerlist.Peers = append(peerlist.Peers, &pr)
 
 		}
@@ -686,6 +688,7 @@ func (c *Chain33) GetHeaders(in *types.ReqBlocks, result *interface{}) error {
 				StateHash:  common.ToHex(item.GetStateHash()),
 				TxHash:     common.ToHex(item.GetTxHash()),
 				Difficulty: item.GetDifficulty(),
+				BaseFee:    item.GetBaseFee(),
 				/* 空值，斩不显示
 				Signature: &Signature{
 					Ty:        item.GetSignature().GetTy(),
@@ -761,6 +764,7 @@ func (c *Chain33) GetBlockOverview(in rpctypes.QueryParm, result *interface{}) e
 	header.Hash = common.ToHex(reply.GetHead().GetHash())
 	header.TxCount = reply.GetHead().GetTxCount()
 	header.Difficulty = reply.GetHead().GetDifficulty()
+	header.BaseFee = reply.GetHead().GetBaseFee()
 	/* 空值，斩不显示
 	header.Signature = &Signature{
 		Ty:        reply.GetHead().GetSignature().GetTy(),
@@ -1008,6 +1012,12 @@ func (c *Chain33) GetTotalCoins(in *types.ReqGetTotalCoins, result *interface{})
 	return nil
 }
 
+// GetAPIKeyUsage 获取所有api key的使用统计
+func (c *Chain33) GetAPIKeyUsage(in *types.ReqNil, result *interface{}) error {
+	*result = apiKeys.Usages()
+	return nil
+}
+
 // IsSync is sync or not
 func (c *Chain33) IsSync(in *types.ReqNil, result *interface{}) error {
 	reply, err := c.cli.IsSync()
@@ -1076,7 +1086,7 @@ func (c *Chain33) GetNetInfo(in *types.P2PGetNetInfoReq, result *interface{}) er
 	if err != nil {
 		return err
 	}
-	*result = &rpctypes.NodeNetinfo{
+	info := &rpctypes.NodeNetinfo{
 		Externaladdr: resp.GetExternaladdr(),
 		Localaddr:    resp.GetLocaladdr(),
 		Service:      resp.GetService(),
@@ -1088,9 +1098,29 @@ func (c *Chain33) GetNetInfo(in *types.P2PGetNetInfoReq, result *interface{}) er
 		Rateout:      resp.GetRateout(),
 		Ratetotal:    resp.GetRatetotal(),
 	}
+	if bandwidth := resp.GetBandwidth(); bandwidth != nil {
+		info.Bandwidth = convertBandwidthUsage(bandwidth)
+	}
+	for _, bandwidth := range resp.GetProtocolBandwidth() {
+		info.ProtocolBandwidth = append(info.ProtocolBandwidth, convertBandwidthUsage(bandwidth))
+	}
+	*result = info
 	return nil
 }
 
+func convertBandwidthUsage(usage *types.BandwidthUsage) *rpctypes.BandwidthUsage {
+	return &rpctypes.BandwidthUsage{
+		Protocol:    usage.GetProtocol(),
+		Priority:    usage.GetPriority(),
+		RateIn:      usage.GetRateIn(),
+		RateOut:     usage.GetRateOut(),
+		LimitIn:     usage.GetLimitIn(),
+		LimitOut:    usage.GetLimitOut(),
+		ThrottleIn:  usage.GetThrottleIn(),
+		ThrottleOut: usage.GetThrottleOut(),
+	}
+}
+
 // GetFatalFailure return fatal failure
 func (c *Chain33) GetFatalFailure(in *types.ReqNil, result *interface{}) error {
 	reply, err := c.cli.ExecWalletFunc("wallet", "FatalFailure", &types.ReqNil{})
@@ -1288,6 +1318,166 @@ func (c *Chain33) GetPushSeqLastNum(in *types.ReqString, result *interface{}) er
 	return nil
 }
 
+// RemovePushSubscribe  remove push subscribe
+func (c *Chain33) RemovePushSubscribe(in *types.ReqPushSubscribeAuth, result *interface{}) error {
+	in.Operator = false
+	resp, err := c.cli.RemovePushSubscribe(in)
+	if err != nil {
+		return err
+	}
+	*result = resp
+	return nil
+}
+
+// PausePushSubscribe  pause push subscribe
+func (c *Chain33) PausePushSubscribe(in *types.ReqPushSubscribeAuth, result *interface{}) error {
+	in.Operator = false
+	resp, err := c.cli.PausePushSubscribe(in)
+	if err != nil {
+		return err
+	}
+	*result = resp
+	return nil
+}
+
+// OperatorRemovePushSubscribe  remove push subscribe without secret, only for loopback or explicitly whitelisted callers
+func (c *Chain33) OperatorRemovePushSubscribe(in *types.ReqPushSubscribeAuth, result *interface{}) error {
+	in.Operator = true
+	resp, err := c.cli.RemovePushSubscribe(in)
+	if err != nil {
+		return err
+	}
+	*result = resp
+	return nil
+}
+
+// OperatorPausePushSubscribe  pause push subscribe without secret, only for loopback or explicitly whitelisted callers
+func (c *Chain33) OperatorPausePushSubscribe(in *types.ReqPushSubscribeAuth, result *interface{}) error {
+	in.Operator = true
+	resp, err := c.cli.PausePushSubscribe(in)
+	if err != nil {
+		return err
+	}
+	*result = resp
+	return nil
+}
+
+// GetPushStats  get push service stats
+func (c *Chain33) GetPushStats(in *types.ReqString, result *interface{}) error {
+	resp, err := c.cli.GetPushStats(in)
+	if err != nil {
+		return err
+	}
+	*result = resp
+	return nil
+}
+
+// ReadPushOutbox  read push records from outbox
+func (c *Chain33) ReadPushOutbox(in *types.ReqPushOutbox, result *interface{}) error {
+	resp, err := c.cli.ReadPushOutbox(in)
+	if err != nil {
+		return err
+	}
+	*result = resp
+	return nil
+}
+
+// GetStateProof get values and mavl proofs of keys, anchored to header's stateHash
+func (c *Chain33) GetStateProof(in *rpctypes.ReqStateProof, result *interface{}) error {
+	if in == nil {
+		return types.ErrInvalidParam
+	}
+	req := &types.ReqStateProof{Height: in.Height}
+	if in.StateHash != "" {
+		hash, err := common.FromHex(in.StateHash)
+		if err != nil {
+			return err
+		}
+		req.StateHash = hash
+	}
+	for _, key := range in.Keys {
+		k, err := common.FromHex(key)
+		if err != nil {
+			return err
+		}
+		req.Keys = append(req.Keys, k)
+	}
+	reply, err := c.cli.GetStateProof(req)
+	if err != nil {
+		return err
+	}
+	var jsonMsg json.RawMessage
+	jsonMsg, err = types.PBToJSON(reply)
+	if err != nil {
+		return err
+	}
+	*result = jsonMsg
+	return nil
+}
+
+// SimulateTransaction execute tx or tx group without commit, return receipt, state changes and fee
+func (c *Chain33) SimulateTransaction(in *rpctypes.ReqSimulateTx, result *interface{}) error {
+	if in == nil {
+		return types.ErrInvalidParam
+	}
+	var tx types.Transaction
+	data, err := common.FromHex(in.Tx)
+	if err != nil {
+		return err
+	}
+	err = types.Decode(data, &tx)
+	if err != nil {
+		return err
+	}
+	req := &types.ReqSimulateTx{Tx: &tx, SkipSign: in.SkipSign, SignType: in.SignType}
+	if in.StateHash != "" {
+		req.StateHash, err = common.FromHex(in.StateHash)
+		if err != nil {
+			return err
+		}
+	}
+	if in.PubKey != "" {
+		req.PubKey, err = common.FromHex(in.PubKey)
+		if err != nil {
+			return err
+		}
+	}
+	reply, err := c.cli.SimulateTransaction(req)
+	if err != nil {
+		return err
+	}
+	txs := []*types.Transaction{&tx}
+	if tx.GetGroupCount() > 0 {
+		group, err := tx.GetTxGroup()
+		if err != nil {
+			return err
+		}
+		txs = group.GetTxs()
+	}
+	ret := &rpctypes.ReplySimulateTx{StateHash: common.ToHex(reply.GetStateHash()), Height: reply.GetHeight()}
+	for i, res := range reply.GetResults() {
+		rdata := &rpctypes.ReceiptData{Ty: res.GetReceipt().GetTy()}
+		for _, l := range res.GetReceipt().GetLogs() {
+			rdata.Logs = append(rdata.Logs, &rpctypes.ReceiptLog{Ty: l.GetTy(), Log: common.ToHex(l.GetLog())})
+		}
+		receipt, err := rpctypes.DecodeLog(txs[i].GetExecer(), rdata)
+		if err != nil {
+			return err
+		}
+		item := &rpctypes.SimulateTxResult{Hash: common.ToHex(res.GetHash()), Receipt: receipt, Fee: res.GetFee()}
+		for _, change := range res.GetChanges() {
+			item.Changes = append(item.Changes, &rpctypes.StateChange{
+				Key:    common.ToHex(change.GetKey()),
+				Before: common.ToHex(change.GetBefore()),
+				After:  common.ToHex(change.GetAfter()),
+			})
+		}
+		ret.Results = append(ret.Results, item)
+	}
+	*result = ret
+	return nil
+}
+
 func convertBlockDetails(details []*types.BlockDetail, retDetails *rpctypes.BlockDetails, isDetail bool, coinPercision int64) error {
 	for _, item := range details {
 		var bdtl rpctypes.BlockDetail
@@ -1305,6 +1495,7 @@ func convertBlockDetails(details []*types.BlockDetail, retDetails *rpctypes.Bloc
 		block.Difficulty = item.Block.Difficulty
 		block.MainHash = common.ToHex(item.Block.MainHash)
 		block.MainHeight = item.Block.MainHeight
+		block.BaseFee = item.Block.BaseFee
 		if item.Block.Signature != nil {
 			block.Signature = &rpctypes.Signature{Ty: item.Block.Signature.Ty, Pubkey: common.ToHex(item.Block.Signature.Pubkey),
 				Signature: common.ToHex(item.Block.Signature.Signature)}
@@ -1439,6 +1630,7 @@ func convertHeader(header *types.Header, message *rpctypes.Header) {
 	message.Hash = common.ToHex(header.GetHash())
 	message.TxCount = header.GetTxCount()
 	message.Difficulty = header.GetDifficulty()
+	message.BaseFee = header.GetBaseFee()
 	if header.Signature != nil {
 		message.Signature = &rpctypes.Signature{Ty: header.Signature.Ty, Pubkey: common.ToHex(header.Signature.Pubkey),
 			Signature: common.ToHex(header.Signature.Signature)}
@@ -1670,6 +1862,17 @@ func (c *Chain33) ShowBlacklist(in *types.ReqNil, result *interface{}) error {
 	return nil
 }
 
+//PeerScores show scores of misbehaving peers
+func (c *Chain33) PeerScores(in *types.ReqNil, result *interface{}) error {
+	reply, err := c.cli.PeerScores(in)
+	if err != nil {
+		return err
+	}
+
+	*result = reply.GetScores()
+	return nil
+}
+
 //DialPeer dial the specified peer
 func (c *Chain33) DialPeer(in *types.SetPeer, result *interface{}) error {
 	reply, err := c.cli.DialPeer(in)
@@ -6,6 +6,7 @@ package rpc
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"testing"
 
@@ -24,6 +25,7 @@ import (
 	cty "github.com/33cn/chain33/system/dapp/coins/types"
 	mty "github.com/33cn/chain33/system/dapp/manage/types"
 	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -1643,6 +1645,102 @@ func TestChain33_GetSeqCallBackLastNum(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestChain33_RemovePushSubscribe(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	client := newTestChain33(api)
+	var testResult interface{}
+	api.On("RemovePushSubscribe", mock.Anything).Return(&types.ReplySubscribePush{IsOk: true}, nil)
+	//客户端设置的operator无效
+	req := &types.ReqPushSubscribeAuth{Name: "test", Secret: "123", Operator: true}
+	err := client.RemovePushSubscribe(req, &testResult)
+	assert.NoError(t, err)
+	assert.True(t, testResult.(*types.ReplySubscribePush).IsOk)
+	assert.False(t, req.Operator)
+
+	req = &types.ReqPushSubscribeAuth{Name: "test"}
+	err = client.OperatorRemovePushSubscribe(req, &testResult)
+	assert.NoError(t, err)
+	assert.True(t, req.Operator)
+
+	api.On("PausePushSubscribe", mock.Anything).Return(nil, types.ErrPushAuthFailed)
+	err = client.PausePushSubscribe(&types.ReqPushSubscribeAuth{Name: "test"}, &testResult)
+	assert.Equal(t, types.ErrPushAuthFailed, err)
+}
+
+func TestChain33_GetPushStats(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	client := newTestChain33(api)
+	var testResult interface{}
+	stats := &types.PushStats{LastSequence: 10, Subscribers: []*types.PushSubscriberStats{{Name: "test", LastPushSeq: 8, Lag: 2}}}
+	api.On("GetPushStats", &types.ReqString{Data: "test"}).Return(stats, nil)
+	err := client.GetPushStats(&types.ReqString{Data: "test"}, &testResult)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), testResult.(*types.PushStats).Subscribers[0].Lag)
+
+	api.On("GetPushStats", &types.ReqString{Data: "none"}).Return(nil, types.ErrPushNotSubscribed)
+	err = client.GetPushStats(&types.ReqString{Data: "none"}, &testResult)
+	assert.Equal(t, types.ErrPushNotSubscribed, err)
+}
+
+func TestChain33_GetStateProof(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	client := newTestChain33(api)
+	var testResult interface{}
+	proof := &types.StateProof{
+		StateHash: []byte("state"),
+		Proofs:    []*types.StateKeyProof{{Key: []byte("k1"), Value: []byte("v1"), Exists: true}},
+	}
+	api.On("StoreGetProof", &types.StoreGet{StateHash: []byte("state"), Keys: [][]byte{[]byte("k1")}}).Return(proof, nil)
+	in := &rpctypes.ReqStateProof{StateHash: common.ToHex([]byte("state")), Keys: []string{common.ToHex([]byte("k1"))}}
+	err := client.GetStateProof(in, &testResult)
+	assert.NoError(t, err)
+	var reply types.ReplyStateProof
+	assert.Nil(t, types.JSONToPB(testResult.(json.RawMessage), &reply))
+	assert.Equal(t, types.Encode(proof), types.Encode(reply.Proof))
+
+	err = client.GetStateProof(&rpctypes.ReqStateProof{StateHash: "0xzz", Keys: in.Keys}, &testResult)
+	assert.NotNil(t, err)
+	err = client.GetStateProof(&rpctypes.ReqStateProof{StateHash: in.StateHash}, &testResult)
+	assert.Equal(t, types.ErrInvalidParam, err)
+}
+
+func TestChain33_SimulateTransaction(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	client := newTestChain33(api)
+	var testResult interface{}
+	err := client.SimulateTransaction(&rpctypes.ReqSimulateTx{Tx: "0xzz"}, &testResult)
+	assert.NotNil(t, err)
+
+	_, priv := util.Genaddress()
+	tx := util.CreateNoneTx(cfg, priv)
+	api.On("GetLastHeader").Return(&types.Header{Height: 1, StateHash: []byte("state1")}, nil)
+	fee := &types.ReceiptAccountTransfer{Prev: &types.Account{Balance: 100}, Current: &types.Account{Balance: 90}}
+	api.On("ExecTxList", mock.Anything).Return(&types.Receipts{Receipts: []*types.Receipt{{Ty: types.ExecOk,
+		KV:   []*types.KeyValue{{Key: []byte("k1"), Value: []byte("v1")}},
+		Logs: []*types.ReceiptLog{{Ty: types.TyLogFee, Log: types.Encode(fee)}}}}}, nil)
+	api.On("StoreGet", &types.StoreGet{StateHash: []byte("state2"), Keys: [][]byte{[]byte("k1")}}).Return(&types.StoreReplyValue{Values: [][]byte{[]byte("v0")}}, nil)
+	in := &rpctypes.ReqSimulateTx{Tx: common.ToHex(types.Encode(tx)), StateHash: common.ToHex([]byte("state2"))}
+	err = client.SimulateTransaction(in, &testResult)
+	assert.NoError(t, err)
+	reply := testResult.(*rpctypes.ReplySimulateTx)
+	assert.Equal(t, common.ToHex([]byte("state2")), reply.StateHash)
+	assert.Equal(t, int64(2), reply.Height)
+	assert.Equal(t, 1, len(reply.Results))
+	assert.Equal(t, common.ToHex(tx.Hash()), reply.Results[0].Hash)
+	assert.Equal(t, int64(10), reply.Results[0].Fee)
+	assert.Equal(t, "ExecOk", reply.Results[0].Receipt.TyName)
+	assert.Equal(t, "LogFee", reply.Results[0].Receipt.Logs[0].TyName)
+	assert.Equal(t, []*rpctypes.StateChange{{Key: common.ToHex([]byte("k1")), Before: common.ToHex([]byte("v0")), After: common.ToHex([]byte("v1"))}}, reply.Results[0].Changes)
+}
+
 func TestChain33_ConvertExectoAddr(t *testing.T) {
 	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
 	api := new(mocks.QueueProtocolAPI)
@@ -1938,6 +2036,21 @@ func TestChain33_ShowBlacklist(t *testing.T) {
 
 }
 
+func TestChain33_PeerScores(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	expected := &types.PeerScores{Scores: []*types.PeerScore{{PeerName: "testpid", Score: -20}}}
+	api.On("PeerScores", mock.Anything).Return(expected, nil)
+	testChain33 := newTestChain33(api)
+	var testResult interface{}
+	err := testChain33.PeerScores(&types.ReqNil{}, &testResult)
+	assert.Nil(t, err)
+	scores, ok := testResult.([]*types.PeerScore)
+	assert.True(t, ok)
+	assert.Equal(t, int32(-20), scores[0].Score)
+}
+
 func TestChain33_DialPeer(t *testing.T) {
 	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
 	api := new(mocks.QueueProtocolAPI)
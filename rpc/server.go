@@ -17,12 +17,14 @@ import (
 	"sync"
 	"time"
 
+	"github.com/33cn/chain33/rpc/apikey"
 	rpctypes "github.com/33cn/chain33/rpc/types"
 
 	rclient "github.com/33cn/chain33/rpc/client"
 	"github.com/33cn/chain33/rpc/ethrpc"
 
 	"github.com/33cn/chain33/client"
+	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/log/log15"
 	"github.com/33cn/chain33/pluginmgr"
 	"github.com/33cn/chain33/queue"
@@ -45,7 +47,13 @@ var (
 	grpcFuncBlacklist           = make(map[string]bool)
 	rpcFilterPrintFuncBlacklist = make(map[string]bool)
 	grpcFuncListLock            = sync.RWMutex{}
+	apiKeys                     *apikey.Manager
 	log                         = log15.New("module", "rpc_client")
+	//运维接口，只允许回环地址或者在jrpcFuncWhitelist中显式配置的调用者访问
+	jrpcOperatorFuncs = map[string]bool{
+		"OperatorRemovePushSubscribe": true,
+		"OperatorPausePushSubscribe":  true,
+	}
 )
 
 // Chain33  a channel client
@@ -58,6 +66,19 @@ type Grpc struct {
 	cli       rclient.ChannelClient
 	cachelock sync.Mutex
 	subCache  map[string]*subInfo // topic -->subInfo
+	client    queue.Client
+	//mempool交易的订阅者，不经过blockchain推送
+	mempoolLock sync.Mutex
+	mempoolSubs map[*mempoolSub]bool
+}
+
+//mempoolSub mempool新加入交易的订阅者
+type mempoolSub struct {
+	name   string
+	filter *types.PushFilter
+	txChan chan *types.Transaction
+	//grpc订阅通过UnSubEvent取消
+	quit chan struct{}
 }
 
 type subInfo struct {
@@ -69,6 +90,8 @@ type subInfo struct {
 	since time.Time
 	//同一个topic下多个订阅者分配不同的channel来接收订阅的消息
 	subChan map[chan *queue.Message]string
+	//发送给客户端成功之后由订阅者自己回复blockchain的channel
+	ackChan map[chan *queue.Message]bool
 }
 
 // Grpcserver a object
@@ -93,6 +116,20 @@ func (g *Grpc) addSubChan(topic string, dch chan *queue.Message) {
 	}
 }
 
+//addAckSubChan 加入由订阅者在发送成功之后回复blockchain的channel，推送失败时blockchain会重新推送
+func (g *Grpc) addAckSubChan(topic string, dch chan *queue.Message) {
+	g.cachelock.Lock()
+	defer g.cachelock.Unlock()
+	info, ok := g.subCache[topic]
+	if ok {
+		info.subChan[dch] = topic
+		if info.ackChan == nil {
+			info.ackChan = make(map[chan *queue.Message]bool)
+		}
+		info.ackChan[dch] = true
+	}
+}
+
 func (g *Grpc) delSubInfo(topic string, dch chan *queue.Message) error {
 	g.cachelock.Lock()
 	defer g.cachelock.Unlock()
@@ -100,6 +137,7 @@ func (g *Grpc) delSubInfo(topic string, dch chan *queue.Message) error {
 	if ok {
 		if dch != nil {
 			delete(info.subChan, dch)
+			delete(info.ackChan, dch)
 			if len(info.subChan) == 0 {
 				delete(g.subCache, topic)
 			}
@@ -116,8 +154,16 @@ func (g *Grpc) hashTopic(topic string) *subInfo {
 	g.cachelock.Lock()
 	defer g.cachelock.Unlock()
 	if info, ok := g.subCache[topic]; ok {
-		//clone subinfo
+		//clone subinfo，推送时遍历channel不持有锁
 		cinfo := *info
+		cinfo.subChan = make(map[chan *queue.Message]string, len(info.subChan))
+		for ch, topic := range info.subChan {
+			cinfo.subChan[ch] = topic
+		}
+		cinfo.ackChan = make(map[chan *queue.Message]bool, len(info.ackChan))
+		for ch := range info.ackChan {
+			cinfo.ackChan[ch] = true
+		}
 		return &cinfo
 	}
 	return nil
@@ -143,6 +189,91 @@ func (g *Grpc) getSubUsers(topic string) uint32 {
 	return 0
 }
 
+//addMempoolSub 第一个mempool交易的订阅者加入时通知mempool开始推送
+func (g *Grpc) addMempoolSub(sub *mempoolSub) {
+	g.mempoolLock.Lock()
+	defer g.mempoolLock.Unlock()
+	g.mempoolSubs[sub] = true
+	if len(g.mempoolSubs) == 1 {
+		g.setMempoolPush(1)
+	}
+}
+
+//delMempoolSub 没有mempool交易的订阅者时通知mempool停止推送
+func (g *Grpc) delMempoolSub(sub *mempoolSub) {
+	g.mempoolLock.Lock()
+	defer g.mempoolLock.Unlock()
+	if !g.mempoolSubs[sub] {
+		return
+	}
+	delete(g.mempoolSubs, sub)
+	if len(g.mempoolSubs) == 0 {
+		g.setMempoolPush(0)
+	}
+}
+
+//quitMempoolSub 取消grpc的mempool交易订阅，返回是否存在该名称的订阅
+func (g *Grpc) quitMempoolSub(name string) bool {
+	g.mempoolLock.Lock()
+	defer g.mempoolLock.Unlock()
+	var found bool
+	for sub := range g.mempoolSubs {
+		if sub.name == name && sub.quit != nil {
+			delete(g.mempoolSubs, sub)
+			close(sub.quit)
+			found = true
+		}
+	}
+	if found && len(g.mempoolSubs) == 0 {
+		g.setMempoolPush(0)
+	}
+	return found
+}
+
+func (g *Grpc) setMempoolPush(push int32) {
+	msg := g.client.NewMessage("mempool", types.EventSubMempoolTx, &types.Int32{Data: push})
+	err := g.client.Send(msg, false)
+	if err != nil {
+		log.Error("grpc setMempoolPush", "push", push, "err", err)
+	}
+}
+
+//pushMempoolTx mempool新加入的交易发送给过滤条件匹配的订阅者，订阅者的缓存满时丢弃，不阻塞rpc的事件处理
+func (g *Grpc) pushMempoolTx(tx *types.Transaction) {
+	g.mempoolLock.Lock()
+	defer g.mempoolLock.Unlock()
+	for sub := range g.mempoolSubs {
+		if !matchMempoolTx(sub.filter, tx) {
+			continue
+		}
+		select {
+		case sub.txChan <- tx:
+		default:
+			log.Debug("grpc pushMempoolTx channel full", "name", sub.name, "hash", common.ToHex(tx.Hash()))
+		}
+	}
+}
+
+//matchMempoolTx 配置了执行器或者to地址时，交易需要和其中之一匹配
+func matchMempoolTx(filter *types.PushFilter, tx *types.Transaction) bool {
+	if len(filter.GetExecers()) > 0 && !containsString(filter.GetExecers(), string(tx.GetExecer())) {
+		return false
+	}
+	if len(filter.GetToAddrs()) > 0 && !containsString(filter.GetToAddrs(), tx.GetTo()) {
+		return false
+	}
+	return true
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
 // NewGrpcServer new  GrpcServer object
 func NewGrpcServer() *Grpcserver {
 	return &Grpcserver{grpc: &Grpc{}}
@@ -237,6 +368,13 @@ func checkGrpcFuncValidity(funcName string) bool {
 	return false
 }
 
+func checkGrpcFuncBlacklist(funcName string) bool {
+	grpcFuncListLock.RLock()
+	defer grpcFuncListLock.RUnlock()
+	_, ok := grpcFuncBlacklist[funcName]
+	return ok
+}
+
 func checkJrpcFuncBlacklist(funcName string) bool {
 	if _, ok := jrpcFuncBlacklist[funcName]; ok {
 		return true
@@ -264,18 +402,36 @@ func (j *Grpcserver) Close() {
 func NewGRpcServer(c queue.Client, api client.QueueProtocolAPI) *Grpcserver {
 	s := &Grpcserver{grpc: &Grpc{}}
 	s.grpc.subCache = make(map[string]*subInfo)
+	s.grpc.mempoolSubs = make(map[*mempoolSub]bool)
+	s.grpc.client = c
 	s.grpc.cli.Init(c, api)
 	var opts []grpc.ServerOption
 	//register interceptor
 	//var interceptor grpc.UnaryServerInterceptor
 	interceptor := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
-		if err := auth(ctx, info); err != nil {
+		cli, err := grpcAuth(ctx, info.FullMethod, protoSize(req))
+		if err != nil {
 			return nil, err
 		}
 		// Continue processing the request
-		return handler(ctx, req)
+		resp, err = handler(ctx, req)
+		if cli != nil {
+			cli.AddBytes(protoSize(resp))
+		}
+		return resp, err
+	}
+	//流式接口同样需要api key，方法权限和限流检查
+	streamInterceptor := func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		cli, err := grpcAuth(ss.Context(), info.FullMethod, 0)
+		if err != nil {
+			return err
+		}
+		if cli != nil {
+			ss = &apiKeyServerStream{ServerStream: ss, cli: cli}
+		}
+		return handler(srv, ss)
 	}
-	opts = append(opts, grpc.UnaryInterceptor(interceptor))
+	opts = append(opts, grpc.UnaryInterceptor(interceptor), grpc.StreamInterceptor(streamInterceptor))
 	if rpcCfg.EnableTLS {
 		creds, err := credentials.NewServerTLSFromFile(rpcCfg.CertFile, rpcCfg.KeyFile)
 		if err != nil {
@@ -317,6 +473,7 @@ type RPC struct {
 	allCfg *types.Chain33Config
 	gapi   *Grpcserver
 	japi   *JSONRPCServer
+	wsapi  *WSServer
 	eapi   ethrpc.ServerAPI
 	ewsapi ethrpc.ServerAPI
 	cli    queue.Client
@@ -332,6 +489,7 @@ func InitCfg(rcfg *types.RPC) {
 	InitJrpcFuncBlacklist(rcfg)
 	InitGrpcFuncBlacklist(rcfg)
 	InitFilterPrintFuncBlacklist()
+	InitAPIKeys(rcfg)
 }
 
 // New produce a rpc by cfg
@@ -359,8 +517,11 @@ func (r *RPC) SetQueueClient(c queue.Client) {
 	r.ewsapi = ethrpc.NewHTTPServer(c, r.api)
 	r.gapi = gapi
 	r.japi = japi
+	r.wsapi = NewWSServer(japi, gapi)
 	r.cli = c
 	//配置rpc,websocket
+	r.eapi.SetAPIKeys(apiKeys)
+	r.ewsapi.SetAPIKeys(apiKeys)
 	r.eapi.EnableRPC()
 	r.ewsapi.EnableWS()
 	go r.handleSysEvent()
@@ -376,10 +537,13 @@ func (r *RPC) SetQueueClientNoListen(c queue.Client) {
 	japi := NewJSONRPCServer(c, r.api)
 	r.eapi = ethrpc.NewHTTPServer(c, r.api)
 	r.ewsapi = ethrpc.NewHTTPServer(c, r.api)
+	r.eapi.SetAPIKeys(apiKeys)
+	r.ewsapi.SetAPIKeys(apiKeys)
 	r.eapi.EnableRPC()
 	r.ewsapi.EnableWS()
 	r.gapi = gapi
 	r.japi = japi
+	r.wsapi = NewWSServer(japi, gapi)
 	r.cli = c
 
 }
@@ -437,13 +601,18 @@ func (r *RPC) handleSysEvent() {
 			topicInfo := r.gapi.grpc.hashTopic(msg.GetData().(*types.PushData).GetName())
 			if topicInfo != nil {
 				var ticket = time.NewTicker(time.Second)
+				//存在发送成功之后自己回复的订阅者时不在这里回复，每个消息只回复一次
+				replied := len(topicInfo.ackChan) > 0
 				for ch := range topicInfo.subChan {
 					select {
 					case <-ticket.C:
 						ticket.Reset(time.Second)
 						continue
 					case ch <- msg:
-						msg.Reply(r.cli.NewMessage("blockchain", msg.Ty, &types.Reply{IsOk: true}))
+						if !replied {
+							msg.Reply(r.cli.NewMessage("blockchain", msg.Ty, &types.Reply{IsOk: true}))
+							replied = true
+						}
 						ticket.Reset(time.Second)
 
 					}
@@ -455,6 +624,9 @@ func (r *RPC) handleSysEvent() {
 				msg.Reply(r.cli.NewMessage("blockchain", msg.Ty, &types.Reply{IsOk: false, Msg: []byte("no subscriber")}))
 			}
 
+		case types.EventPushMempoolTx:
+			r.gapi.grpc.pushMempoolTx(msg.GetData().(*types.Transaction))
+
 		default:
 			log.Error("rpc.handleSysEvent no support event:", msg.Ty)
 		}
@@ -493,6 +665,13 @@ func (r *RPC) Listen() (port1 int, port2 int, port3, port4 int) {
 	if err != nil {
 		log.Error("wsrpc Listen", "err", err)
 	}
+	if rpcCfg.JrpcWsBindAddr != "" {
+		wsport, err := r.wsapi.Listen()
+		if err != nil {
+			log.Error("jrpc websocket Listen", "err", err)
+		}
+		log.Info("rpc Listen port", "jrpcws", wsport)
+	}
 	log.Info("rpc Listen port", "grpc", port1, "jrpc", port2, "erpc", port3, "wsport:", port4)
 	//sleep for a while
 
@@ -525,12 +704,16 @@ func (r *RPC) Close() {
 	if r.japi != nil {
 		r.japi.Close()
 	}
+	if r.wsapi != nil {
+		r.wsapi.Close()
+	}
 	if r.eapi != nil {
 		r.eapi.Close()
 	}
 	if r.ewsapi != nil {
 		r.ewsapi.Close()
 	}
+	apiKeys.Close()
 	r.cli.Close()
 }
 
@@ -620,6 +803,34 @@ func InitGrpcFuncBlacklist(cfg *types.RPC) {
 	}
 }
 
+// InitAPIKeys init api keys，配置了api key文件时，携带api key的请求按key的方法权限和限流检查
+func InitAPIKeys(cfg *types.RPC) {
+	apiKeys.Close()
+	apiKeys = nil
+	if cfg.APIKeyFile == "" {
+		return
+	}
+	keys, err := apikey.New(cfg.APIKeyFile, cfg.APIKeyRequired)
+	if err != nil {
+		panic(fmt.Sprintf("load api key file:%s, err:%s", cfg.APIKeyFile, err.Error()))
+	}
+	apiKeys = keys
+}
+
+//checkJrpcFunc 非本地请求先检查全局黑名单和运维接口，携带api key的请求再按key的方法权限和限流检查，否则检查方法白名单
+func checkJrpcFunc(cli *apikey.Client, funcName string, size int, isLoopback bool) error {
+	if !isLoopback && (checkJrpcFuncBlacklist(funcName) || (jrpcOperatorFuncs[funcName] && !jrpcFuncWhitelist[funcName])) {
+		return fmt.Errorf(`The %s method is not authorized!`, funcName)
+	}
+	if cli != nil {
+		return cli.Allow(funcName, size)
+	}
+	if !isLoopback && !checkJrpcFuncWhitelist(funcName) {
+		return fmt.Errorf(`The %s method is not authorized!`, funcName)
+	}
+	return nil
+}
+
 // InitFilterPrintFuncBlacklist rpc模块打印requet信息时需要过滤掉一些敏感接口的入参打印，比如钱包密码相关的
 func InitFilterPrintFuncBlacklist() {
 	rpcFilterPrintFuncBlacklist["UnLock"] = true
@@ -640,5 +851,9 @@ func checkFilterPrintFuncBlacklist(funcName string) bool {
 type PushType int32
 
 func (pushType PushType) string() string {
-	return []string{"PushBlock", "PushBlockHeader", "PushTxReceipt", "PushTxResult", "PushEVMEvent", "NotSupported"}[pushType]
+	names := []string{"PushBlock", "PushBlockHeader", "PushTxReceipt", "PushTxResult", "PushEVMEvent", "PushMempoolTx"}
+	if pushType < 0 || int(pushType) >= len(names) {
+		return "NotSupported"
+	}
+	return names[pushType]
 }
@@ -7,7 +7,12 @@ package rpc
 import (
 	"encoding/json"
 	"errors"
+	"io/ioutil"
+	"net"
 	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -20,6 +25,7 @@ import (
 	"github.com/33cn/chain33/client/mocks"
 	"github.com/33cn/chain33/common"
 	qmocks "github.com/33cn/chain33/queue/mocks"
+	"github.com/33cn/chain33/rpc/apikey"
 	"github.com/33cn/chain33/rpc/jsonclient"
 	rpctypes "github.com/33cn/chain33/rpc/types"
 	"github.com/33cn/chain33/types"
@@ -27,6 +33,8 @@ import (
 	"github.com/stretchr/testify/mock"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 )
 
 func TestCheckIpWhitelist(t *testing.T) {
@@ -148,6 +156,148 @@ func TestJSONClient_Call(t *testing.T) {
 	mock.AssertExpectationsForObjects(t, api)
 }
 
+func TestJSONRPCBatch(t *testing.T) {
+	rpcCfg = new(types.RPC)
+	rpcCfg.JrpcBindAddr = "127.0.0.1:8201"
+	rpcCfg.Whitelist = []string{"127.0.0.1", "0.0.0.0"}
+	rpcCfg.JrpcFuncWhitelist = []string{"*"}
+	rpcCfg.JrpcFuncBlacklist = []string{"IsSync"}
+	rpcCfg.JrpcMaxBatchSize = 3
+	InitCfg(rpcCfg)
+	defer func() {
+		jrpcFuncBlacklist = make(map[string]bool)
+	}()
+	api := new(mocks.QueueProtocolAPI)
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	qm := &qmocks.Client{}
+	qm.On("GetConfig", mock.Anything).Return(cfg)
+	server := NewJSONRPCServer(qm, api)
+	assert.NotNil(t, server)
+	_, err := server.Listen()
+	assert.Nil(t, err)
+	defer server.Close()
+	api.On("Version").Return(&types.VersionInfo{Chain33: "6.0.2"}, nil)
+	api.On("IsSync").Return(&types.Reply{IsOk: true}, nil)
+	api.On("Close").Return()
+
+	post := func(body string) []map[string]interface{} {
+		resp, err := http.Post("http://"+rpcCfg.JrpcBindAddr, "application/json", strings.NewReader(body))
+		assert.Nil(t, err)
+		defer resp.Body.Close()
+		var results []map[string]interface{}
+		assert.Nil(t, json.NewDecoder(resp.Body).Decode(&results))
+		return results
+	}
+	//结果按请求的顺序返回，本地请求不做黑名单检查
+	results := post(` [{"jsonrpc":"2.0","id":3,"method":"Chain33.IsSync","params":[{}]},{"jsonrpc":"2.0","id":1,"method":"Chain33.Version","params":[]},"invalid"]`)
+	assert.Equal(t, 3, len(results))
+	assert.Equal(t, float64(3), results[0]["id"])
+	assert.Equal(t, true, results[0]["result"])
+	assert.Equal(t, float64(1), results[1]["id"])
+	assert.Equal(t, "6.0.2", results[1]["result"].(map[string]interface{})["chain33"])
+	assert.Nil(t, results[1]["error"])
+	assert.NotNil(t, results[2]["error"])
+	for _, result := range results {
+		assert.Equal(t, "2.0", result["jsonrpc"])
+	}
+
+	//通知不返回结果
+	results = post(`[{"jsonrpc":"2.0","method":"Chain33.Version","params":[]},{"jsonrpc":"2.0","id":5,"method":"Chain33.IsSync","params":[{}]}]`)
+	assert.Equal(t, 1, len(results))
+	assert.Equal(t, float64(5), results[0]["id"])
+	assert.Equal(t, true, results[0]["result"])
+	assert.Equal(t, "2.0", results[0]["jsonrpc"])
+
+	//全部为通知时不返回任何内容
+	resp, err := http.Post("http://"+rpcCfg.JrpcBindAddr, "application/json", strings.NewReader(`[{"jsonrpc":"2.0","method":"Chain33.Version","params":[]},{"jsonrpc":"2.0","method":"Chain33.IsSync","params":[{}]}]`))
+	assert.Nil(t, err)
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.Nil(t, err)
+	resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Empty(t, body)
+
+	//超过批量请求的最大个数
+	resp, err = http.Post("http://"+rpcCfg.JrpcBindAddr, "application/json", strings.NewReader(`[{},{},{},{}]`))
+	assert.Nil(t, err)
+	var errResp serverResponse
+	assert.Nil(t, json.NewDecoder(resp.Body).Decode(&errResp))
+	resp.Body.Close()
+	assert.Equal(t, "invalid batch request size:4, max:3", errResp.Error)
+
+	//非本地请求对每个请求做方法的黑白名单检查
+	assert.Nil(t, json.Unmarshal(server.serveBatchRequest([]byte(`{"id":2,"method":"Chain33.IsSync","params":[{}]}`), false, nil), &errResp))
+	assert.Equal(t, uint64(2), errResp.ID)
+	assert.Equal(t, "The IsSync method is not authorized!", errResp.Error)
+	var ret map[string]interface{}
+	assert.Nil(t, json.Unmarshal(server.serveBatchRequest([]byte(`{"id":2,"method":"Chain33.Version","params":[]}`), false, nil), &ret))
+	assert.Nil(t, ret["error"])
+}
+
+func TestJSONRPCAPIKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "apikey")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "apikey.toml")
+	assert.Nil(t, ioutil.WriteFile(file, []byte("[[keys]]\nname=\"partner\"\nkey=\"key1\"\nmethods=[\"Version\",\"GetAPIKeyUsage\"]\n"), 0600))
+	rpcCfg = new(types.RPC)
+	rpcCfg.JrpcBindAddr = "127.0.0.1:8203"
+	rpcCfg.JrpcFuncWhitelist = []string{"*"}
+	rpcCfg.APIKeyFile = file
+	InitCfg(rpcCfg)
+	defer InitAPIKeys(&types.RPC{})
+	api := new(mocks.QueueProtocolAPI)
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	api.On("Version").Return(&types.VersionInfo{Chain33: "6.0.2"}, nil)
+	api.On("Close").Return()
+	qm := &qmocks.Client{}
+	qm.On("GetConfig", mock.Anything).Return(cfg)
+	server := NewJSONRPCServer(qm, api)
+	_, err = server.Listen()
+	assert.Nil(t, err)
+	defer server.Close()
+
+	post := func(key, body string) map[string]interface{} {
+		req, err := http.NewRequest("POST", "http://"+rpcCfg.JrpcBindAddr, strings.NewReader(body))
+		assert.Nil(t, err)
+		if key != "" {
+			req.Header.Set(apikey.Header, key)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		assert.Nil(t, err)
+		defer resp.Body.Close()
+		var result map[string]interface{}
+		assert.Nil(t, json.NewDecoder(resp.Body).Decode(&result))
+		return result
+	}
+	result := post("key2", `{"id":1,"method":"Chain33.Version","params":[]}`)
+	assert.Equal(t, types.ErrAPIKeyInvalid.Error(), result["error"])
+	result = post("key1", `{"id":1,"method":"Chain33.IsSync","params":[{}]}`)
+	assert.Equal(t, types.ErrAPIKeyMethodDenied.Error(), result["error"])
+	result = post("key1", `{"id":1,"method":"Chain33.Version","params":[]}`)
+	assert.Nil(t, result["error"])
+	assert.Equal(t, "6.0.2", result["result"].(map[string]interface{})["chain33"])
+
+	//使用统计
+	result = post("", `{"id":1,"method":"Chain33.GetAPIKeyUsage","params":[{}]}`)
+	usages := result["result"].([]interface{})
+	assert.Equal(t, 1, len(usages))
+	usage := usages[0].(map[string]interface{})
+	assert.Equal(t, "partner", usage["name"])
+	assert.Equal(t, float64(2), usage["requests"])
+	assert.Equal(t, float64(1), usage["rejected"])
+
+	//携带api key的请求同样需要通过basic auth检查
+	rpcCfg.JrpcUserName, rpcCfg.JrpcUserPasswd = "chain33-user", "chain33-mypasswd"
+	defer func() {
+		rpcCfg.JrpcUserName, rpcCfg.JrpcUserPasswd = "", ""
+	}()
+	result = post("key1", `{"id":1,"method":"Chain33.Version","params":[]}`)
+	assert.Equal(t, "Unauthozied", result["error"])
+}
+
 func testDecodeTxHex(t *testing.T, txHex string) *types.Transaction {
 	txbytes, err := common.FromHex(txHex)
 	assert.Nil(t, err)
@@ -404,3 +554,53 @@ func TestCheckFuncList(t *testing.T) {
 	assert.False(t, checkGrpcFuncValidity(funcName))
 
 }
+
+func TestCheckJrpcOperatorFunc(t *testing.T) {
+	funcName := "OperatorRemovePushSubscribe"
+	jrpcFuncWhitelist = map[string]bool{"*": true}
+	jrpcFuncBlacklist = make(map[string]bool)
+	//运维接口不受通配白名单的影响
+	assert.Nil(t, checkJrpcFunc(nil, funcName, 0, true))
+	assert.NotNil(t, checkJrpcFunc(nil, funcName, 0, false))
+	jrpcFuncWhitelist[funcName] = true
+	assert.Nil(t, checkJrpcFunc(nil, funcName, 0, false))
+	jrpcFuncWhitelist = make(map[string]bool)
+}
+
+func TestCheckFuncWithAPIKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "apikey")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "apikey.toml")
+	assert.Nil(t, ioutil.WriteFile(file, []byte("[[keys]]\nname=\"partner\"\nkey=\"key1\"\nmethods=[\"*\"]\n"), 0600))
+	InitAPIKeys(&types.RPC{APIKeyFile: file, APIKeyRequired: true})
+	defer InitAPIKeys(&types.RPC{})
+	cli, err := apiKeys.Client("key1", false)
+	assert.Nil(t, err)
+
+	//全局黑名单和运维接口在api key的方法权限之前检查
+	jrpcFuncWhitelist = make(map[string]bool)
+	jrpcFuncBlacklist = map[string]bool{"CloseQueue": true}
+	assert.NotNil(t, checkJrpcFunc(cli, "CloseQueue", 0, false))
+	assert.NotNil(t, checkJrpcFunc(cli, "OperatorRemovePushSubscribe", 0, false))
+	assert.Nil(t, checkJrpcFunc(cli, "Version", 0, false))
+	assert.Nil(t, checkJrpcFunc(cli, "CloseQueue", 0, true))
+	jrpcFuncBlacklist = make(map[string]bool)
+
+	remoteIPWhitelist = map[string]bool{"192.168.1.1": true}
+	grpcFuncWhitelist = make(map[string]bool)
+	grpcFuncBlacklist = map[string]bool{"CloseQueue": true}
+	defer func() {
+		remoteIPWhitelist = make(map[string]bool)
+		grpcFuncBlacklist = make(map[string]bool)
+	}()
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("192.168.1.1"), Port: 8802}})
+	_, err = grpcAuth(ctx, "/types.chain33/GetLastHeader", 0)
+	assert.Equal(t, types.ErrAPIKeyRequired, err)
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(apikey.Header, "key1"))
+	_, err = grpcAuth(ctx, "/types.chain33/CloseQueue", 0)
+	assert.NotNil(t, err)
+	c, err := grpcAuth(ctx, "/types.chain33/SubEvent", 0)
+	assert.Nil(t, err)
+	assert.Equal(t, cli, c)
+}
@@ -38,6 +38,44 @@ type QueryParm struct {
 	Hash string `json:"hash"`
 }
 
+// ReqStateProof 获取状态证明的参数，stateHash和keys使用hex编码，stateHash为空时使用height对应区块的stateHash
+type ReqStateProof struct {
+	StateHash string   `json:"stateHash"`
+	Height    int64    `json:"height"`
+	Keys      []string `json:"keys"`
+}
+
+// ReqSimulateTx 模拟执行交易的参数，tx为hex编码的交易或交易组，未签名的交易需要指定pubKey，stateHash为空时使用最新区块的状态
+type ReqSimulateTx struct {
+	Tx        string `json:"tx"`
+	StateHash string `json:"stateHash"`
+	SkipSign  bool   `json:"skipSign"`
+	PubKey    string `json:"pubKey"`
+	SignType  int32  `json:"signType"`
+}
+
+// StateChange 状态数据修改前后的值
+type StateChange struct {
+	Key    string `json:"key"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// SimulateTxResult 单笔交易的模拟执行结果
+type SimulateTxResult struct {
+	Hash    string             `json:"hash"`
+	Receipt *ReceiptDataResult `json:"receipt"`
+	Changes []*StateChange     `json:"changes"`
+	Fee     int64              `json:"fee"`
+}
+
+// ReplySimulateTx 模拟执行交易的结果
+type ReplySimulateTx struct {
+	StateHash string              `json:"stateHash"`
+	Height    int64               `json:"height"`
+	Results   []*SimulateTxResult `json:"results"`
+}
+
 // BlockParam block parameter
 type BlockParam struct {
 	Start    int64 `json:"start"`
@@ -57,6 +95,7 @@ type Header struct {
 	Hash       string     `json:"hash"`
 	Difficulty uint32     `json:"difficulty"`
 	Signature  *Signature `json:"signature,omitempty"`
+	BaseFee    int64      `json:"baseFee,omitempty"`
 }
 
 // Signature parameter
@@ -127,6 +166,7 @@ type Block struct {
 	MainHash   string         ` json:"mainHash,omitempty"`
 	MainHeight int64          `json:"mainHeight,omitempty"`
 	Signature  *Signature     `json:"signature,omitempty"`
+	BaseFee    int64          `json:"baseFee,omitempty"`
 }
 
 // BlockDetail  block detail
@@ -228,6 +268,7 @@ type Peer struct {
 	RunningTime    string  `json:"runningTime,omitempty"`
 	FullNode       bool    `json:"fullNode,omitempty"`
 	Blocked        bool    `json:"blocked,omitempty"`
+	Score          int32   `json:"score,omitempty"`
 }
 
 // WalletAccounts Wallet Module
@@ -343,6 +384,21 @@ type NodeNetinfo struct {
 	Ratein       string `json:"ratein"`
 	Rateout      string `json:"rateout"`
 	Ratetotal    string `json:"ratetotal"`
+	//全局和各协议带宽使用情况
+	Bandwidth         *BandwidthUsage   `json:"bandwidth,omitempty"`
+	ProtocolBandwidth []*BandwidthUsage `json:"protocolBandwidth,omitempty"`
+}
+
+// BandwidthUsage 带宽使用情况, 速率和限制单位为字节每秒, 限制为0表示不限制
+type BandwidthUsage struct {
+	Protocol    string `json:"protocol,omitempty"`
+	Priority    int32  `json:"priority"`
+	RateIn      int64  `json:"rateIn"`
+	RateOut     int64  `json:"rateOut"`
+	LimitIn     int64  `json:"limitIn"`
+	LimitOut    int64  `json:"limitOut"`
+	ThrottleIn  int64  `json:"throttleIn"`
+	ThrottleOut int64  `json:"throttleOut"`
 }
 
 // ReplyCacheTxList reply cache tx list
@@ -481,3 +537,22 @@ type ChainConfigInfo struct {
 	IsPara           bool   `json:"isPara,omitempty"`
 	DefaultAddressID int32  `json:"defaultAddressID"`
 }
+
+// SubscribeParm websocket订阅参数，type: 0区块，1区块头，2交易回执，3交易执行结果，4evm事件，5mempool新加入的交易
+type SubscribeParm struct {
+	Name          string          `json:"name"`
+	Type          int32           `json:"type"`
+	Contract      map[string]bool `json:"contract,omitempty"`
+	ContractAddrs []string        `json:"contractAddrs,omitempty"`
+	Execers       []string        `json:"execers,omitempty"`
+	ToAddrs       []string        `json:"toAddrs,omitempty"`
+	LastSequence  int64           `json:"lastSequence,omitempty"`
+	FromBlock     int64           `json:"fromBlock,omitempty"`
+}
+
+// SubscribeResult websocket订阅推送的消息
+type SubscribeResult struct {
+	Subscription string          `json:"subscription"`
+	Type         int32           `json:"type"`
+	Result       json.RawMessage `json:"result"`
+}
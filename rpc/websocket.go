@@ -0,0 +1,368 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/rpc/apikey"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+	"golang.org/x/net/websocket"
+)
+
+//websocket rpc：通过websocket连接调用Chain33.*方法，同时支持订阅推送。
+//区块、区块头、交易回执、交易执行结果以及evm事件的订阅复用blockchain的push机制，订阅名称在blockchain中持久化，
+//断线后用相同的名称重新订阅会从上次推送的序列号继续推送，新的订阅可以通过lastSequence指定从某个序列号之后开始推送。
+//mempool交易的订阅只推送订阅之后新加入mempool的交易
+
+const (
+	wsSubscribeMethod   = "Chain33.Subscribe"
+	wsUnsubscribeMethod = "Chain33.Unsubscribe"
+	wsNotifyMethod      = "Chain33.Subscription"
+	//每个订阅缓存的推送消息个数
+	wsSubChanSize = 128
+)
+
+//PushMempoolTx mempool新加入交易的订阅类型，只在rpc模块内部处理，不需要blockchain推送
+const PushMempoolTx PushType = 5
+
+// WSServer websocket rpc server
+type WSServer struct {
+	japi *JSONRPCServer
+	grpc *Grpc
+	l    net.Listener
+}
+
+// NewWSServer new websocket rpc server，方法调用由jsonrpc server处理，订阅复用grpc的订阅缓存
+func NewWSServer(japi *JSONRPCServer, gapi *Grpcserver) *WSServer {
+	return &WSServer{japi: japi, grpc: gapi.grpc}
+}
+
+// Listen websocket server listen
+func (ws *WSServer) Listen() (int, error) {
+	listener, err := net.Listen("tcp", rpcCfg.JrpcWsBindAddr)
+	if err != nil {
+		return 0, err
+	}
+	ws.l = listener
+	server := websocket.Server{Handler: ws.serveConn}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		log.Debug("WSServer", "RemoteAddr", r.RemoteAddr)
+		ip, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			http.Error(w, fmt.Sprintf(`The %s Address is not authorized!`, ip), http.StatusForbidden)
+			return
+		}
+		//携带api key的连接同样需要通过ip白名单和basic auth检查
+		_, err = apiKeys.Client(r.Header.Get(apikey.Header), net.ParseIP(ip).IsLoopback())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		if !checkIPWhitelist(ip) {
+			http.Error(w, fmt.Sprintf(`The %s Address is not authorized!`, ip), http.StatusForbidden)
+			return
+		}
+		if !checkBasicAuth(r) {
+			http.Error(w, `Unauthozied`, http.StatusUnauthorized)
+			return
+		}
+		server.ServeHTTP(w, r)
+	})
+	if !rpcCfg.EnableTLS {
+		go http.Serve(listener, handler)
+	} else {
+		go http.ServeTLS(listener, handler, rpcCfg.CertFile, rpcCfg.KeyFile)
+	}
+	return listener.Addr().(*net.TCPAddr).Port, nil
+}
+
+// Close websocket server close
+func (ws *WSServer) Close() {
+	if ws.l != nil {
+		err := ws.l.Close()
+		if err != nil {
+			log.Error("WSServer close", "err", err)
+		}
+	}
+}
+
+type wsRequest struct {
+	Method string             `json:"method"`
+	Params [1]json.RawMessage `json:"params"`
+	ID     uint64             `json:"id"`
+}
+
+type wsNotification struct {
+	Method string                    `json:"method"`
+	Params *rpctypes.SubscribeResult `json:"params"`
+}
+
+//wsConn websocket连接，连接断开时取消该连接上的所有订阅
+type wsConn struct {
+	ws         *WSServer
+	conn       *websocket.Conn
+	isLoopback bool
+	cli        *apikey.Client
+	wlock      sync.Mutex
+	lock       sync.Mutex
+	subs       map[string]chan struct{} //订阅名称 --> 取消订阅
+}
+
+func (ws *WSServer) serveConn(conn *websocket.Conn) {
+	ip, _, _ := net.SplitHostPort(conn.Request().RemoteAddr)
+	c := &wsConn{ws: ws, conn: conn, isLoopback: net.ParseIP(ip).IsLoopback(), subs: make(map[string]chan struct{})}
+	c.cli, _ = apiKeys.Client(conn.Request().Header.Get(apikey.Header), c.isLoopback)
+	defer c.close()
+	for {
+		var data []byte
+		err := websocket.Message.Receive(conn, &data)
+		if err != nil {
+			log.Debug("WSServer Receive", "err", err)
+			return
+		}
+		resp := c.handle(data)
+		if resp == nil {
+			continue
+		}
+		err = c.write(resp)
+		if err != nil {
+			log.Debug("WSServer Send", "err", err)
+			return
+		}
+	}
+}
+
+func (c *wsConn) write(data []byte) error {
+	c.wlock.Lock()
+	defer c.wlock.Unlock()
+	return websocket.Message.Send(c.conn, string(data))
+}
+
+func (c *wsConn) close() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for name, quit := range c.subs {
+		close(quit)
+		delete(c.subs, name)
+	}
+	c.conn.Close()
+}
+
+//handle 处理单个请求或者json rpc 2.0批量请求
+func (c *wsConn) handle(data []byte) json.RawMessage {
+	if !isBatchRequest(data) {
+		return c.handleRequest(data)
+	}
+	var reqs []json.RawMessage
+	err := json.Unmarshal(data, &reqs)
+	if err != nil {
+		return errorResponse(0, fmt.Sprintf(`invalid json request err:%s`, err.Error()))
+	}
+	maxBatchSize := jrpcMaxBatchSize()
+	if len(reqs) == 0 || len(reqs) > maxBatchSize {
+		return errorResponse(0, fmt.Sprintf(`invalid batch request size:%d, max:%d`, len(reqs), maxBatchSize))
+	}
+	resps := make([]json.RawMessage, len(reqs))
+	for i, req := range reqs {
+		resps[i] = c.handleRequest(req)
+	}
+	resps = batchResponses(reqs, resps)
+	if len(resps) == 0 {
+		return nil
+	}
+	resp, err := json.Marshal(resps)
+	if err != nil {
+		return errorResponse(0, err.Error())
+	}
+	return resp
+}
+
+//handleRequest 订阅相关的方法在连接上处理，其他方法由jsonrpc server处理
+func (c *wsConn) handleRequest(data []byte) json.RawMessage {
+	var req wsRequest
+	err := json.Unmarshal(data, &req)
+	if err != nil {
+		return errorResponse(0, fmt.Sprintf(`invalid json request err:%s`, err.Error()))
+	}
+	if req.Method != wsSubscribeMethod && req.Method != wsUnsubscribeMethod {
+		return c.ws.japi.serveBatchRequest(data, c.isLoopback, c.cli)
+	}
+	err = checkJrpcFunc(c.cli, req.Method[len("Chain33."):], len(data), c.isLoopback)
+	if err != nil {
+		return errorResponse(req.ID, err.Error())
+	}
+	var result interface{}
+	if req.Method == wsSubscribeMethod {
+		var param rpctypes.SubscribeParm
+		err = json.Unmarshal(req.Params[0], &param)
+		if err == nil {
+			err = c.subscribe(&param)
+			result = param.Name
+		}
+	} else {
+		var param types.ReqString
+		err = json.Unmarshal(req.Params[0], &param)
+		if err == nil {
+			err = c.unsubscribe(param.GetData())
+			result = true
+		}
+	}
+	if err != nil {
+		return errorResponse(req.ID, err.Error())
+	}
+	resp, err := json.Marshal(&serverResponse{ID: req.ID, Result: result})
+	if err != nil {
+		return errorResponse(req.ID, err.Error())
+	}
+	return resp
+}
+
+func (c *wsConn) subscribe(param *rpctypes.SubscribeParm) error {
+	if param.Name == "" || param.Type < 0 || PushType(param.Type) > PushMempoolTx {
+		return types.ErrInvalidParam
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if _, ok := c.subs[param.Name]; ok {
+		return types.ErrSubscriberExist
+	}
+	quit := make(chan struct{})
+	if PushType(param.Type) == PushMempoolTx {
+		sub := &mempoolSub{name: param.Name, filter: &types.PushFilter{Execers: param.Execers, ToAddrs: param.ToAddrs},
+			txChan: make(chan *types.Transaction, wsSubChanSize)}
+		c.ws.grpc.addMempoolSub(sub)
+		go c.sendMempoolTxs(param, sub, quit)
+	} else {
+		dataChan := make(chan *queue.Message, wsSubChanSize)
+		grpc := c.ws.grpc
+		//推送数据发送成功之后由订阅者回复blockchain，同一个订阅名称只能有一个websocket订阅者
+		if grpc.hashTopic(param.Name) != nil {
+			return types.ErrSubscriberExist
+		}
+		subReq, err := c.pushSubscribeReq(param)
+		if err != nil {
+			return err
+		}
+		err = grpc.addPushSubscribe(subReq, dataChan, true)
+		if err != nil {
+			return err
+		}
+		go c.sendPushData(param, dataChan, quit)
+	}
+	c.subs[param.Name] = quit
+	return nil
+}
+
+func (c *wsConn) unsubscribe(name string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	quit, ok := c.subs[name]
+	if !ok {
+		return types.ErrPushNotSubscribed
+	}
+	close(quit)
+	delete(c.subs, name)
+	return nil
+}
+
+//pushSubscribeReq 向blockchain注册的推送请求，通过queue推送给rpc模块，lastSequence和fromBlock都指定时以lastSequence为准
+func (c *wsConn) pushSubscribeReq(param *rpctypes.SubscribeParm) (*types.PushSubscribeReq, error) {
+	subReq := &types.PushSubscribeReq{Encode: "grpc", Name: param.Name, Type: param.Type, Contract: param.Contract}
+	if len(param.ContractAddrs) > 0 || len(param.Execers) > 0 || len(param.ToAddrs) > 0 {
+		subReq.Filter = &types.PushFilter{ContractAddrs: param.ContractAddrs, Execers: param.Execers, ToAddrs: param.ToAddrs}
+	}
+	cli := c.ws.grpc.cli
+	if param.LastSequence > 0 {
+		seq, err := cli.GetBlockBySeq(&types.Int64{Data: param.LastSequence})
+		if err != nil {
+			return nil, err
+		}
+		subReq.LastSequence = param.LastSequence
+		subReq.LastHeight = seq.GetDetail().GetBlock().GetHeight()
+		subReq.LastBlockHash = common.HashHex(seq.GetSeq().GetHash())
+	} else if param.FromBlock > 0 {
+		hash, err := cli.GetBlockHash(&types.ReqInt{Height: param.FromBlock})
+		if err != nil {
+			return nil, err
+		}
+		seq, err := cli.GetSequenceByHash(&types.ReqHash{Hash: hash.GetHash()})
+		if err != nil {
+			return nil, err
+		}
+		subReq.LastSequence = seq.GetData()
+		subReq.LastHeight = param.FromBlock
+		subReq.LastBlockHash = common.HashHex(hash.GetHash())
+	}
+	return subReq, nil
+}
+
+//sendPushData blockchain推送的数据发送给客户端成功之后再回复blockchain，发送失败时blockchain会从该序列号重新推送，
+//取消订阅或者发送失败时删除订阅的channel
+func (c *wsConn) sendPushData(param *rpctypes.SubscribeParm, dataChan chan *queue.Message, quit chan struct{}) {
+	defer c.ws.grpc.delSubInfo(param.Name, dataChan)
+	for {
+		select {
+		case <-quit:
+			return
+		case msg := <-dataChan:
+			pushData, ok := msg.GetData().(*types.PushData)
+			if !ok {
+				log.Error("WSServer sendPushData", "msg", msg)
+				msg.ReplyErr("WSServer sendPushData", types.ErrInvalidParam)
+				continue
+			}
+			err := c.notify(param, pushData)
+			msg.ReplyErr("WSServer sendPushData", err)
+			if err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (c *wsConn) sendMempoolTxs(param *rpctypes.SubscribeParm, sub *mempoolSub, quit chan struct{}) {
+	defer c.ws.grpc.delMempoolSub(sub)
+	for {
+		select {
+		case <-quit:
+			return
+		case tx := <-sub.txChan:
+			if c.notify(param, tx) != nil {
+				return
+			}
+		}
+	}
+}
+
+func (c *wsConn) notify(param *rpctypes.SubscribeParm, data types.Message) error {
+	result, err := types.PBToJSON(data)
+	if err != nil {
+		log.Error("WSServer notify", "name", param.Name, "err", err)
+		return err
+	}
+	resp, err := json.Marshal(&wsNotification{
+		Method: wsNotifyMethod,
+		Params: &rpctypes.SubscribeResult{Subscription: param.Name, Type: param.Type, Result: result},
+	})
+	if err != nil {
+		return err
+	}
+	if c.cli != nil {
+		c.cli.AddBytes(len(resp))
+	}
+	err = c.write(resp)
+	if err != nil {
+		log.Debug("WSServer notify", "name", param.Name, "err", err)
+	}
+	return err
+}
@@ -0,0 +1,192 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+)
+
+func TestJSONRPCWebsocket(t *testing.T) {
+	rpcCfg = new(types.RPC)
+	rpcCfg.JrpcWsBindAddr = "127.0.0.1:0"
+	rpcCfg.Whitelist = []string{"127.0.0.1"}
+	rpcCfg.JrpcFuncWhitelist = []string{"*"}
+	InitCfg(rpcCfg)
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	q := queue.New("ws")
+	q.SetConfig(cfg)
+	defer q.Close()
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	api.On("Version").Return(&types.VersionInfo{Chain33: "6.0.2"}, nil)
+	api.On("AddPushSubscribe", mock.Anything).Return(&types.ReplySubscribePush{IsOk: true}, nil).Once()
+
+	c := q.Client()
+	japi := NewJSONRPCServer(c, api)
+	gapi := NewGRpcServer(c, api)
+	r := &RPC{gapi: gapi, japi: japi, wsapi: NewWSServer(japi, gapi), cli: c, api: api}
+	go r.handleSysEvent()
+	port, err := r.wsapi.Listen()
+	require.Nil(t, err)
+	addr := fmt.Sprintf("127.0.0.1:%d", port)
+	defer r.wsapi.Close()
+
+	//模拟mempool接收推送开关
+	mempool := q.Client()
+	mempool.Sub("mempool")
+	pushSwitch := make(chan int32, 2)
+	go func() {
+		for msg := range mempool.Recv() {
+			pushSwitch <- msg.GetData().(*types.Int32).GetData()
+		}
+	}()
+
+	ws, err := websocket.Dial("ws://"+addr, "", "http://"+addr)
+	require.Nil(t, err)
+	defer ws.Close()
+	receive := func(v interface{}) {
+		var data []byte
+		require.Nil(t, ws.SetReadDeadline(time.Now().Add(time.Second*5)))
+		require.Nil(t, websocket.Message.Receive(ws, &data))
+		require.Nil(t, json.Unmarshal(data, v))
+	}
+	call := func(req string) map[string]interface{} {
+		require.Nil(t, websocket.Message.Send(ws, req))
+		var resp map[string]interface{}
+		receive(&resp)
+		return resp
+	}
+
+	//普通的方法调用
+	resp := call(`{"id":1,"method":"Chain33.Version","params":[]}`)
+	require.Equal(t, "6.0.2", resp["result"].(map[string]interface{})["chain33"])
+
+	//批量请求全部为通知时不返回结果，下一个收到的是普通调用的结果
+	require.Nil(t, websocket.Message.Send(ws, `[{"jsonrpc":"2.0","method":"Chain33.Version","params":[]}]`))
+	resp = call(`{"id":8,"method":"Chain33.Version","params":[]}`)
+	require.Equal(t, float64(8), resp["id"])
+
+	//订阅区块头，blockchain推送的数据转发给客户端
+	resp = call(`{"id":2,"method":"Chain33.Subscribe","params":[{"name":"header","type":1}]}`)
+	require.Nil(t, resp["error"])
+	require.Equal(t, "header", resp["result"])
+	resp = call(`{"id":3,"method":"Chain33.Subscribe","params":[{"name":"header","type":1}]}`)
+	require.Equal(t, types.ErrSubscriberExist.Error(), resp["error"])
+	chain := q.Client()
+	pushData := &types.PushData{Name: "header", Value: &types.PushData_HeaderSeqs{HeaderSeqs: &types.HeaderSeqs{
+		Seqs: []*types.HeaderSeq{{Num: 5, Header: &types.Header{Height: 5}}}}}}
+	msg := chain.NewMessage("rpc", types.EventPushBlockHeader, pushData)
+	require.Nil(t, chain.Send(msg, true))
+	reply, err := chain.WaitTimeout(msg, time.Second*5)
+	require.Nil(t, err)
+	require.True(t, reply.GetData().(*types.Reply).GetIsOk())
+	var notify wsNotification
+	receive(&notify)
+	require.Equal(t, wsNotifyMethod, notify.Method)
+	require.Equal(t, "header", notify.Params.Subscription)
+	var headers types.PushData
+	require.Nil(t, types.JSONToPB(notify.Params.Result, &headers))
+	require.Equal(t, int64(5), headers.GetHeaderSeqs().GetSeqs()[0].GetNum())
+
+	//同名的订阅已经存在时不能在其他连接上订阅
+	other, err := websocket.Dial("ws://"+addr, "", "http://"+addr)
+	require.Nil(t, err)
+	require.Nil(t, websocket.Message.Send(other, `{"id":1,"method":"Chain33.Subscribe","params":[{"name":"header","type":1}]}`))
+	var data []byte
+	require.Nil(t, other.SetReadDeadline(time.Now().Add(time.Second*5)))
+	require.Nil(t, websocket.Message.Receive(other, &data))
+	require.Contains(t, string(data), types.ErrSubscriberExist.Error())
+	other.Close()
+
+	//批量请求订阅mempool中执行器为coins的交易，第一个订阅者加入时开启mempool的推送
+	require.Nil(t, websocket.Message.Send(ws, `[{"id":4,"method":"Chain33.Subscribe","params":[{"name":"mempool","type":5,"execers":["coins"]}]},{"id":5,"method":"Chain33.Subscribe","params":[{"name":"invalid","type":6}]}]`))
+	var results []map[string]interface{}
+	receive(&results)
+	require.Equal(t, 2, len(results))
+	require.Equal(t, "mempool", results[0]["result"])
+	require.Equal(t, types.ErrInvalidParam.Error(), results[1]["error"])
+	require.Equal(t, int32(1), <-pushSwitch)
+	for _, execer := range []string{"none", "coins"} {
+		tx := &types.Transaction{Execer: []byte(execer), Nonce: 1}
+		require.Nil(t, chain.Send(chain.NewMessage("rpc", types.EventPushMempoolTx, tx), false))
+	}
+	receive(&notify)
+	require.Equal(t, "mempool", notify.Params.Subscription)
+	require.Equal(t, int32(PushMempoolTx), notify.Params.Type)
+	var tx types.Transaction
+	require.Nil(t, types.JSONToPB(notify.Params.Result, &tx))
+	require.Equal(t, "coins", string(tx.Execer))
+
+	//取消订阅，最后一个订阅者退出时关闭mempool的推送
+	resp = call(`{"id":6,"method":"Chain33.Unsubscribe","params":[{"data":"mempool"}]}`)
+	require.Equal(t, true, resp["result"])
+	require.Equal(t, int32(0), <-pushSwitch)
+	resp = call(`{"id":7,"method":"Chain33.Unsubscribe","params":[{"data":"mempool"}]}`)
+	require.Equal(t, types.ErrPushNotSubscribed.Error(), resp["error"])
+
+	//连接断开后删除订阅，blockchain停止推送
+	ws.Close()
+	require.Eventually(t, func() bool { return gapi.grpc.hashTopic("header") == nil }, time.Second*5, time.Millisecond*10)
+	msg = chain.NewMessage("rpc", types.EventPushBlockHeader, pushData)
+	require.Nil(t, chain.Send(msg, true))
+	reply, err = chain.WaitTimeout(msg, time.Second*5)
+	require.Nil(t, err)
+	require.False(t, reply.GetData().(*types.Reply).GetIsOk())
+}
+
+func TestWebsocketPushAck(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	q := queue.New("ws")
+	q.SetConfig(cfg)
+	defer q.Close()
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	c := q.Client()
+	gapi := NewGRpcServer(c, api)
+	r := &RPC{gapi: gapi, cli: c, api: api}
+	go r.handleSysEvent()
+
+	//由订阅者回复的channel，数据放入channel时不回复blockchain
+	dataChan := make(chan *queue.Message, 1)
+	gapi.grpc.addSubInfo(&subInfo{topic: "header", subChan: make(map[chan *queue.Message]string)})
+	gapi.grpc.addAckSubChan("header", dataChan)
+	chain := q.Client()
+	pushData := &types.PushData{Name: "header", Value: &types.PushData_HeaderSeqs{HeaderSeqs: &types.HeaderSeqs{}}}
+	msg := chain.NewMessage("rpc", types.EventPushBlockHeader, pushData)
+	require.Nil(t, chain.Send(msg, true))
+	_, err := chain.WaitTimeout(msg, time.Millisecond*200)
+	require.Equal(t, queue.ErrQueueTimeout, err)
+
+	//发送给客户端成功之后回复
+	recv := <-dataChan
+	recv.ReplyErr("test", nil)
+	reply, err := chain.WaitTimeout(msg, time.Second*5)
+	require.Nil(t, err)
+	require.True(t, reply.GetData().(*types.Reply).GetIsOk())
+
+	//同一个主题下的其他订阅者不重复回复
+	grpcChan := make(chan *queue.Message, 1)
+	gapi.grpc.addSubChan("header", grpcChan)
+	msg = chain.NewMessage("rpc", types.EventPushBlockHeader, pushData)
+	require.Nil(t, chain.Send(msg, true))
+	<-grpcChan
+	recv = <-dataChan
+	recv.ReplyErr("test", types.ErrInvalidParam)
+	reply, err = chain.WaitTimeout(msg, time.Second*5)
+	require.Nil(t, err)
+	require.False(t, reply.GetData().(*types.Reply).GetIsOk())
+	require.Nil(t, gapi.grpc.delSubInfo("header", dataChan))
+	require.Equal(t, 0, len(gapi.grpc.hashTopic("header").ackChan))
+}
@@ -26,6 +26,7 @@ func init() {
 func initEnv() (queue.Queue, queue.Module) {
 	var q = queue.New("channel")
 	cfg := types.NewChain33Config(types.ReadFile("../cmd/chain33/chain33.test.toml"))
+	q.SetConfig(cfg)
 	s := New(cfg)
 	s.SetQueueClient(q.Client())
 	return q, s
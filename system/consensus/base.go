@@ -435,8 +435,28 @@ func buildHashList(deltx []*types.Transaction) *types.TxHashList {
 	return list
 }
 
+//setBaseFee 根据父区块设置区块的基础手续费，blockchain对所有共识的区块都会检查基础手续费
+func (bc *BaseClient) setBaseFee(block *types.Block) error {
+	if block.Height <= 0 {
+		return nil
+	}
+	cfg := bc.client.GetConfig()
+	parent := bc.GetCurrentBlock()
+	if parent == nil || parent.Height != block.Height-1 || !bytes.Equal(parent.Hash(cfg), block.ParentHash) {
+		var err error
+		if parent, err = bc.RequestBlock(block.Height - 1); err != nil {
+			return err
+		}
+	}
+	block.BaseFee = types.CalcBaseFeeByBlock(cfg, parent)
+	return nil
+}
+
 //WriteBlock 向blockchain写区块
 func (bc *BaseClient) WriteBlock(prev []byte, block *types.Block) error {
+	if err := bc.setBaseFee(block); err != nil {
+		return err
+	}
 	//保存block的原始信息用于删除mempool中的错误交易
 	rawtxs := make([]*types.Transaction, len(block.Txs))
 	copy(rawtxs, block.Txs)
@@ -481,6 +501,10 @@ func (bc *BaseClient) PreExecBlock(block *types.Block, errReturn bool) *types.Bl
 		log.Error("PreExecBlock RequestBlock fail", "err", err)
 		return nil
 	}
+	//本节点打包的区块在执行前设置基础手续费，其他节点的区块由blockchain检查
+	if !errReturn {
+		block.BaseFee = types.CalcBaseFeeByBlock(bc.client.GetConfig(), lastBlock)
+	}
 	blockdetail, deltx, err := util.PreExecBlock(bc.client, lastBlock.StateHash, block, errReturn, false, true)
 	if err != nil {
 		log.Error("util.PreExecBlock fail", "err", err)
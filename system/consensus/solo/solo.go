@@ -8,6 +8,7 @@ package solo
 import (
 	"time"
 
+	"github.com/33cn/chain33/blockchain"
 	log "github.com/33cn/chain33/common/log/log15"
 	"github.com/33cn/chain33/queue"
 	drivers "github.com/33cn/chain33/system/consensus"
@@ -27,6 +28,21 @@ type Client struct {
 func init() {
 	drivers.Reg("solo", New)
 	drivers.QueryData.Register("solo", &Client{})
+	blockchain.RegisterLightHeaderChecker("solo", checkLightHeader)
+}
+
+//checkLightHeader 轻节点校验solo区块头的固定难度以及基础手续费
+func checkLightHeader(cfg *types.Chain33Config, parent, header *types.Header) error {
+	if parent == nil {
+		return nil
+	}
+	if header.Difficulty != cfg.GetP(0).PowLimitBits {
+		return types.ErrBlockHeaderDifficulty
+	}
+	if header.BaseFee != types.CalcBaseFee(cfg, parent) {
+		return types.ErrBaseFee
+	}
+	return nil
 }
 
 type subConfig struct {
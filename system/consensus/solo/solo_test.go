@@ -479,3 +479,29 @@ func BenchmarkBase58(b *testing.B) {
 		}
 	})
 }
+
+func TestCheckLightHeader(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	cfg := mock33.GetClient().GetConfig()
+	txs := util.GenNoneTxs(cfg, mock33.GetGenesisKey(), 10)
+	for i := 0; i < len(txs); i++ {
+		mock33.GetAPI().SendTx(txs[i])
+	}
+	mock33.WaitHeight(1)
+	headers, err := mock33.GetAPI().GetHeaders(&types.ReqBlocks{Start: 0, End: 1})
+	assert.Nil(t, err)
+	genesis, header := headers.Items[0], headers.Items[1]
+	//基础手续费在共识公共的写区块流程中设置
+	assert.Equal(t, types.CalcBaseFee(cfg, genesis), header.BaseFee)
+	assert.NotEqual(t, int64(0), header.BaseFee)
+	assert.Nil(t, checkLightHeader(cfg, nil, genesis))
+	assert.Nil(t, checkLightHeader(cfg, genesis, header))
+
+	fake := types.Clone(header).(*types.Header)
+	fake.Difficulty++
+	assert.Equal(t, types.ErrBlockHeaderDifficulty, checkLightHeader(cfg, genesis, fake))
+	fake = types.Clone(header).(*types.Header)
+	fake.BaseFee++
+	assert.Equal(t, types.ErrBaseFee, checkLightHeader(cfg, genesis, fake))
+}
@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/33cn/chain33/blockchain"
+	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/rpc/jsonclient"
 	rpctypes "github.com/33cn/chain33/rpc/types"
 	commandtypes "github.com/33cn/chain33/system/dapp/commands/types"
@@ -39,6 +40,11 @@ func BlockCmd() *cobra.Command {
 		AddPushSubscribeCmd(),
 		ListPushesCmd(),
 		GetPushSeqLastNumCmd(),
+		RemovePushSubscribeCmd(),
+		PausePushSubscribeCmd(),
+		GetPushStatsCmd(),
+		ReadPushOutboxCmd(),
+		GetStateProofCmd(),
 	)
 
 	return cmd
@@ -316,8 +322,7 @@ func addPushSubscribeFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("name", "n", "", "call back name")
 	cmd.MarkFlagRequired("name")
 
-	cmd.Flags().StringP("url", "u", "", "call back URL")
-	cmd.MarkFlagRequired("url")
+	cmd.Flags().StringP("url", "u", "", "call back URL, not required when sink is outbox")
 
 	cmd.Flags().StringP("encode", "e", "", "data encode type,json or proto buff")
 	cmd.MarkFlagRequired("encode")
@@ -327,6 +332,20 @@ func addPushSubscribeFlags(cmd *cobra.Command) {
 	cmd.Flags().Int64P("lastSequence", "", 0, "lastSequence")
 	cmd.Flags().Int64P("lastHeight", "", 0, "lastHeight")
 	cmd.Flags().StringP("lastBlockHash", "", "", "lastBlockHash")
+
+	cmd.Flags().StringP("secret", "", "", "secret for signing pushed data and removing or pausing the push")
+	cmd.Flags().StringP("execers", "", "", "only push txs with these execers, separated by ','")
+	cmd.Flags().StringP("contract_addrs", "", "", "only push evm txs calling these contracts, separated by ','")
+	cmd.Flags().StringP("to_addrs", "", "", "only push txs sent to these addresses, separated by ','")
+	cmd.Flags().StringP("sink", "", "", "push sink, set outbox to write pushed data into the node's local outbox")
+}
+
+func splitFilterFlag(cmd *cobra.Command, name string) []string {
+	value, _ := cmd.Flags().GetString(name)
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
 }
 
 func addPushSubscribe(cmd *cobra.Command, args []string) {
@@ -355,6 +374,23 @@ func addPushSubscribe(cmd *cobra.Command, args []string) {
 	if isHeader {
 		pushType = blockchain.PushBlockHeader
 	}
+	secret, _ := cmd.Flags().GetString("secret")
+	sink, _ := cmd.Flags().GetString("sink")
+	if url == "" && sink == "" {
+		fmt.Println("url is required")
+		return
+	}
+	var filter *types.PushFilter
+	execers := splitFilterFlag(cmd, "execers")
+	contractAddrs := splitFilterFlag(cmd, "contract_addrs")
+	toAddrs := splitFilterFlag(cmd, "to_addrs")
+	if len(execers) > 0 || len(contractAddrs) > 0 || len(toAddrs) > 0 {
+		filter = &types.PushFilter{
+			Execers:       execers,
+			ContractAddrs: contractAddrs,
+			ToAddrs:       toAddrs,
+		}
+	}
 
 	params := types.PushSubscribeReq{
 		Name:          name,
@@ -364,6 +400,9 @@ func addPushSubscribe(cmd *cobra.Command, args []string) {
 		LastHeight:    lastHeight,
 		LastBlockHash: lastBlockHash,
 		Type:          int32(pushType),
+		Filter:        filter,
+		Secret:        secret,
+		Sink:          sink,
 	}
 
 	var res types.ReplySubscribePush
@@ -417,3 +456,171 @@ func getPushSeqLastNumCmd(cmd *cobra.Command, args []string) {
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.GetPushSeqLastNum", &params, &res)
 	ctx.Run()
 }
+
+// GetPushStatsCmd get push service stats
+func GetPushStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "push_stats",
+		Short: "show push stats, including lag of each subscriber",
+		Run:   getPushStats,
+	}
+	cmd.Flags().StringP("name", "n", "", "push name, show all pushes if empty")
+	return cmd
+}
+
+func getPushStats(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	name, _ := cmd.Flags().GetString("name")
+
+	params := types.ReqString{
+		Data: name,
+	}
+
+	var res types.PushStats
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.GetPushStats", &params, &res)
+	ctx.Run()
+}
+
+// ReadPushOutboxCmd read push records from outbox
+func ReadPushOutboxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "read_outbox",
+		Short: "read push records from outbox",
+		Run:   readPushOutbox,
+	}
+	cmd.Flags().StringP("name", "n", "", "push name")
+	cmd.MarkFlagRequired("name")
+	cmd.Flags().StringP("secret", "s", "", "secret of the push")
+	cmd.Flags().Int64P("offset", "o", 0, "offset to read from")
+	cmd.Flags().Int32P("count", "c", 10, "max count of records")
+	return cmd
+}
+
+func readPushOutbox(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	name, _ := cmd.Flags().GetString("name")
+	secret, _ := cmd.Flags().GetString("secret")
+	offset, _ := cmd.Flags().GetInt64("offset")
+	count, _ := cmd.Flags().GetInt32("count")
+
+	params := types.ReqPushOutbox{
+		Name:   name,
+		Secret: secret,
+		Offset: offset,
+		Count:  count,
+	}
+
+	var res types.PushOutboxRecords
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.ReadPushOutbox", &params, &res)
+	ctx.Run()
+}
+
+// RemovePushSubscribeCmd remove push subscribe
+func RemovePushSubscribeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove_push",
+		Short: "remove push subscribe",
+		Run:   removePushSubscribe,
+	}
+	addPushSubscribeAuthFlags(cmd)
+	return cmd
+}
+
+// PausePushSubscribeCmd pause push subscribe
+func PausePushSubscribeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause_push",
+		Short: "pause push subscribe, use add_push to resume",
+		Run:   pausePushSubscribe,
+	}
+	addPushSubscribeAuthFlags(cmd)
+	return cmd
+}
+
+func addPushSubscribeAuthFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("name", "n", "", "call back name")
+	cmd.MarkFlagRequired("name")
+
+	cmd.Flags().StringP("secret", "", "", "secret set when adding the push")
+	cmd.Flags().BoolP("operator", "", false, "operate without secret, only allowed from loopback or whitelisted rpc")
+}
+
+func removePushSubscribe(cmd *cobra.Command, args []string) {
+	if operator, _ := cmd.Flags().GetBool("operator"); operator {
+		operatePushSubscribe(cmd, "Chain33.OperatorRemovePushSubscribe")
+		return
+	}
+	operatePushSubscribe(cmd, "Chain33.RemovePushSubscribe")
+}
+
+func pausePushSubscribe(cmd *cobra.Command, args []string) {
+	if operator, _ := cmd.Flags().GetBool("operator"); operator {
+		operatePushSubscribe(cmd, "Chain33.OperatorPausePushSubscribe")
+		return
+	}
+	operatePushSubscribe(cmd, "Chain33.PausePushSubscribe")
+}
+
+func operatePushSubscribe(cmd *cobra.Command, method string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	name, _ := cmd.Flags().GetString("name")
+	secret, _ := cmd.Flags().GetString("secret")
+
+	params := types.ReqPushSubscribeAuth{
+		Name:   name,
+		Secret: secret,
+	}
+
+	var res types.ReplySubscribePush
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, method, &params, &res)
+	ctx.Run()
+}
+
+// GetStateProofCmd get values and mavl proofs of state keys
+func GetStateProofCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state_proof",
+		Short: "Get state values and proofs anchored to block header's state hash",
+		Run:   getStateProof,
+	}
+	cmd.Flags().Int64P("height", "t", -1, `block height, used when state hash is empty, "-1" stands for current height`)
+	cmd.Flags().StringP("state_hash", "s", "", "state hash")
+	cmd.Flags().StringP("keys", "k", "", "state keys separated by comma, hex keys need 0x prefix")
+	cmd.MarkFlagRequired("keys")
+	return cmd
+}
+
+func getStateProof(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	height, _ := cmd.Flags().GetInt64("height")
+	stateHash, _ := cmd.Flags().GetString("state_hash")
+	keys, _ := cmd.Flags().GetString("keys")
+
+	if stateHash == "" && height < 0 {
+		rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		header, err := getLastBlock(rpc)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		height = header.Height
+	}
+	params := rpctypes.ReqStateProof{
+		StateHash: stateHash,
+		Height:    height,
+	}
+	for _, key := range strings.Split(keys, ",") {
+		if !strings.HasPrefix(key, "0x") {
+			key = common.ToHex([]byte(key))
+		}
+		params.Keys = append(params.Keys, key)
+	}
+
+	var res types.ReplyStateProof
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.GetStateProof", &params, &res)
+	ctx.Run()
+}
@@ -27,6 +27,7 @@ func NetCmd() *cobra.Command {
 		GetFatalFailureCmd(),
 		GetTimeStausCmd(),
 		NetProtocolsCmd(),
+		PeerScoresCmd(),
 		DialCmd(),
 		CloseCmd(),
 	)
@@ -267,6 +268,23 @@ func showblacklist(cmd *cobra.Command, args []string) {
 
 }
 
+//PeerScoresCmd show scores of misbehaving peers
+func PeerScoresCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scores",
+		Short: "show reputation scores of misbehaving peers",
+		Run:   peerScores,
+	}
+	return cmd
+}
+
+func peerScores(cmd *cobra.Command, args []string) {
+	var res = new([]*types.PeerScore)
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.PeerScores", nil, &res)
+	ctx.Run()
+}
+
 //DialCmd dial the specified node
 func DialCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -0,0 +1,161 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/33cn/chain33/common/trace"
+	"github.com/spf13/cobra"
+)
+
+// TraceCmd trace command
+func TraceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trace",
+		Short: "Distributed trace tools",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	cmd.AddCommand(
+		TraceTxCmd(),
+	)
+	return cmd
+}
+
+// TraceTxCmd 显示交易相关的span树
+func TraceTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tx",
+		Short: "Show span tree of a transaction from trace file",
+		Run:   traceTx,
+	}
+	addTraceTxFlags(cmd)
+	return cmd
+}
+
+func addTraceTxFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("file", "f", "logs/trace.json", "trace file written by file exporter")
+	cmd.Flags().StringP("hash", "s", "", "transaction hash")
+	cmd.MarkFlagRequired("hash")
+}
+
+func traceTx(cmd *cobra.Command, args []string) {
+	file, _ := cmd.Flags().GetString("file")
+	hash, _ := cmd.Flags().GetString("hash")
+	f, err := os.Open(file)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	defer f.Close()
+	spans, err := trace.ReadSpans(f)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	if !printTxTrace(os.Stdout, spans, hash) {
+		fmt.Fprintln(os.Stderr, "no span found for tx", hash)
+	}
+}
+
+//printTxTrace 输出包含该交易的所有追踪，交易发送以及交易在区块中执行通常属于不同的追踪
+func printTxTrace(w io.Writer, spans []*trace.SpanData, hash string) bool {
+	hash = strings.ToLower(hash)
+	if !strings.HasPrefix(hash, "0x") {
+		hash = "0x" + hash
+	}
+	traces := make(map[string][]*trace.SpanData)
+	var traceIDs []string
+	for _, span := range spans {
+		traces[span.TraceID] = append(traces[span.TraceID], span)
+	}
+	for id, list := range traces {
+		for _, span := range list {
+			if strings.ToLower(span.Attributes[trace.AttrTxHash]) == hash {
+				traceIDs = append(traceIDs, id)
+				break
+			}
+		}
+	}
+	sort.Slice(traceIDs, func(i, j int) bool {
+		return startOf(traces[traceIDs[i]]) < startOf(traces[traceIDs[j]])
+	})
+	for _, id := range traceIDs {
+		fmt.Fprintf(w, "trace %s\n", id)
+		printSpanTree(w, traces[id])
+	}
+	return len(traceIDs) > 0
+}
+
+func startOf(spans []*trace.SpanData) int64 {
+	start := spans[0].Start
+	for _, span := range spans {
+		if span.Start < start {
+			start = span.Start
+		}
+	}
+	return start
+}
+
+func printSpanTree(w io.Writer, spans []*trace.SpanData) {
+	ids := make(map[string]bool)
+	for _, span := range spans {
+		ids[span.SpanID] = true
+	}
+	children := make(map[string][]*trace.SpanData)
+	var roots []*trace.SpanData
+	for _, span := range spans {
+		//父span未输出到文件时(如其他节点的span)作为根节点显示
+		if span.ParentID == "" || !ids[span.ParentID] {
+			roots = append(roots, span)
+			continue
+		}
+		children[span.ParentID] = append(children[span.ParentID], span)
+	}
+	byStart := func(list []*trace.SpanData) {
+		sort.Slice(list, func(i, j int) bool { return list[i].Start < list[j].Start })
+	}
+	byStart(roots)
+	var walk func(span *trace.SpanData, prefix string, last bool)
+	walk = func(span *trace.SpanData, prefix string, last bool) {
+		branch, indent := "├─ ", "│  "
+		if last {
+			branch, indent = "└─ ", "   "
+		}
+		fmt.Fprintf(w, "%s%s%s %v%s\n", prefix, branch, span.Name, time.Duration(span.End-span.Start), formatSpanInfo(span))
+		list := children[span.SpanID]
+		byStart(list)
+		for i, child := range list {
+			walk(child, prefix+indent, i == len(list)-1)
+		}
+	}
+	for i, root := range roots {
+		walk(root, "", i == len(roots)-1)
+	}
+}
+
+func formatSpanInfo(span *trace.SpanData) string {
+	var info []string
+	keys := make([]string, 0, len(span.Attributes))
+	for k := range span.Attributes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		info = append(info, k+"="+span.Attributes[k])
+	}
+	if span.Error != "" {
+		info = append(info, "error="+span.Error)
+	}
+	if len(info) == 0 {
+		return ""
+	}
+	return " [" + strings.Join(info, " ") + "]"
+}
@@ -5,7 +5,6 @@
 package mempool
 
 import (
-	"bytes"
 	"encoding/hex"
 	"sort"
 	"sync"
@@ -16,8 +15,11 @@ import (
 
 	"github.com/33cn/chain33/common"
 	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/common/trace"
+	"github.com/33cn/chain33/metrics"
 	"github.com/33cn/chain33/queue"
 	"github.com/33cn/chain33/types"
+	go_metrics "github.com/rcrowley/go-metrics"
 )
 
 var mlog = log.New("module", "mempool.base")
@@ -40,6 +42,10 @@ type Mempool struct {
 	cache             *txCache
 	delayTxListChan   chan []*types.Transaction
 	currHeight        int64
+	journal           *txJournal
+	future            *futureTxQueue
+	//rpc订阅了新加入的交易时置1
+	pushTx int32
 }
 
 func (mem *Mempool) setAPI(api client.QueueProtocolAPI) {
@@ -73,6 +79,12 @@ func NewMempool(cfg *types.Mempool) *Mempool {
 	if cfg.PoolCacheSize == 0 {
 		cfg.PoolCacheSize = poolCacheSize
 	}
+	if cfg.ReplaceFeeBump == 0 {
+		cfg.ReplaceFeeBump = replaceFeeBump
+	}
+	if cfg.MaxFutureTxNum == 0 {
+		cfg.MaxFutureTxNum = maxFutureTxNum
+	}
 	pool.in = make(chan *queue.Message)
 	pool.out = make(<-chan *queue.Message)
 	pool.done = make(chan struct{})
@@ -80,8 +92,19 @@ func NewMempool(cfg *types.Mempool) *Mempool {
 	pool.poolHeader = make(chan struct{}, 2)
 	pool.removeBlockTicket = time.NewTicker(time.Minute)
 	pool.cache = newCache(cfg.MaxTxNumPerAccount, cfg.MaxTxLast, cfg.PoolCacheSize)
+	pool.future = newFutureTxQueue(int(cfg.MaxFutureTxNum), int(cfg.MaxTxNumPerAccount))
 	pool.delayTxListChan = make(chan []*types.Transaction, 16)
-
+	if cfg.EnableJournal {
+		if cfg.JournalDriver == "" {
+			cfg.JournalDriver = journalDriver
+		}
+		if cfg.JournalPath == "" {
+			cfg.JournalPath = journalPath
+		}
+		pool.journal = newTxJournal(cfg.JournalDriver, cfg.JournalPath)
+		pool.cache.setJournal(pool.journal)
+		pool.future.journal = pool.journal
+	}
 	return pool
 }
 
@@ -98,6 +121,7 @@ func (mem *Mempool) Close() {
 	mem.removeBlockTicket.Stop()
 	mlog.Info("mempool module closing")
 	mem.wg.Wait()
+	mem.journal.close()
 	mlog.Info("mempool module closed")
 }
 
@@ -110,6 +134,7 @@ func (mem *Mempool) SetQueueClient(cli queue.Client) {
 		panic("Mempool SetQueueClient client.New err")
 	}
 	mem.setAPI(api)
+	mem.registerMetrics()
 	mem.wg.Add(1)
 	go mem.pollLastHeader()
 	mem.wg.Add(1)
@@ -122,6 +147,48 @@ func (mem *Mempool) SetQueueClient(cli queue.Client) {
 	go mem.pushDelayTxRoutine()
 }
 
+//registerMetrics 注册交易数量和交易占用字节数指标
+func (mem *Mempool) registerMetrics() {
+	go_metrics.Unregister("mempool_size")
+	_ = go_metrics.Register("mempool_size", go_metrics.NewFunctionalGauge(func() int64 {
+		return int64(mem.Size())
+	}))
+	go_metrics.Unregister("mempool_bytes")
+	_ = go_metrics.Register("mempool_bytes", go_metrics.NewFunctionalGauge(func() int64 {
+		mem.proxyMtx.RLock()
+		defer mem.proxyMtx.RUnlock()
+		//SetQueueCache可能在SetQueueClient之后调用
+		if mem.cache.qcache == nil {
+			return 0
+		}
+		return mem.cache.qcache.GetCacheBytes()
+	}))
+}
+
+//rejectedErrs 统计未被mempool接受的交易时作为标签的错误，其他错误统一记为other，避免标签无限增长，
+//执行器返回的错误可能经过queue转换，按错误信息匹配
+var rejectedErrs = make(map[string]bool)
+
+func init() {
+	for _, err := range []error{
+		types.ErrNotSync, types.ErrTxExist, types.ErrDupTx, types.ErrMemFull, types.ErrManyTx,
+		types.ErrTxFeeTooLow, types.ErrReplaceFeeTooLow, types.ErrTxExpire, types.ErrSign, types.ErrEmptyTx,
+		types.ErrNoBalance, types.ErrBalanceLessThanTenTimesFee, types.ErrTxMsgSizeTooBig, types.ErrCacheOverFlow,
+		types.ErrInvalidAddress,
+	} {
+		rejectedErrs[err.Error()] = true
+	}
+}
+
+//markRejected 按错误类型统计未被mempool接受的交易
+func markRejected(err error) {
+	label := "other"
+	if rejectedErrs[err.Error()] {
+		label = err.Error()
+	}
+	go_metrics.GetOrRegisterCounter(metrics.Name("mempool_rejected", "err", label), nil).Inc(1)
+}
+
 // Size 返回mempool中txCache大小
 func (mem *Mempool) Size() int {
 	mem.proxyMtx.RLock()
@@ -192,7 +259,7 @@ func (mem *Mempool) sortEthSignTyTx(txs []*types.Transaction) []*types.Transacti
 	var ethsignTxs = make(map[string][]*types.Transaction)
 	for _, tx := range txs {
 		//只有eth 签名且非平行链交易才能进入mempool 中进行 nonce 排序
-		if types.IsEthSignID(tx.GetSignature().GetTy()) && !bytes.HasPrefix(tx.GetExecer(), []byte(types.ParaKeyX)) {
+		if isEthNonceTx(tx) {
 			//暂时不考虑组交易的情况
 			ethsignTxs[tx.From()] = append(ethsignTxs[tx.From()], tx)
 			continue
@@ -231,19 +298,31 @@ func (mem *Mempool) sortEthSignTyTx(txs []*types.Transaction) []*types.Transacti
 }
 
 func (mem *Mempool) getCurrentNonce(addr string) int64 {
+	nonce, _ := mem.queryCurrentNonce(addr)
+	return nonce
+}
+
+//queryCurrentNonce 查询账户在evm中的当前nonce
+func (mem *Mempool) queryCurrentNonce(addr string) (int64, error) {
 	msg := mem.client.NewMessage("rpc", types.EventGetEvmNonce, &types.ReqEvmAccountNonce{
 		Addr: addr,
 	})
-	mem.client.Send(msg, true)
+	err := mem.client.Send(msg, true)
+	if err != nil {
+		return 0, err
+	}
 	reply, err := mem.client.WaitTimeout(msg, time.Second*2)
-	if err == nil {
-		nonceInfo, ok := reply.GetData().(*types.EvmAccountNonce)
-		if ok {
-			return nonceInfo.GetNonce()
-		}
+	if err != nil {
+		return 0, err
 	}
-	return 0
-
+	if reply.Err() != nil {
+		return 0, reply.Err()
+	}
+	nonceInfo, ok := reply.GetData().(*types.EvmAccountNonce)
+	if !ok {
+		return 0, types.ErrTypeAsset
+	}
+	return nonceInfo.GetNonce(), nil
 }
 
 // RemoveTxs 从mempool中删除给定Hash的txs
@@ -266,9 +345,13 @@ func (mem *Mempool) removeTxs(hashes [][]byte) {
 
 // PushTx 将交易推入mempool，并返回结果（error）
 func (mem *Mempool) PushTx(tx *types.Transaction) error {
+	return mem.pushTxWithTime(tx, types.Now().Unix())
+}
+
+func (mem *Mempool) pushTxWithTime(tx *types.Transaction, enterTime int64) error {
 	mem.proxyMtx.Lock()
 	defer mem.proxyMtx.Unlock()
-	err := mem.cache.Push(tx)
+	err := mem.cache.pushWithTime(tx, enterTime)
 	return err
 }
 
@@ -353,6 +436,7 @@ func (mem *Mempool) pollLastHeader() {
 		}
 		h := lastHeader.(*queue.Message).Data.(*types.Header)
 		mem.setHeader(h)
+		mem.restoreJournal()
 		return
 	}
 }
@@ -363,6 +447,7 @@ func (mem *Mempool) removeExpired() {
 	types.AssertConfig(mem.client)
 	//mempool的header是当前高度，而交易将被下一个区块打包，过期判定采用下一个区块的高度和时间
 	mem.cache.removeExpiredTx(mem.client.GetConfig(), mem.header.GetHeight()+1, mem.header.GetBlockTime())
+	mem.future.removeExpired(mem.client.GetConfig(), mem.header.GetHeight()+1, mem.header.GetBlockTime())
 }
 
 // removeBlockedTxs 每隔1分钟清理一次已打包的交易
@@ -425,14 +510,27 @@ func (mem *Mempool) GetProperFeeRate(req *types.ReqProperFee) int64 {
 	}
 	feeRate := mem.getCacheFeeRate()
 	if mem.cfg.IsLevelFee {
-		levelFeeRate := mem.getLevelFeeRate(mem.cfg.MinTxFeeRate, req.TxCount, req.TxSize)
+		levelFeeRate := mem.getLevelFeeRate(mem.getMinTxFeeRate(), req.TxCount, req.TxSize)
 		if levelFeeRate > feeRate {
 			feeRate = levelFeeRate
 		}
 	}
+	//开启基础手续费之后，不低于下一个区块的基础手续费
+	if baseFee := types.CalcBaseFee(mem.client.GetConfig(), mem.GetHeader()); baseFee > feeRate {
+		feeRate = baseFee
+	}
 	return feeRate
 }
 
+//getMinTxFeeRate 交易的最低手续费费率，开启基础手续费之后不低于下一个区块的基础手续费
+func (mem *Mempool) getMinTxFeeRate() int64 {
+	baseFee := types.CalcBaseFee(mem.client.GetConfig(), mem.GetHeader())
+	if baseFee > mem.cfg.MinTxFeeRate {
+		return baseFee
+	}
+	return mem.cfg.MinTxFeeRate
+}
+
 // getLevelFeeRate 获取合适的阶梯手续费率, 可以外部传入count, size进行前瞻性估计
 func (mem *Mempool) getLevelFeeRate(baseFeeRate int64, appendCount, appendSize int32) int64 {
 	var feeRate int64
@@ -507,11 +605,11 @@ func (mem *Mempool) Wait() {
 }
 
 // SendTxToP2P 向"p2p"发送消息
-func (mem *Mempool) sendTxToP2P(tx *types.Transaction) {
+func (mem *Mempool) sendTxToP2P(tx *types.Transaction, parent trace.SpanContext) {
 	if mem.client == nil {
 		panic("client not bind message queue.")
 	}
-	msg := mem.client.NewMessage("p2p", types.EventTxBroadcast, tx)
+	msg := queue.WithTrace(mem.client, parent).NewMessage("p2p", types.EventTxBroadcast, tx)
 	err := mem.client.Send(msg, false)
 	if err != nil {
 		mlog.Error("tx sent to p2p", "tx.Hash", common.ToHex(tx.Hash()))
@@ -520,6 +618,18 @@ func (mem *Mempool) sendTxToP2P(tx *types.Transaction) {
 	//mlog.Debug("tx sent to p2p", "tx.Hash", common.ToHex(tx.Hash()))
 }
 
+//sendTxToRPC 新加入mempool的交易推送给rpc的订阅者，不阻塞mempool的处理
+func (mem *Mempool) sendTxToRPC(tx *types.Transaction) {
+	if atomic.LoadInt32(&mem.pushTx) == 0 {
+		return
+	}
+	msg := mem.client.NewMessage("rpc", types.EventPushMempoolTx, tx)
+	err := mem.client.SendTimeout(msg, false, 0)
+	if err != nil {
+		mlog.Debug("tx sent to rpc", "tx.Hash", common.ToHex(tx.Hash()), "err", err)
+	}
+}
+
 // Mempool.checkSync检查并获取mempool同步状态
 func (mem *Mempool) checkSync() {
 	defer func() {
@@ -37,6 +37,8 @@ type txCache struct {
 	totalFee int64
 	*SHashTxCache
 	delayCache *delayTxCache
+	journal    *txJournal
+	nonceIndex *ethNonceIndex
 }
 
 //NewTxCache init accountIndex and last cache
@@ -46,9 +48,16 @@ func newCache(maxTxPerAccount int64, sizeLast int64, poolCacheSize int64) *txCac
 		LastTxCache:    NewLastTxCache(int(sizeLast)),
 		SHashTxCache:   NewSHashTxCache(int(poolCacheSize)),
 		delayCache:     newDelayTxCache(int(poolCacheSize) / 2),
+		nonceIndex:     newEthNonceIndex(),
 	}
 }
 
+//setJournal 开启交易日志
+func (cache *txCache) setJournal(journal *txJournal) {
+	cache.journal = journal
+	cache.delayCache.journal = journal
+}
+
 //SetQueueCache set queue cache , 这个接口可以扩展
 func (cache *txCache) SetQueueCache(qcache QueueCache) {
 	cache.qcache = qcache
@@ -69,6 +78,10 @@ func (cache *txCache) Remove(hash string) {
 	cache.LastTxCache.Remove(hash)
 	cache.totalFee -= tx.Fee
 	cache.SHashTxCache.Remove(hash)
+	cache.journal.removeTx(hash)
+	if isEthNonceTx(tx) {
+		cache.nonceIndex.remove(tx, hash)
+	}
 }
 
 //Exist 是否存在
@@ -109,10 +122,15 @@ func (cache *txCache) RemoveTxs(txs []string) {
 
 //Push 存入交易到cache 中
 func (cache *txCache) Push(tx *types.Transaction) error {
+	return cache.pushWithTime(tx, types.Now().Unix())
+}
+
+//pushWithTime 存入交易到cache中，从日志恢复的交易保留原来进入mempool的时间
+func (cache *txCache) pushWithTime(tx *types.Transaction, enterTime int64) error {
 	if !cache.AccountTxIndex.CanPush(tx) {
 		return types.ErrManyTx
 	}
-	item := &Item{Value: tx, Priority: tx.Fee, EnterTime: types.Now().Unix()}
+	item := &Item{Value: tx, Priority: tx.Fee, EnterTime: enterTime}
 	txHash := tx.Hash()
 	err := cache.qcache.Push(item)
 	if err != nil {
@@ -125,6 +143,10 @@ func (cache *txCache) Push(tx *types.Transaction) error {
 	cache.LastTxCache.Push(tx, string(txHash))
 	cache.totalFee += tx.Fee
 	cache.SHashTxCache.Push(tx, txHash)
+	cache.journal.addTx(tx, string(txHash), item.EnterTime)
+	if isEthNonceTx(tx) {
+		cache.nonceIndex.push(tx, string(txHash))
+	}
 	return nil
 }
 
@@ -168,6 +190,7 @@ type delayTxCache struct {
 	txCache   map[int64][]*types.Transaction // 以延时时间作为key索引
 	hashCache map[string]int64               //哈希缓存，用于查重
 	lock      sync.RWMutex
+	journal   *txJournal
 }
 
 // new txCache
@@ -179,8 +202,7 @@ func newDelayTxCache(size int) *delayTxCache {
 	}
 }
 
-// 目前延时交易只做缓存，到期后发到mempool，通过节点广播可以基本避免局部缓存丢失问题
-// TODO: 后续可以考虑增加磁盘存储
+// 延时交易缓存，到期后发到mempool，开启交易日志时同时记录到磁盘
 func (c *delayTxCache) addDelayTx(tx *types.DelayTx) error {
 
 	c.lock.Lock()
@@ -203,6 +225,7 @@ func (c *delayTxCache) addDelayTx(tx *types.DelayTx) error {
 	}
 	txList = append(txList, tx.GetTx())
 	c.txCache[tx.EndDelayTime] = txList
+	c.journal.addDelayTx(tx, txHash)
 	return nil
 }
 
@@ -233,7 +256,9 @@ func (c *delayTxCache) delExpiredTxs(lastBlockTime, currBlockTime, currBlockHeig
 
 	// 删除哈希缓存
 	for _, tx := range delList {
-		delete(c.hashCache, string(tx.Hash()))
+		txHash := string(tx.Hash())
+		delete(c.hashCache, txHash)
+		c.journal.removeDelayTx(txHash)
 	}
 	return delList
 }
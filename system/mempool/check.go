@@ -102,7 +102,7 @@ func (mem *Mempool) checkTxs(msg *queue.Message) *queue.Message {
 		return msg
 	}
 
-	err := cacheTx.Check(cfg, mem.GetHeader().GetHeight()+1, mem.cfg.MinTxFeeRate, mem.cfg.MaxTxFee)
+	err := cacheTx.Check(cfg, mem.GetHeader().GetHeight()+1, mem.getMinTxFeeRate(), mem.cfg.MaxTxFee)
 	if err == nil && mem.cfg.IsLevelFee {
 		err = mem.checkLevelFee(cacheTx)
 	}
@@ -135,7 +135,7 @@ func (mem *Mempool) checkTxs(msg *queue.Message) *queue.Message {
 // checkLevelFee 检查阶梯手续费
 func (mem *Mempool) checkLevelFee(tx *types.TransactionCache) error {
 	//获取mempool里所有交易手续费总和
-	feeRate := mem.getLevelFeeRate(mem.cfg.MinTxFeeRate, 0, 0)
+	feeRate := mem.getLevelFeeRate(mem.getMinTxFeeRate(), 0, 0)
 	totalfee, err := tx.GetTotalFee(feeRate)
 	if err != nil {
 		return err
@@ -148,6 +148,11 @@ func (mem *Mempool) checkLevelFee(tx *types.TransactionCache) error {
 
 //checkTxRemote 检查账户余额是否足够，并加入到Mempool，成功则传入goodChan，若加入Mempool失败则传入badChan
 func (mem *Mempool) checkTxRemote(msg *queue.Message) *queue.Message {
+	return mem.checkTxRemoteWithTime(msg, types.Now().Unix())
+}
+
+//checkTxRemoteWithTime 同checkTxRemote，enterTime为交易进入mempool的时间
+func (mem *Mempool) checkTxRemoteWithTime(msg *queue.Message, enterTime int64) *queue.Message {
 	tx := msg.GetData().(types.TxGroup)
 	lastheader := mem.GetHeader()
 
@@ -175,8 +180,19 @@ func (mem *Mempool) checkTxRemote(msg *queue.Message) *queue.Message {
 		return msg
 	}
 
-	//exec模块检查效率影响系统性能， 支持关闭
-	if !mem.cfg.DisableExecCheck {
+	//eth签名交易检查nonce，nonce不连续的交易同样需要通过执行检查(包括手续费余额)才能暂存在future队列
+	ethTx := txGroup == nil && mem.isEthNonceCheck(tx.Tx(), lastheader.GetHeight())
+	future := false
+	if ethTx {
+		future, err = mem.checkEthNonce(tx.Tx())
+		if err != nil {
+			msg.Data = err
+			return msg
+		}
+	}
+
+	//exec模块检查效率影响系统性能， 支持关闭，future队列中的交易不参与打包，不能跳过检查
+	if !mem.cfg.DisableExecCheck || future {
 		txlist := &types.ExecTxList{}
 		txlist.Txs = append(txlist.Txs, tx.Tx())
 		txlist.BlockTime = lastheader.BlockTime
@@ -185,6 +201,7 @@ func (mem *Mempool) checkTxRemote(msg *queue.Message) *queue.Message {
 		// 增加这个属性，在执行器中会使用到
 		txlist.Difficulty = uint64(lastheader.Difficulty)
 		txlist.IsMempool = true
+		txlist.BaseFee = types.CalcBaseFee(mem.client.GetConfig(), lastheader)
 
 		result, err := mem.checkTxListRemote(txlist)
 
@@ -198,7 +215,13 @@ func (mem *Mempool) checkTxRemote(msg *queue.Message) *queue.Message {
 		}
 	}
 
-	err = mem.PushTx(tx.Tx())
+	if future {
+		err = mem.pushFutureTx(tx.Tx(), enterTime)
+	} else if ethTx {
+		err = mem.pushEthTx(tx.Tx(), enterTime)
+	} else {
+		err = mem.pushTxWithTime(tx.Tx(), enterTime)
+	}
 	if err != nil {
 		if err == types.ErrMemFull {
 			//has a sleep
@@ -206,6 +229,14 @@ func (mem *Mempool) checkTxRemote(msg *queue.Message) *queue.Message {
 		}
 		mlog.Error("checkTxRemote", "push err", err)
 		msg.Data = err
+		return msg
+	}
+	if future {
+		msg.Data = &futureTx{TxGroup: tx}
+		return msg
+	}
+	if ethTx {
+		mem.promoteFutureTx(tx.Tx().From())
 	}
 	return msg
 }
@@ -14,7 +14,11 @@ var (
 	mempoolExpiredInterval int64 = 600   // mempool内交易过期时间，10分钟
 	maxTxNumPerAccount     int64 = 100   // TODO 每个账户在mempool中最大交易数量，10
 	maxTxLast              int64 = 10
+	replaceFeeBump         int64 = 10   // 相同nonce的交易替换时手续费至少提高的百分比
+	maxFutureTxNum         int64 = 1024 // nonce不连续的交易缓存容量
 	processNum             int
+	journalDriver          = "leveldb"         // 交易日志数据库类型
+	journalPath            = "datadir/mempool" // 交易日志数据库路径
 )
 
 // TODO
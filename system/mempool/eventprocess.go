@@ -15,10 +15,16 @@ func (mem *Mempool) reply() {
 	defer mem.wg.Done()
 	for m := range mem.out {
 		if m.Err() != nil {
+			markRejected(m.Err())
 			m.Reply(mem.client.NewMessage("rpc", types.EventReply,
 				&types.Reply{IsOk: false, Msg: []byte(m.Err().Error())}))
+		} else if _, ok := m.GetData().(*futureTx); ok {
+			//future队列中的交易补齐nonce进入mempool之后再广播
+			m.Reply(mem.client.NewMessage("rpc", types.EventReply, &types.Reply{IsOk: true, Msg: nil}))
 		} else {
-			mem.sendTxToP2P(m.GetData().(types.TxGroup).Tx())
+			tx := m.GetData().(types.TxGroup).Tx()
+			mem.sendTxToP2P(tx, m.Trace)
+			mem.sendTxToRPC(tx)
 			m.Reply(mem.client.NewMessage("rpc", types.EventReply, &types.Reply{IsOk: true, Msg: nil}))
 		}
 	}
@@ -90,6 +96,9 @@ func (mem *Mempool) eventProcess() {
 		case types.EventGetLastMempool:
 			// 消息类型EventGetLastMempool：获取最新十条加入到mempool的交易
 			mem.eventGetLastMempool(msg)
+		case types.EventGetFutureMempool:
+			// 消息类型EventGetFutureMempool：获取future队列中nonce不连续的交易
+			mem.eventGetFutureMempool(msg)
 		case types.EventDelBlock:
 			// 回滚区块，把该区块内交易重新加回mempool
 			mem.eventDelBlock(msg)
@@ -106,6 +115,9 @@ func (mem *Mempool) eventProcess() {
 			mem.eventCheckTxsExist(msg)
 		case types.EventAddDelayTx:
 			mem.eventAddDelayTx(msg)
+		case types.EventSubMempoolTx:
+			// rpc开启或者关闭新加入交易的推送
+			mem.eventSubMempoolTx(msg)
 
 		default:
 		}
@@ -116,6 +128,7 @@ func (mem *Mempool) eventProcess() {
 //EventTx 初步筛选后存入mempool
 func (mem *Mempool) eventTx(msg *queue.Message) {
 	if !mem.getSync() {
+		markRejected(types.ErrNotSync)
 		msg.Reply(mem.client.NewMessage("", types.EventReply, &types.Reply{Msg: []byte(types.ErrNotSync.Error())}))
 		mlog.Debug("wrong tx", "err", types.ErrNotSync.Error())
 	} else {
@@ -127,6 +140,16 @@ func (mem *Mempool) eventTx(msg *queue.Message) {
 	}
 }
 
+// EventSubMempoolTx 开启或者关闭新加入交易的推送
+func (mem *Mempool) eventSubMempoolTx(msg *queue.Message) {
+	var push int32
+	if msg.GetData().(*types.Int32).GetData() != 0 {
+		push = 1
+	}
+	atomic.StoreInt32(&mem.pushTx, push)
+	msg.Reply(mem.client.NewMessage("", types.EventSubMempoolTx, &types.Reply{IsOk: true}))
+}
+
 // EventGetMempool 获取Mempool内所有交易
 func (mem *Mempool) eventGetMempool(msg *queue.Message) {
 	var isAll bool
@@ -172,13 +195,18 @@ func (mem *Mempool) eventAddBlock(msg *queue.Message) {
 		header.BlockTime = block.BlockTime
 		header.Height = block.Height
 		header.StateHash = block.StateHash
+		header.TxCount = int64(len(block.Txs))
+		header.BaseFee = block.BaseFee
 		mem.setHeader(header)
+		mem.journal.setHeader(header)
 	}
 	//同步状态等mempool中不存在交易时，不需要执行操作
 	if mem.Size() > 0 {
 		mem.RemoveTxsOfBlock(block)
 		mem.removeExpired()
 	}
+	// 区块中的交易使账户nonce增长，尝试推送future队列中的交易
+	mem.promoteFutureTxsOfBlock(block)
 	// 检测是否存在延时存证交易，并将其中的延时交易进行暂存
 	mem.addDelayTx(mem.cache.delayCache, block)
 	// 区块高度增长，推送延时到期的延时交易
@@ -257,6 +285,15 @@ func (mem *Mempool) eventGetLastMempool(msg *queue.Message) {
 		&types.ReplyTxList{Txs: txList}))
 }
 
+// EventGetFutureMempool 获取future队列中nonce不连续的交易
+func (mem *Mempool) eventGetFutureMempool(msg *queue.Message) {
+	mem.proxyMtx.RLock()
+	txList := mem.future.getTxs()
+	mem.proxyMtx.RUnlock()
+	msg.Reply(mem.client.NewMessage("rpc", types.EventReplyTxList,
+		&types.ReplyTxList{Txs: txList}))
+}
+
 // EventDelBlock 回滚区块，把该区块内交易重新加回mempool
 func (mem *Mempool) eventDelBlock(msg *queue.Message) {
 	block := msg.GetData().(*types.BlockDetail).Block
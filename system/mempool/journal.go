@@ -0,0 +1,200 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"encoding/binary"
+
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+)
+
+//交易日志：记录进入mempool的交易、future队列中的交易和延时交易，以及交易的删除，节点重启后从日志中恢复
+
+var (
+	journalTxPrefix    = []byte("mempool-journal-tx-")
+	journalDelayPrefix = []byte("mempool-journal-delay-")
+	journalHeaderKey   = []byte("mempool-journal-header")
+)
+
+//journalTx 日志中记录的交易，以及交易进入mempool的时间
+type journalTx struct {
+	tx        *types.Transaction
+	enterTime int64
+}
+
+type txJournal struct {
+	db dbm.DB
+}
+
+func newTxJournal(driver, path string) *txJournal {
+	return &txJournal{db: dbm.NewDB("journal", driver, path, 16)}
+}
+
+func journalTxKey(hash string) []byte {
+	return append(append([]byte{}, journalTxPrefix...), hash...)
+}
+
+func journalDelayKey(hash string) []byte {
+	return append(append([]byte{}, journalDelayPrefix...), hash...)
+}
+
+//addTx 记录进入mempool的交易，value为8字节的进入时间和交易数据
+func (j *txJournal) addTx(tx *types.Transaction, hash string, enterTime int64) {
+	if j == nil {
+		return
+	}
+	value := make([]byte, 8, 8+tx.Size())
+	binary.BigEndian.PutUint64(value, uint64(enterTime))
+	value = append(value, types.Encode(tx)...)
+	if err := j.db.Set(journalTxKey(hash), value); err != nil {
+		mlog.Error("journal addTx", "err", err)
+	}
+}
+
+func (j *txJournal) removeTx(hash string) {
+	if j == nil {
+		return
+	}
+	if err := j.db.Delete(journalTxKey(hash)); err != nil {
+		mlog.Error("journal removeTx", "err", err)
+	}
+}
+
+func (j *txJournal) addDelayTx(delayTx *types.DelayTx, hash string) {
+	if j == nil {
+		return
+	}
+	if err := j.db.Set(journalDelayKey(hash), types.Encode(delayTx)); err != nil {
+		mlog.Error("journal addDelayTx", "err", err)
+	}
+}
+
+func (j *txJournal) removeDelayTx(hash string) {
+	if j == nil {
+		return
+	}
+	if err := j.db.Delete(journalDelayKey(hash)); err != nil {
+		mlog.Error("journal removeDelayTx", "err", err)
+	}
+}
+
+//setHeader 记录mempool处理过的最新区块头，重启后用于判断停机期间到期的延时交易
+func (j *txJournal) setHeader(header *types.Header) {
+	if j == nil {
+		return
+	}
+	if err := j.db.Set(journalHeaderKey, types.Encode(header)); err != nil {
+		mlog.Error("journal setHeader", "err", err)
+	}
+}
+
+func (j *txJournal) getHeader() *types.Header {
+	value, err := j.db.Get(journalHeaderKey)
+	if err != nil {
+		return nil
+	}
+	header := &types.Header{}
+	if types.Decode(value, header) != nil {
+		return nil
+	}
+	return header
+}
+
+//loadTxs 读取日志中的交易，无法解析的记录直接丢弃
+func (j *txJournal) loadTxs() []*journalTx {
+	var txs []*journalTx
+	values := dbm.NewListHelper(j.db).PrefixScan(journalTxPrefix)
+	for _, value := range values {
+		tx := &types.Transaction{}
+		if len(value) < 8 || types.Decode(value[8:], tx) != nil {
+			mlog.Error("journal loadTxs", "err", types.ErrDecode)
+			continue
+		}
+		txs = append(txs, &journalTx{tx: tx, enterTime: int64(binary.BigEndian.Uint64(value))})
+	}
+	return txs
+}
+
+func (j *txJournal) loadDelayTxs() []*types.DelayTx {
+	var delayTxs []*types.DelayTx
+	values := dbm.NewListHelper(j.db).PrefixScan(journalDelayPrefix)
+	for _, value := range values {
+		delayTx := &types.DelayTx{}
+		if types.Decode(value, delayTx) != nil || delayTx.GetTx() == nil {
+			mlog.Error("journal loadDelayTxs", "err", types.ErrDecode)
+			continue
+		}
+		delayTxs = append(delayTxs, delayTx)
+	}
+	return delayTxs
+}
+
+func (j *txJournal) close() {
+	if j == nil {
+		return
+	}
+	j.db.Close()
+}
+
+//restoreJournal 节点重启后恢复日志中的交易，交易需要重新通过mempool的检查，并保留原来进入mempool的时间，
+//nonce不连续的eth交易重新进入future队列，
+//过期的以及已经被打包的交易直接从日志中删除
+func (mem *Mempool) restoreJournal() {
+	journal := mem.journal
+	if journal == nil {
+		return
+	}
+	header := mem.GetHeader()
+	txs := journal.loadTxs()
+	restored := 0
+	for _, jtx := range txs {
+		hash := string(jtx.tx.Hash())
+		if mem.cache.Exist(hash) {
+			restored++
+			continue
+		}
+		if types.Now().Unix()-jtx.enterTime >= mempoolExpiredInterval {
+			journal.removeTx(hash)
+			continue
+		}
+		msg := mem.checkTxs(mem.client.NewMessage("mempool", types.EventTx, jtx.tx))
+		if msg.Err() == nil {
+			msg = mem.checkSign(msg)
+		}
+		if msg.Err() == nil {
+			msg = mem.checkTxRemoteWithTime(msg, jtx.enterTime)
+		}
+		if msg.Err() != nil {
+			mlog.Debug("restoreJournal", "txHash", common.ToHex(jtx.tx.Hash()), "err", msg.Err())
+			journal.removeTx(hash)
+			continue
+		}
+		restored++
+	}
+
+	//停机期间到期的延时交易在下一个区块推送到mempool，其他的重新加入延时交易缓存
+	last := journal.getHeader()
+	if last == nil {
+		last = header
+	}
+	expired := 0
+	delayTxs := journal.loadDelayTxs()
+	for _, delayTx := range delayTxs {
+		end := delayTx.EndDelayTime
+		if (end > last.GetBlockTime() && end <= header.GetBlockTime()) ||
+			(end > last.GetHeight() && end <= header.GetHeight()) {
+			delayTx.EndDelayTime = header.GetHeight() + 1
+			expired++
+		}
+		if err := mem.cache.delayCache.addDelayTx(delayTx); err != nil && err != types.ErrDupTx {
+			mlog.Error("restoreJournal", "delayTxHash", common.ToHex(delayTx.Tx.Hash()), "err", err)
+			journal.removeDelayTx(string(delayTx.Tx.Hash()))
+		}
+	}
+	journal.setHeader(header)
+	mlog.Info("restoreJournal", "txs", len(txs), "restored", restored, "delayTxs", len(delayTxs), "expiredDelayTxs", expired)
+}
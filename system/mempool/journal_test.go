@@ -0,0 +1,145 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+)
+
+//initJournalEnv packed中的交易在blockchain中被认为已经打包
+func initJournalEnv(dir string, packed map[string]bool) (queue.Queue, *Mempool) {
+	cfg := types.NewChain33Config(types.MergeCfg(types.ReadFile("../../cmd/chain33/chain33.test.toml"), types.ReadFile("../../cmd/chain33/chain33.fork.toml")))
+	mcfg := cfg.GetModuleConfig()
+	q := queue.New("channel")
+	q.SetConfig(cfg)
+	go func() {
+		client := q.Client()
+		client.Sub("blockchain")
+		for msg := range client.Recv() {
+			switch msg.Ty {
+			case types.EventGetLastHeader:
+				msg.Reply(client.NewMessage("", types.EventHeader, &types.Header{Height: 10, BlockTime: 10}))
+			case types.EventIsSync:
+				msg.Reply(client.NewMessage("", types.EventReplyIsSync, &types.IsCaughtUp{Iscaughtup: true}))
+			case types.EventTxHashList:
+				var hashes [][]byte
+				for _, hash := range msg.Data.(*types.TxHashList).Hashes {
+					if packed[string(hash)] {
+						hashes = append(hashes, hash)
+					}
+				}
+				msg.Reply(client.NewMessage("", types.EventTxHashListReply, &types.TxHashList{Hashes: hashes}))
+			}
+		}
+	}()
+	execProcess(q)
+	mcfg.Mempool.EnableJournal = true
+	mcfg.Mempool.JournalPath = dir
+	mem := NewMempool(mcfg.Mempool)
+	mem.SetQueueCache(NewSimpleQueue(SubConfig{mcfg.Mempool.PoolCacheSize, mcfg.Mempool.MinTxFeeRate}))
+	mem.SetQueueClient(q.Client())
+	mem.setSync(true)
+	mem.SetMinFee(cfg.GetMinTxFeeRate())
+	mem.Wait()
+	return q, mem
+}
+
+func TestMempoolJournal(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mempool")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	q, mem := initJournalEnv(dir, nil)
+	for _, tx := range []*types.Transaction{tx2, tx3, tx4, tx5} {
+		msg := mem.client.NewMessage("mempool", types.EventTx, tx)
+		require.Nil(t, mem.client.Send(msg, true))
+		resp, err := mem.client.Wait(msg)
+		require.Nil(t, err)
+		require.True(t, resp.GetData().(*types.Reply).GetIsOk())
+	}
+	require.Nil(t, mem.RemoveTxs(&types.TxHashList{Hashes: [][]byte{tx3.Hash()}}))
+	//进入mempool时间过长的交易
+	mem.journal.addTx(tx6, string(tx6.Hash()), types.Now().Unix()-mempoolExpiredInterval)
+	//恢复的交易保留原来进入mempool的时间
+	enterTime := types.Now().Unix() - 100
+	mem.journal.addTx(tx2, string(tx2.Hash()), enterTime)
+
+	//延时交易, 停机期间到期的交易在重启后的下一个区块推送
+	mem.journal.setHeader(&types.Header{Height: 5, BlockTime: 5})
+	delayTxs := []*types.DelayTx{
+		{Tx: tx7, EndDelayTime: 20},
+		{Tx: tx8, EndDelayTime: 8},
+		{Tx: tx9, EndDelayTime: 3},
+	}
+	for _, delayTx := range delayTxs {
+		require.Nil(t, mem.cache.delayCache.addDelayTx(delayTx))
+	}
+	txs := mem.cache.delayCache.delExpiredTxs(0, 0, 3)
+	require.Equal(t, 1, len(txs))
+	mem.Close()
+	q.Close()
+
+	//重启后恢复交易，已经打包的交易被删除
+	q, mem = initJournalEnv(dir, map[string]bool{string(tx5.Hash()): true})
+	require.Equal(t, 2, mem.Size())
+	require.True(t, mem.cache.Exist(string(tx2.Hash())))
+	require.True(t, mem.cache.Exist(string(tx4.Hash())))
+	item, err := mem.cache.qcache.GetItem(string(tx2.Hash()))
+	require.Nil(t, err)
+	require.Equal(t, enterTime, item.EnterTime)
+	journalTxs := mem.journal.loadTxs()
+	require.Equal(t, 2, len(journalTxs))
+	end, ok := mem.cache.delayCache.contains(tx7.Hash())
+	require.True(t, ok)
+	require.Equal(t, int64(20), end)
+	end, ok = mem.cache.delayCache.contains(tx8.Hash())
+	require.True(t, ok)
+	require.Equal(t, int64(11), end)
+	_, ok = mem.cache.delayCache.contains(tx9.Hash())
+	require.False(t, ok)
+	require.Equal(t, int64(10), mem.journal.getHeader().Height)
+	mem.Close()
+	q.Close()
+
+	//关闭交易日志
+	cfg := types.NewChain33Config(types.ReadFile("../../cmd/chain33/chain33.test.toml"))
+	mem = NewMempool(cfg.GetModuleConfig().Mempool)
+	require.Nil(t, mem.journal)
+	mem.cache.journal.addTx(tx2, string(tx2.Hash()), 0)
+	mem.Close()
+}
+
+func TestFutureTxJournal(t *testing.T) {
+	future := newFutureTxQueue(10, 10)
+	future.journal = newTxJournal("memdb", "")
+	defer future.journal.close()
+
+	enterTime := types.Now().Unix() - 100
+	tx := types.Clone(tx2).(*types.Transaction)
+	tx.Nonce = 5
+	require.Nil(t, future.add(tx, 10, enterTime))
+	txs := future.journal.loadTxs()
+	require.Equal(t, 1, len(txs))
+	require.Equal(t, tx.Hash(), txs[0].tx.Hash())
+	require.Equal(t, enterTime, txs[0].enterTime)
+
+	//替换交易同时替换日志中的记录
+	replace := types.Clone(tx).(*types.Transaction)
+	replace.Fee = tx.Fee * 2
+	require.Nil(t, future.add(replace, 10, enterTime+1))
+	txs = future.journal.loadTxs()
+	require.Equal(t, 1, len(txs))
+	require.Equal(t, replace.Hash(), txs[0].tx.Hash())
+
+	//离开future队列的交易从日志中删除
+	require.NotNil(t, future.pop(replace.From(), replace.GetNonce()))
+	require.Equal(t, 0, len(future.journal.loadTxs()))
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/33cn/chain33/common/limits"
 	"github.com/33cn/chain33/common/log"
 	"github.com/33cn/chain33/executor"
+	"github.com/33cn/chain33/metrics"
 	"github.com/33cn/chain33/queue"
 	"github.com/33cn/chain33/store"
 	_ "github.com/33cn/chain33/system/consensus/init"
@@ -33,6 +34,7 @@ import (
 	_ "github.com/33cn/chain33/system/dapp/init"
 	_ "github.com/33cn/chain33/system/store/init"
 	"github.com/33cn/chain33/types"
+	go_metrics "github.com/rcrowley/go-metrics"
 	"github.com/stretchr/testify/require"
 )
 
@@ -291,6 +293,8 @@ func TestAddDuplicatedTx(t *testing.T) {
 	if mem.Size() != 1 {
 		t.Error("TestAddDuplicatedTx failed", "size", mem.Size())
 	}
+	rejected := go_metrics.GetOrRegisterCounter(metrics.Name("mempool_rejected", "err", types.ErrDupTx.Error()), nil)
+	count := rejected.Count()
 	msg2 := mem.client.NewMessage("mempool", types.EventTx, tx2)
 	mem.client.Send(msg2, true)
 	mem.client.Wait(msg2)
@@ -298,6 +302,14 @@ func TestAddDuplicatedTx(t *testing.T) {
 	if mem.Size() != 1 {
 		t.Error("TestAddDuplicatedTx failed", "size", mem.Size())
 	}
+	require.Equal(t, count+1, rejected.Count())
+	require.Equal(t, int64(1), go_metrics.Get("mempool_size").(go_metrics.Gauge).Value())
+	//未知的错误统一记为other
+	other := go_metrics.GetOrRegisterCounter(metrics.Name("mempool_rejected", "err", "other"), nil)
+	count = other.Count()
+	markRejected(errors.New("ErrUnknown"))
+	require.Equal(t, count+1, other.Count())
+	require.Nil(t, go_metrics.Get(metrics.Name("mempool_rejected", "err", "ErrUnknown")))
 }
 
 func checkReply(reply *types.Reply) error {
@@ -1144,6 +1156,10 @@ func rpcProcess(q queue.Queue) {
 		}
 	}()
 }
+
+//手续费为noBalanceFee的交易执行检查返回余额不足
+const noBalanceFee = 999999
+
 func execProcess(q queue.Queue) {
 	go func() {
 		client := q.Client()
@@ -1153,6 +1169,10 @@ func execProcess(q queue.Queue) {
 				datas := msg.GetData().(*types.ExecTxList)
 				result := &types.ReceiptCheckTxList{}
 				for i := 0; i < len(datas.Txs); i++ {
+					if datas.Txs[i].Fee == noBalanceFee {
+						result.Errs = append(result.Errs, types.ErrNoBalance.Error())
+						continue
+					}
 					result.Errs = append(result.Errs, "")
 				}
 				msg.Reply(client.NewMessage("", types.EventReceiptCheckTx, result))
@@ -0,0 +1,302 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"bytes"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/types"
+)
+
+//eth签名交易按账户nonce排序打包：
+//1. 同一账户相同nonce的交易只保留一笔，新交易的手续费比原交易至少高出ReplaceFeeBump百分比时替换原交易，
+//   原交易从mempool的所有索引中删除
+//2. nonce不连续的交易通过执行检查后暂存在future队列中，不参与打包也不广播，前面的nonce补齐后重新检查并加入mempool
+
+//futureTx 进入future队列的交易，补齐nonce进入mempool之前不广播
+type futureTx struct {
+	types.TxGroup
+}
+
+//isEthNonceTx 只有eth签名且非平行链交易才按nonce排序
+func isEthNonceTx(tx *types.Transaction) bool {
+	return types.IsEthSignID(tx.GetSignature().GetTy()) && !bytes.HasPrefix(tx.GetExecer(), []byte(types.ParaKeyX))
+}
+
+//isFeeBumped 替换交易的手续费需要比原交易至少高出bump百分比
+func isFeeBumped(oldFee, newFee, bump int64) bool {
+	return newFee > oldFee && newFee*100 >= oldFee*(100+bump)
+}
+
+//ethNonceIndex mempool中eth签名交易的账户nonce索引
+type ethNonceIndex struct {
+	accounts map[string]map[int64]string
+}
+
+func newEthNonceIndex() *ethNonceIndex {
+	return &ethNonceIndex{accounts: make(map[string]map[int64]string)}
+}
+
+func (idx *ethNonceIndex) get(from string, nonce int64) (string, bool) {
+	hash, ok := idx.accounts[from][nonce]
+	return hash, ok
+}
+
+func (idx *ethNonceIndex) push(tx *types.Transaction, hash string) {
+	from := tx.From()
+	nonces, ok := idx.accounts[from]
+	if !ok {
+		nonces = make(map[int64]string)
+		idx.accounts[from] = nonces
+	}
+	nonces[tx.GetNonce()] = hash
+}
+
+func (idx *ethNonceIndex) remove(tx *types.Transaction, hash string) {
+	from := tx.From()
+	nonces, ok := idx.accounts[from]
+	if !ok || nonces[tx.GetNonce()] != hash {
+		return
+	}
+	delete(nonces, tx.GetNonce())
+	if len(nonces) == 0 {
+		delete(idx.accounts, from)
+	}
+}
+
+//pendingNonce 从账户当前的nonce开始，mempool中nonce连续的交易之后的下一个nonce
+func (idx *ethNonceIndex) pendingNonce(from string, nonce int64) int64 {
+	nonces := idx.accounts[from]
+	for {
+		if _, ok := nonces[nonce]; !ok {
+			return nonce
+		}
+		nonce++
+	}
+}
+
+//futureTxQueue nonce不连续的交易，和mempool中的交易一样记录到交易日志，重启后重新检查nonce
+type futureTxQueue struct {
+	size          int
+	maxPerAccount int
+	count         int
+	accounts      map[string]map[int64]*Item
+	journal       *txJournal
+}
+
+func newFutureTxQueue(size, maxPerAccount int) *futureTxQueue {
+	return &futureTxQueue{
+		size:          size,
+		maxPerAccount: maxPerAccount,
+		accounts:      make(map[string]map[int64]*Item),
+	}
+}
+
+//add 加入future队列，相同nonce的交易同样按手续费替换
+func (q *futureTxQueue) add(tx *types.Transaction, bump, enterTime int64) error {
+	from := tx.From()
+	nonces, ok := q.accounts[from]
+	if ok {
+		if old, ok := nonces[tx.GetNonce()]; ok {
+			if bytes.Equal(old.Value.Hash(), tx.Hash()) {
+				return types.ErrTxExist
+			}
+			if !isFeeBumped(old.Value.Fee, tx.Fee, bump) {
+				return types.ErrReplaceFeeTooLow
+			}
+			nonces[tx.GetNonce()] = &Item{Value: tx, Priority: tx.Fee, EnterTime: enterTime}
+			q.journal.removeTx(string(old.Value.Hash()))
+			q.journal.addTx(tx, string(tx.Hash()), enterTime)
+			return nil
+		}
+	}
+	if len(nonces) >= q.maxPerAccount {
+		return types.ErrManyTx
+	}
+	if q.count >= q.size {
+		return types.ErrMemFull
+	}
+	if !ok {
+		nonces = make(map[int64]*Item)
+		q.accounts[from] = nonces
+	}
+	nonces[tx.GetNonce()] = &Item{Value: tx, Priority: tx.Fee, EnterTime: enterTime}
+	q.count++
+	q.journal.addTx(tx, string(tx.Hash()), enterTime)
+	return nil
+}
+
+func (q *futureTxQueue) delete(from string, nonce int64) {
+	nonces := q.accounts[from]
+	item, ok := nonces[nonce]
+	if !ok {
+		return
+	}
+	q.journal.removeTx(string(item.Value.Hash()))
+	delete(nonces, nonce)
+	q.count--
+	if len(nonces) == 0 {
+		delete(q.accounts, from)
+	}
+}
+
+//pop 取出账户指定nonce的交易
+func (q *futureTxQueue) pop(from string, nonce int64) *Item {
+	item, ok := q.accounts[from][nonce]
+	if !ok {
+		return nil
+	}
+	q.delete(from, nonce)
+	return item
+}
+
+//getTxs future队列中的所有交易
+func (q *futureTxQueue) getTxs() []*types.Transaction {
+	txs := make([]*types.Transaction, 0, q.count)
+	for _, nonces := range q.accounts {
+		for _, item := range nonces {
+			txs = append(txs, item.Value)
+		}
+	}
+	return txs
+}
+
+func (q *futureTxQueue) has(from string) bool {
+	_, ok := q.accounts[from]
+	return ok
+}
+
+//removeStale 删除nonce小于账户当前nonce的交易
+func (q *futureTxQueue) removeStale(from string, nonce int64) {
+	for n := range q.accounts[from] {
+		if n < nonce {
+			q.delete(from, n)
+		}
+	}
+}
+
+func (q *futureTxQueue) removeExpired(cfg *types.Chain33Config, height, blocktime int64) {
+	for from, nonces := range q.accounts {
+		for n, item := range nonces {
+			if isExpired(cfg, item, height, blocktime) {
+				q.delete(from, n)
+			}
+		}
+	}
+}
+
+//isEthNonceCheck 开启eth交易nonce排序之后，同nonce交易替换和future队列才生效
+func (mem *Mempool) isEthNonceCheck(tx *types.Transaction, height int64) bool {
+	return isEthNonceTx(tx) && mem.client.GetConfig().IsFork(height, "ForkCheckEthTxSort")
+}
+
+//checkEthNonce 检查相同nonce的交易替换是否满足手续费要求，nonce不连续时返回future为true
+func (mem *Mempool) checkEthNonce(tx *types.Transaction) (future bool, err error) {
+	from := tx.From()
+	current, nonceErr := mem.queryCurrentNonce(from)
+	mem.proxyMtx.RLock()
+	defer mem.proxyMtx.RUnlock()
+	if hash, ok := mem.cache.nonceIndex.get(from, tx.GetNonce()); ok {
+		old := mem.cache.getTxByHash(hash)
+		if old != nil && !isFeeBumped(old.Fee, tx.Fee, mem.cfg.ReplaceFeeBump) {
+			return false, types.ErrReplaceFeeTooLow
+		}
+		return false, nil
+	}
+	//获取不到账户nonce时不做nonce连续性检查
+	if nonceErr != nil {
+		return false, nil
+	}
+	return tx.GetNonce() > mem.cache.nonceIndex.pendingNonce(from, current), nil
+}
+
+//pushEthTx eth签名交易加入mempool，存在相同nonce的交易时替换原交易，新交易加入失败则恢复原交易
+func (mem *Mempool) pushEthTx(tx *types.Transaction, enterTime int64) error {
+	mem.proxyMtx.Lock()
+	defer mem.proxyMtx.Unlock()
+	hash, ok := mem.cache.nonceIndex.get(tx.From(), tx.GetNonce())
+	if !ok {
+		return mem.cache.pushWithTime(tx, enterTime)
+	}
+	item, err := mem.cache.qcache.GetItem(hash)
+	if err != nil {
+		return mem.cache.pushWithTime(tx, enterTime)
+	}
+	old := item.Value
+	if !isFeeBumped(old.Fee, tx.Fee, mem.cfg.ReplaceFeeBump) {
+		return types.ErrReplaceFeeTooLow
+	}
+	mem.cache.Remove(hash)
+	if err := mem.cache.pushWithTime(tx, enterTime); err != nil {
+		if e := mem.cache.pushWithTime(old, item.EnterTime); e != nil {
+			mlog.Error("pushEthTx", "restore txHash", common.ToHex(old.Hash()), "err", e)
+		}
+		return err
+	}
+	mlog.Debug("pushEthTx", "replace txHash", common.ToHex(old.Hash()), "txHash", common.ToHex(tx.Hash()))
+	return nil
+}
+
+//pushFutureTx nonce不连续的交易加入future队列
+func (mem *Mempool) pushFutureTx(tx *types.Transaction, enterTime int64) error {
+	mem.proxyMtx.Lock()
+	defer mem.proxyMtx.Unlock()
+	return mem.future.add(tx, mem.cfg.ReplaceFeeBump, enterTime)
+}
+
+//promoteFutureTx 账户的nonce补齐后，future队列中下一个nonce的交易重新检查后加入mempool并广播，
+//加入成功后会继续检查后续的交易
+func (mem *Mempool) promoteFutureTx(from string) {
+	current, err := mem.queryCurrentNonce(from)
+	if err != nil {
+		return
+	}
+	mem.proxyMtx.Lock()
+	mem.future.removeStale(from, current)
+	item := mem.future.pop(from, mem.cache.nonceIndex.pendingNonce(from, current))
+	mem.proxyMtx.Unlock()
+	if item == nil {
+		return
+	}
+	tx := item.Value
+	msg := mem.checkTxRemoteWithTime(mem.client.NewMessage("mempool", types.EventTx, types.NewTransactionCache(tx)), item.EnterTime)
+	if msg.Err() != nil {
+		mlog.Debug("promoteFutureTx", "txHash", common.ToHex(tx.Hash()), "err", msg.Err())
+		return
+	}
+	if _, ok := msg.GetData().(*futureTx); ok {
+		return
+	}
+	mem.sendTxToP2P(tx, msg.Trace)
+	mem.sendTxToRPC(tx)
+}
+
+//promoteFutureTxsOfBlock 区块中的交易可能补齐了future队列中交易的nonce
+func (mem *Mempool) promoteFutureTxsOfBlock(block *types.Block) {
+	var accounts []string
+	exist := make(map[string]bool)
+	mem.proxyMtx.RLock()
+	for _, tx := range block.GetTxs() {
+		if !isEthNonceTx(tx) {
+			continue
+		}
+		from := tx.From()
+		if !exist[from] && mem.future.has(from) {
+			exist[from] = true
+			accounts = append(accounts, from)
+		}
+	}
+	mem.proxyMtx.RUnlock()
+	if len(accounts) == 0 {
+		return
+	}
+	//需要查询账户nonce以及执行检查，不阻塞区块事件处理
+	go func() {
+		for _, from := range accounts {
+			mem.promoteFutureTx(from)
+		}
+	}()
+}
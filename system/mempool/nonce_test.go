@@ -0,0 +1,104 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEthNonceReplaceAndFuture(t *testing.T) {
+	pub, err := common.FromHex("0x04715e4e07d983c2d98eeac7018bce6e68ef9de25835340f6455f1b1c9686132ac54904f5e04b07966a256140a5f487c4aef3ddc461e02d58f90cc8baa49f9c7ca")
+	require.Nil(t, err)
+	sig := &types.Signature{Ty: 8452, Pubkey: pub}
+	newTx := func(nonce, fee int64) *types.Transaction {
+		return &types.Transaction{ChainID: 3999, Execer: []byte("coins"), Payload: types.Encode(transfer), Fee: fee, To: toAddr, Nonce: nonce, Signature: sig}
+	}
+	q, mem := initEnv(0)
+	defer q.Close()
+	defer mem.Close()
+	push := func(tx *types.Transaction) error {
+		return mem.checkTxRemote(mem.client.NewMessage("mempool", types.EventTx, types.NewTransactionCache(tx))).Err()
+	}
+
+	//rpc返回的账户nonce为1
+	tx1 := newTx(1, 1000000)
+	require.Nil(t, push(tx1))
+	//手续费提高不足10%
+	require.Equal(t, types.ErrReplaceFeeTooLow, push(newTx(1, 1050000)))
+	require.True(t, mem.cache.Exist(string(tx1.Hash())))
+
+	//替换原交易，原交易从所有索引中删除
+	tx1r := newTx(1, 1100000)
+	require.Nil(t, push(tx1r))
+	require.Equal(t, 1, mem.Size())
+	require.False(t, mem.cache.Exist(string(tx1.Hash())))
+	require.True(t, mem.cache.Exist(string(tx1r.Hash())))
+	require.Equal(t, 1, len(mem.GetAccTxs(&types.ReqAddrs{Addrs: []string{tx1.From()}}).GetTxs()))
+	require.Equal(t, tx1r.Hash(), mem.GetLatestTx()[0].Hash())
+	require.Nil(t, mem.cache.GetSHashTxCache(types.CalcTxShortHash(tx1.Hash())))
+	require.Equal(t, tx1r.Fee, mem.cache.TotalFee())
+
+	//nonce不连续的交易通过执行检查后进入future队列，不参与打包也不广播
+	//测试的blockchain对查询过的交易都返回重复，future队列中的交易直接加入
+	require.Equal(t, types.ErrNoBalance, push(newTx(6, noBalanceFee)))
+	require.Equal(t, 0, mem.future.count)
+	msg := mem.checkTxRemote(mem.client.NewMessage("mempool", types.EventTx, types.NewTransactionCache(newTx(6, 1000000))))
+	require.Nil(t, msg.Err())
+	_, ok := msg.GetData().(*futureTx)
+	require.True(t, ok)
+	tx3 := newTx(3, 1000000)
+	tx4 := newTx(4, 1000000)
+	require.Nil(t, mem.pushFutureTx(tx4, types.Now().Unix()))
+	require.Nil(t, mem.pushFutureTx(tx3, types.Now().Unix()))
+	require.Equal(t, 1, mem.Size())
+	require.Equal(t, 3, mem.future.count)
+	msg = mem.client.NewMessage("mempool", types.EventGetFutureMempool, &types.ReqNil{})
+	require.Nil(t, mem.client.Send(msg, true))
+	reply, err := mem.client.Wait(msg)
+	require.Nil(t, err)
+	require.Equal(t, 3, len(reply.GetData().(*types.ReplyTxList).GetTxs()))
+	require.Equal(t, types.ErrTxExist, mem.pushFutureTx(tx3, types.Now().Unix()))
+	require.Equal(t, types.ErrReplaceFeeTooLow, push(newTx(3, 1000001)))
+
+	//补齐nonce之后future队列中nonce连续的交易进入mempool并广播
+	p2p := q.Client()
+	p2p.Sub("p2p")
+	tx2 := newTx(2, 1000000)
+	require.Nil(t, push(tx2))
+	require.Equal(t, 4, mem.Size())
+	broadcast := make(map[string]bool)
+	for i := 0; i < 2; i++ {
+		select {
+		case msg := <-p2p.Recv():
+			require.Equal(t, int64(types.EventTxBroadcast), msg.Ty)
+			broadcast[string(msg.GetData().(*types.Transaction).Hash())] = true
+		case <-time.After(time.Second):
+			t.Fatal("promoted tx not broadcast")
+		}
+	}
+	require.Equal(t, map[string]bool{string(tx3.Hash()): true, string(tx4.Hash()): true}, broadcast)
+	require.Equal(t, 1, mem.future.count)
+	txs := mem.sortEthSignTyTx(mem.getTxList(&types.TxHashList{Count: 10}))
+	require.Equal(t, 4, len(txs))
+	for i, tx := range txs {
+		require.Equal(t, int64(i+1), tx.GetNonce())
+	}
+
+	//future队列中过期以及nonce过低的交易被删除
+	require.Nil(t, mem.pushFutureTx(newTx(0, 1000000), types.Now().Unix()))
+	mem.future.removeStale(tx1.From(), 1)
+	require.Equal(t, 1, mem.future.count)
+	mem.future.removeExpired(mem.client.GetConfig(), 0, types.Now().Unix())
+	require.Equal(t, 1, mem.future.count)
+	mem.future.accounts[tx1.From()][6].EnterTime -= mempoolExpiredInterval
+	mem.future.removeExpired(mem.client.GetConfig(), 0, types.Now().Unix())
+	require.Equal(t, 0, mem.future.count)
+	require.False(t, mem.future.has(tx1.From()))
+}
@@ -38,11 +38,12 @@ type Conngater struct {
 	maxConnectNum int32
 	ipLimiter     *leakybucket.Collector
 	blacklist     *TimeCache
+	scores        *PeerScoreManager
 	whitPeerList  map[peer.ID]multiaddr.Multiaddr
 }
 
-//NewConnGater connect gater
-func NewConnGater(h *host.Host, limit int32, cache *TimeCache, whitPeers []*peer.AddrInfo) *Conngater {
+//NewConnGater connect gater, scores不为nil时拒绝被评分禁止的节点
+func NewConnGater(h *host.Host, limit int32, cache *TimeCache, scores *PeerScoreManager, whitPeers []*peer.AddrInfo) *Conngater {
 	gater := &Conngater{}
 	gater.host = h
 	if limit == 0 {
@@ -54,6 +55,7 @@ func NewConnGater(h *host.Host, limit int32, cache *TimeCache, whitPeers []*peer
 	if gater.blacklist == nil {
 		gater.blacklist = NewTimeCache(context.Background(), time.Minute*5)
 	}
+	gater.scores = scores
 	gater.ipLimiter = leakybucket.NewCollector(ipLimit, ipBurst, true)
 
 	for _, pr := range whitPeers {
@@ -73,7 +75,7 @@ func (s *Conngater) InterceptPeerDial(p peer.ID) (allow bool) {
 	if !s.checkWhitePeerList(p) {
 		return false
 	}
-	if s.blacklist.Has(p.Pretty()) {
+	if s.isBanned(p) {
 		return false
 	}
 
@@ -92,7 +94,15 @@ func (s *Conngater) checkWhitePeerList(p peer.ID) bool {
 // InterceptAddrDial tests whether we're permitted to dial the specified
 // multiaddr for the given peer.
 func (s *Conngater) InterceptAddrDial(p peer.ID, m multiaddr.Multiaddr) (allow bool) {
-	return !s.blacklist.Has(p.Pretty())
+	return !s.isBanned(p)
+}
+
+//黑名单或者评分禁止的节点
+func (s *Conngater) isBanned(p peer.ID) bool {
+	if s.blacklist.Has(p.Pretty()) {
+		return true
+	}
+	return s.scores != nil && s.scores.IsBanned(p)
 }
 
 // InterceptAccept tests whether an incipient inbound connection is allowed.
@@ -108,6 +118,10 @@ func (s *Conngater) InterceptAccept(n network.ConnMultiaddrs) (allow bool) {
 	if !s.checkWhitAddr(n.RemoteMultiaddr()) {
 		return false
 	}
+	//评分禁止期间拒绝该节点ip的连入
+	if s.scores != nil && s.scores.IsBannedAddr(n.RemoteMultiaddr()) {
+		return false
+	}
 
 	return !s.isPeerAtLimit(network.DirInbound)
 
@@ -135,7 +149,7 @@ func (s *Conngater) checkWhitAddr(addr multiaddr.Multiaddr) bool {
 // InterceptSecured tests whether a given connection, now authenticated,
 // is allowed.
 func (s *Conngater) InterceptSecured(_ network.Direction, p peer.ID, n network.ConnMultiaddrs) (allow bool) {
-	return !s.blacklist.Has(p.Pretty())
+	return !s.isBanned(p)
 }
 
 // InterceptUpgraded tests whether a fully capable connection is allowed.
@@ -143,7 +157,7 @@ func (s *Conngater) InterceptUpgraded(n network.Conn) (allow bool, reason contro
 	if n == nil {
 		return false, 0
 	}
-	return !s.blacklist.Has(n.RemotePeer().Pretty()), 0
+	return !s.isBanned(n.RemotePeer()), 0
 }
 
 func (s *Conngater) validateDial(addr multiaddr.Multiaddr) bool {
@@ -36,7 +36,7 @@ func Test_MaxLimit(t *testing.T) {
 
 	var host1 host.Host
 	CacheLimit = 0
-	gater := NewConnGater(&host1, 1, nil, nil)
+	gater := NewConnGater(&host1, 1, nil, nil, nil)
 	host1, err = libp2p.New(context.Background(),
 		libp2p.ListenAddrs(m),
 		libp2p.ConnectionGater(gater),
@@ -61,7 +61,7 @@ func Test_MaxLimit(t *testing.T) {
 
 func Test_InterceptAccept(t *testing.T) {
 	var host1 host.Host
-	gater := NewConnGater(&host1, 0, nil, nil)
+	gater := NewConnGater(&host1, 0, nil, nil, nil)
 
 	var ip = "47.97.223.101"
 	multiAddress, err := multiaddr.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%d", ip, 3000))
@@ -76,7 +76,7 @@ func Test_InterceptAccept(t *testing.T) {
 	whitePeer1, _ := multiaddr.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%d/p2p/%v", "192.168.102.123", 3001, "16Uiu2HAmK9PAPYoTzHnobzB5nQFnY7p9ZVcJYQ1BgzKCr7izAhbJ"))
 	peerInfo, err := peer.AddrInfoFromP2pAddr(whitePeer1)
 	assert.Nil(t, err)
-	gater2 := NewConnGater(&host1, 0, nil, []*peer.AddrInfo{peerInfo})
+	gater2 := NewConnGater(&host1, 0, nil, nil, []*peer.AddrInfo{peerInfo})
 	whitePeer2, _ := multiaddr.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%d", "192.168.102.123", 3002))
 	//在白名单内部，校验通过
 	assert.True(t, gater2.checkWhitAddr(whitePeer2))
@@ -87,7 +87,7 @@ func Test_InterceptAccept(t *testing.T) {
 
 func Test_InterceptAddrDial(t *testing.T) {
 	var host1 host.Host
-	gater := NewConnGater(&host1, 0, nil, nil)
+	gater := NewConnGater(&host1, 0, nil, nil, nil)
 	var ip = "47.97.223.101"
 	multiAddress, err := multiaddr.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%d", ip, 3000))
 	require.NoError(t, err)
@@ -101,7 +101,7 @@ func Test_InterceptPeerDial(t *testing.T) {
 	whitePeer1, _ := multiaddr.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%d/p2p/%v", "192.168.105.123", 3001, "16Uiu2HAmK9PAPYoTzHnobzB5nQFnY7p9ZVcJYQ1BgzKCr7izAhbJ"))
 	peerInfo, err := peer.AddrInfoFromP2pAddr(whitePeer1)
 	require.Nil(t, err)
-	gater := NewConnGater(&host1, 1, NewTimeCache(context.Background(), time.Second), nil)
+	gater := NewConnGater(&host1, 1, NewTimeCache(context.Background(), time.Second), nil, nil)
 	var pid = "16Uiu2HAmCyJhBvE1vn62MQWhhaPph1cxeU9nNZJoZQ1Pe1xASZUg"
 
 	gater.blacklist.Add(pid, 0)
@@ -113,7 +113,7 @@ func Test_InterceptPeerDial(t *testing.T) {
 	ok = gater.InterceptPeerDial(id)
 	require.True(t, ok)
 	//白名单校验
-	gater = NewConnGater(&host1, 1, NewTimeCache(context.Background(), time.Second), []*peer.AddrInfo{peerInfo})
+	gater = NewConnGater(&host1, 1, NewTimeCache(context.Background(), time.Second), nil, []*peer.AddrInfo{peerInfo})
 	ok = gater.InterceptPeerDial(id)
 	//因为ID不在白名单内部，所有会被拦截
 	require.False(t, ok)
@@ -124,7 +124,7 @@ func Test_otherInterface(t *testing.T) {
 	var host1 host.Host
 	ctx := context.Background()
 	defer ctx.Done()
-	gater := NewConnGater(&host1, 1, NewTimeCache(context.Background(), time.Second), nil)
+	gater := NewConnGater(&host1, 1, NewTimeCache(context.Background(), time.Second), nil, nil)
 	allow, _ := gater.InterceptUpgraded(nil)
 	require.True(t, !allow)
 	require.True(t, gater.InterceptSecured(network.DirInbound, "", nil))
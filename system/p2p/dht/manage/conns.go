@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/33cn/chain33/common/log/log15"
+	cmetrics "github.com/33cn/chain33/metrics"
 	p2pty "github.com/33cn/chain33/system/p2p/dht/types"
 	"github.com/33cn/chain33/types"
 	core "github.com/libp2p/go-libp2p-core"
@@ -18,6 +19,7 @@ import (
 	"github.com/libp2p/go-libp2p-core/peer"
 	kb "github.com/libp2p/go-libp2p-kbucket"
 	"github.com/multiformats/go-multiaddr"
+	go_metrics "github.com/rcrowley/go-metrics"
 )
 
 var (
@@ -117,10 +119,23 @@ func (s *ConnManager) MonitorAllPeers() {
 			s.procConnections()
 		case <-ticker3.C:
 			s.procRoutingTable()
+			s.updateMetrics()
 		}
 	}
 }
 
+//updateMetrics 更新连接节点数以及各协议累计收发字节数指标
+func (s *ConnManager) updateMetrics() {
+	go_metrics.GetOrRegisterGauge("p2p_peers", nil).Update(int64(len(s.host.Network().Peers())))
+	for id, stat := range s.bandwidthTracker.GetBandwidthByProtocol() {
+		if id == "" {
+			continue
+		}
+		go_metrics.GetOrRegisterGauge(cmetrics.Name("p2p_protocol_bytes", "protocol", string(id), "direction", "in"), nil).Update(stat.TotalIn)
+		go_metrics.GetOrRegisterGauge(cmetrics.Name("p2p_protocol_bytes", "protocol", string(id), "direction", "out"), nil).Update(stat.TotalOut)
+	}
+}
+
 func (s *ConnManager) printMonitorInfo() {
 	var LatencyInfo = fmt.Sprintln("--------------时延--------------------")
 	peers := s.FetchConnPeers()
@@ -0,0 +1,281 @@
+package manage
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	dbm "github.com/33cn/chain33/common/db"
+	p2pty "github.com/33cn/chain33/system/p2p/dht/types"
+	"github.com/33cn/chain33/types"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+	net "github.com/multiformats/go-multiaddr/net"
+)
+
+const (
+	//分数低于该值时临时禁止连接
+	scoreBanThreshold = -100
+	//负分节点每个周期恢复1分
+	scoreRecoverInterval = time.Minute
+	//首次禁止连接的时长, 之后每次翻倍
+	baseBanTime = 10 * time.Minute
+	//最长禁止连接时长, 解禁超过该时长且分数恢复后清除节点评分记录
+	maxBanTime = 24 * time.Hour
+
+	peerScoreKeyPrefix = "peer-score-"
+)
+
+//各类上报原因对应的分值
+var scoreDelta = map[string]int32{
+	p2pty.ScoreInvalidBlock:    -100,
+	p2pty.ScoreInvalidTx:       -20,
+	p2pty.ScoreDownloadTimeout: -10,
+	p2pty.ScoreInvalidChunk:    -25,
+	p2pty.ScoreVersionMismatch: -100,
+	p2pty.ScoreValidMsg:        1,
+}
+
+// PeerScoreManager 节点信誉评分, 各协议上报节点的异常行为,
+// 分数低于阈值时断开连接并临时禁止连接, 禁止时长随禁止次数指数增长
+type PeerScoreManager struct {
+	ctx       context.Context
+	host      *host.Host
+	db        dbm.DB
+	lock      sync.RWMutex
+	scores    map[string]*types.PeerScore
+	bannedIPs map[string]string //被禁止节点的ip, ip->pid
+}
+
+// NewPeerScoreManager new peer score manager, db不为nil时持久化评分
+func NewPeerScoreManager(ctx context.Context, h *host.Host, db dbm.DB) *PeerScoreManager {
+	m := &PeerScoreManager{
+		ctx:       ctx,
+		host:      h,
+		db:        db,
+		scores:    make(map[string]*types.PeerScore),
+		bannedIPs: make(map[string]string),
+	}
+	m.load()
+	go m.recover()
+	return m
+}
+
+func (m *PeerScoreManager) load() {
+	if m.db == nil {
+		return
+	}
+	values := dbm.NewListHelper(m.db).PrefixScan([]byte(peerScoreKeyPrefix))
+	for _, value := range values {
+		info := &types.PeerScore{}
+		if err := types.Decode(value, info); err != nil {
+			log.Error("PeerScoreManager load", "decode err", err)
+			continue
+		}
+		m.scores[info.PeerName] = info
+	}
+}
+
+// Report 上报节点行为, reason为p2pty中定义的Score类型
+func (m *PeerScoreManager) Report(pid peer.ID, reason string) {
+	delta, ok := scoreDelta[reason]
+	if !ok {
+		log.Error("PeerScoreManager Report", "unknown reason", reason)
+		return
+	}
+	name := pid.Pretty()
+	now := types.Now().Unix()
+	m.lock.Lock()
+	info, ok := m.scores[name]
+	//满分节点无需加分, 禁止期间的上报不再处理
+	if (!ok && delta > 0) || (ok && delta > 0 && info.Score >= 0) || (ok && info.BannedUntil > now) {
+		m.lock.Unlock()
+		return
+	}
+	if !ok {
+		info = &types.PeerScore{PeerName: name}
+		m.scores[name] = info
+	}
+	info.Score += delta
+	if info.Score > 0 {
+		info.Score = 0
+	}
+	if delta < 0 {
+		info.LastReason = reason
+	}
+	info.UpdateTime = now
+	banned := info.Score <= scoreBanThreshold
+	if banned {
+		info.BanCount++
+		banTime := maxBanTime
+		if info.BanCount <= 8 && baseBanTime<<(info.BanCount-1) < maxBanTime {
+			banTime = baseBanTime << (info.BanCount - 1)
+		}
+		info.BannedUntil = now + int64(banTime/time.Second)
+		//解禁后处于观察期, 少量错误即会再次禁止
+		info.Score = scoreBanThreshold / 2
+	}
+	m.save(info)
+	bannedUntil := info.BannedUntil
+	m.lock.Unlock()
+
+	if banned {
+		log.Info("PeerScoreManager ban peer", "pid", name, "reason", reason, "until", time.Unix(bannedUntil, 0))
+		m.closePeer(pid)
+	}
+}
+
+//断开被禁止节点的连接, 主动直连公网ip的节点同时记录ip, 禁止期间拒绝该ip的连入
+func (m *PeerScoreManager) closePeer(pid peer.ID) {
+	if m.host == nil || *m.host == nil {
+		return
+	}
+	h := *m.host
+	m.lock.Lock()
+	for _, conn := range h.Network().ConnsToPeer(pid) {
+		if ip, ok := banIP(conn.RemoteMultiaddr(), conn.Stat().Direction); ok {
+			m.bannedIPs[ip] = pid.Pretty()
+		}
+	}
+	m.lock.Unlock()
+	_ = h.Network().ClosePeer(pid)
+}
+
+//banIP 返回需要禁止的ip, 中继连接的地址是中继节点的ip, 连入的节点可能和其他节点共用NAT出口ip,
+//只禁止主动直连的公网ip, 其他情况只按节点id禁止
+func banIP(addr multiaddr.Multiaddr, dir network.Direction) (string, bool) {
+	if dir != network.DirOutbound || !net.IsPublicAddr(addr) {
+		return "", false
+	}
+	if _, err := addr.ValueForProtocol(multiaddr.P_CIRCUIT); err == nil {
+		return "", false
+	}
+	ip, err := net.ToIP(addr)
+	if err != nil {
+		return "", false
+	}
+	return ip.String(), true
+}
+
+// IsBanned 节点是否处于禁止连接期间
+func (m *PeerScoreManager) IsBanned(pid peer.ID) bool {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	info, ok := m.scores[pid.Pretty()]
+	return ok && info.BannedUntil > types.Now().Unix()
+}
+
+// IsBannedAddr 地址是否属于禁止连接期间的节点
+func (m *PeerScoreManager) IsBannedAddr(addr multiaddr.Multiaddr) bool {
+	ip, err := net.ToIP(addr)
+	if err != nil {
+		return false
+	}
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	name, ok := m.bannedIPs[ip.String()]
+	return ok && m.scores[name].GetBannedUntil() > types.Now().Unix()
+}
+
+// Score 返回节点当前分数, 没有记录的节点为0
+func (m *PeerScoreManager) Score(pid peer.ID) int32 {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.scores[pid.Pretty()].GetScore()
+}
+
+// Reset 清除节点的评分和禁止记录, 返回节点是否存在记录
+func (m *PeerScoreManager) Reset(pid peer.ID) bool {
+	name := pid.Pretty()
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	_, ok := m.scores[name]
+	if !ok {
+		return false
+	}
+	for ip, pname := range m.bannedIPs {
+		if pname == name {
+			delete(m.bannedIPs, ip)
+		}
+	}
+	delete(m.scores, name)
+	m.delete(name)
+	return true
+}
+
+// List 返回所有有记录的节点评分, 按分数从低到高排序
+func (m *PeerScoreManager) List() *types.PeerScores {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	scores := &types.PeerScores{}
+	for _, info := range m.scores {
+		scores.Scores = append(scores.Scores, types.Clone(info).(*types.PeerScore))
+	}
+	sort.Slice(scores.Scores, func(i, j int) bool {
+		if scores.Scores[i].Score == scores.Scores[j].Score {
+			return scores.Scores[i].PeerName < scores.Scores[j].PeerName
+		}
+		return scores.Scores[i].Score < scores.Scores[j].Score
+	})
+	return scores
+}
+
+//定期恢复负分节点的分数, 清除已恢复的节点记录
+func (m *PeerScoreManager) recover() {
+	ticker := time.NewTicker(scoreRecoverInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-ticker.C:
+			m.recoverScores(types.Now().Unix())
+		}
+	}
+}
+
+func (m *PeerScoreManager) recoverScores(now int64) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for ip, name := range m.bannedIPs {
+		if m.scores[name].GetBannedUntil() <= now {
+			delete(m.bannedIPs, ip)
+		}
+	}
+	for name, info := range m.scores {
+		if info.BannedUntil > now {
+			continue
+		}
+		if info.Score < 0 {
+			info.Score++
+			info.UpdateTime = now
+			m.save(info)
+			continue
+		}
+		if info.BannedUntil+int64(maxBanTime/time.Second) <= now {
+			delete(m.scores, name)
+			m.delete(name)
+		}
+	}
+}
+
+func (m *PeerScoreManager) save(info *types.PeerScore) {
+	if m.db == nil {
+		return
+	}
+	if err := m.db.Set([]byte(peerScoreKeyPrefix+info.PeerName), types.Encode(info)); err != nil {
+		log.Error("PeerScoreManager save", "pid", info.PeerName, "err", err)
+	}
+}
+
+func (m *PeerScoreManager) delete(name string) {
+	if m.db == nil {
+		return
+	}
+	if err := m.db.Delete([]byte(peerScoreKeyPrefix + name)); err != nil {
+		log.Error("PeerScoreManager delete", "pid", name, "err", err)
+	}
+}
@@ -0,0 +1,167 @@
+package manage
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	dbm "github.com/33cn/chain33/common/db"
+	p2pty "github.com/33cn/chain33/system/p2p/dht/types"
+	"github.com/33cn/chain33/types"
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerScoreManager(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	db := dbm.NewDB("peerscore", "memdb", "", 0)
+	m := NewPeerScoreManager(ctx, nil, db)
+	pid := peer.ID("testpid")
+
+	//满分节点加分无效
+	m.Report(pid, p2pty.ScoreValidMsg)
+	require.Equal(t, 0, len(m.List().Scores))
+	m.Report(pid, "unknown")
+	require.Equal(t, 0, len(m.List().Scores))
+
+	m.Report(pid, p2pty.ScoreInvalidTx)
+	require.Equal(t, int32(-20), m.Score(pid))
+	m.Report(pid, p2pty.ScoreValidMsg)
+	require.Equal(t, int32(-19), m.Score(pid))
+	require.False(t, m.IsBanned(pid))
+
+	//累计扣分达到阈值后禁止
+	m.Report(pid, p2pty.ScoreDownloadTimeout)
+	m.Report(pid, p2pty.ScoreDownloadTimeout)
+	m.Report(pid, p2pty.ScoreInvalidChunk)
+	m.Report(pid, p2pty.ScoreInvalidChunk)
+	require.False(t, m.IsBanned(pid))
+	m.Report(pid, p2pty.ScoreInvalidTx)
+	require.True(t, m.IsBanned(pid))
+	info := m.List().Scores[0]
+	require.Equal(t, int32(1), info.BanCount)
+	require.Equal(t, int32(scoreBanThreshold/2), info.Score)
+	require.Equal(t, p2pty.ScoreInvalidTx, info.LastReason)
+	require.Equal(t, int64(baseBanTime/time.Second), info.BannedUntil-info.UpdateTime)
+	//禁止期间的上报不处理
+	m.Report(pid, p2pty.ScoreInvalidBlock)
+	require.Equal(t, int32(1), m.List().Scores[0].BanCount)
+
+	//持久化
+	m2 := NewPeerScoreManager(ctx, nil, db)
+	require.True(t, m2.IsBanned(pid))
+	require.Equal(t, info.String(), m2.List().Scores[0].String())
+
+	//解禁后逐步恢复分数, 再次禁止的时长翻倍
+	expire := func() int64 {
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		m.scores[pid.Pretty()].BannedUntil = types.Now().Unix()
+		return types.Now().Unix()
+	}
+	now := expire()
+	require.False(t, m.IsBanned(pid))
+	m.recoverScores(now)
+	require.Equal(t, int32(scoreBanThreshold/2+1), m.Score(pid))
+	m.Report(pid, p2pty.ScoreVersionMismatch)
+	info = m.List().Scores[0]
+	require.Equal(t, int32(2), info.BanCount)
+	require.Equal(t, int64(2*baseBanTime/time.Second), info.BannedUntil-info.UpdateTime)
+
+	//分数恢复且解禁超过最长禁止时长后清除记录
+	now = expire()
+	for i := 0; i < -scoreBanThreshold/2; i++ {
+		m.recoverScores(now)
+	}
+	require.Equal(t, int32(0), m.Score(pid))
+	require.Equal(t, 1, len(m.List().Scores))
+	m.recoverScores(now + int64(maxBanTime/time.Second))
+	require.Equal(t, 0, len(m.List().Scores))
+	require.Equal(t, 0, len(NewPeerScoreManager(ctx, nil, db).List().Scores))
+
+	m.Report(pid, p2pty.ScoreInvalidBlock)
+	require.True(t, m.IsBanned(pid))
+	require.True(t, m.Reset(pid))
+	require.False(t, m.IsBanned(pid))
+	require.False(t, m.Reset(pid))
+}
+
+func TestPeerScoreGater(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	m, err := multiaddr.NewMultiaddr(fmt.Sprintf("/ip4/127.0.0.1/tcp/%d", 12355))
+	require.Nil(t, err)
+	var host1 host.Host
+	scores := NewPeerScoreManager(ctx, &host1, nil)
+	host1, err = libp2p.New(ctx,
+		libp2p.ListenAddrs(m),
+		libp2p.ConnectionGater(NewConnGater(&host1, 0, nil, scores, nil)),
+	)
+	require.Nil(t, err)
+	defer host1.Close()
+
+	host2, err := newTestHost(12356)
+	require.Nil(t, err)
+	defer host2.Close()
+	h1info := peer.AddrInfo{ID: host1.ID(), Addrs: host1.Addrs()}
+	require.Nil(t, host2.Connect(ctx, h1info))
+
+	//禁止后断开连接, 拒绝该节点的连入, 连入的本地地址不禁止ip
+	scores.Report(host2.ID(), p2pty.ScoreInvalidBlock)
+	require.True(t, scores.IsBanned(host2.ID()))
+	require.Equal(t, 0, len(host1.Network().ConnsToPeer(host2.ID())))
+	require.False(t, scores.IsBannedAddr(host2.Addrs()[0]))
+	for host2.Network().Connectedness(host1.ID()) == network.Connected {
+		time.Sleep(time.Millisecond * 10)
+	}
+	require.NotNil(t, host2.Connect(ctx, h1info))
+	require.NotNil(t, host1.Connect(ctx, peer.AddrInfo{ID: host2.ID(), Addrs: host2.Addrs()}))
+
+	list := scores.List()
+	require.Equal(t, 1, len(list.Scores))
+	require.Equal(t, host2.ID().Pretty(), list.Scores[0].PeerName)
+	require.InDelta(t, types.Now().Unix()+int64(baseBanTime/time.Second), list.Scores[0].BannedUntil, 2)
+
+	scores.Reset(host2.ID())
+	require.Nil(t, host1.Connect(ctx, peer.AddrInfo{ID: host2.ID(), Addrs: host2.Addrs()}))
+}
+
+func TestPeerScoreBanIP(t *testing.T) {
+	pid := "16Uiu2HAmHffWU9fXzNUG3hiCCgpdj8Y9q1BwbbK7ZBsxSsnaDXk3"
+	addr := func(s string) multiaddr.Multiaddr {
+		m, err := multiaddr.NewMultiaddr(s)
+		require.Nil(t, err)
+		return m
+	}
+	//主动直连的公网ip
+	ip, ok := banIP(addr("/ip4/8.8.8.8/tcp/13803"), network.DirOutbound)
+	require.True(t, ok)
+	require.Equal(t, "8.8.8.8", ip)
+	//连入的节点可能共用NAT出口ip
+	_, ok = banIP(addr("/ip4/8.8.8.8/tcp/13803"), network.DirInbound)
+	require.False(t, ok)
+	//内网ip
+	_, ok = banIP(addr("/ip4/192.168.1.2/tcp/13803"), network.DirOutbound)
+	require.False(t, ok)
+	//通过中继连接时地址中的ip属于中继节点
+	_, ok = banIP(addr("/ip4/8.8.8.8/tcp/13803/p2p/"+pid+"/p2p-circuit"), network.DirOutbound)
+	require.False(t, ok)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	scores := NewPeerScoreManager(ctx, nil, nil)
+	banned, err := peer.Decode(pid)
+	require.Nil(t, err)
+	scores.Report(banned, p2pty.ScoreInvalidBlock)
+	scores.bannedIPs[ip] = banned.Pretty()
+	require.True(t, scores.IsBannedAddr(addr("/ip4/8.8.8.8/tcp/13804")))
+	require.False(t, scores.IsBannedAddr(addr("/ip4/8.8.4.4/tcp/13803")))
+	scores.Reset(banned)
+	require.False(t, scores.IsBannedAddr(addr("/ip4/8.8.8.8/tcp/13804")))
+}
@@ -55,6 +55,7 @@ type P2P struct {
 	connManager     *manage.ConnManager
 	peerInfoManager *manage.PeerInfoManager
 	blackCache      *manage.TimeCache
+	peerScores      *manage.PeerScoreManager
 	api             client.QueueProtocolAPI
 	client          queue.Client
 	addrBook        *AddrBook
@@ -152,7 +153,11 @@ func initP2P(p *P2P) *P2P {
 	log.Info("NewMulti", "addr", maddr.String())
 
 	bandwidthTracker := metrics.NewBandwidthCounter()
+	protocol.SetBandwidthLimiter(protocol.NewBandwidthLimiter(p.ctx, &p.subCfg.Bandwidth, bandwidthTracker))
 	p.blackCache = manage.NewTimeCache(p.ctx, time.Minute*5)
+	//节点评分需要持久化，先于host创建db
+	p.db = newDB("", p.p2pCfg.Driver, filepath.Dir(p.p2pCfg.DbPath), p.subCfg.DHTDataCache)
+	p.peerScores = manage.NewPeerScoreManager(p.ctx, &p.host, p.db)
 	options := p.buildHostOptions(p.addrBook.GetPrivkey(), bandwidthTracker, maddr, p.blackCache)
 	host, err := libp2p.New(p.ctx, options...)
 	if err != nil {
@@ -169,8 +174,6 @@ func initP2P(p *P2P) *P2P {
 	p.connManager = manage.NewConnManager(p.ctx, p.host, p.discovery.RoutingTable(), bandwidthTracker, p.subCfg)
 	p.peerInfoManager = manage.NewPeerInfoManager(p.ctx, p.host, p.client)
 	p.taskGroup = &sync.WaitGroup{}
-
-	p.db = newDB("", p.p2pCfg.Driver, filepath.Dir(p.p2pCfg.DbPath), p.subCfg.DHTDataCache)
 	return p
 }
 
@@ -200,6 +203,7 @@ func (p *P2P) StartP2P() {
 		PeerInfoManager: p.peerInfoManager,
 		ConnManager:     p.connManager,
 		ConnBlackList:   p.blackCache,
+		PeerScores:      p.peerScores,
 	}
 	p.env = env
 	protocol.InitAllProtocol(env)
@@ -292,7 +296,7 @@ func (p *P2P) buildHostOptions(priv crypto.PrivKey, bandwidthTracker metrics.Rep
 
 	}
 	//ConnectionGater,处理网络连接的策略
-	options = append(options, libp2p.ConnectionGater(manage.NewConnGater(&p.host, p.subCfg.MaxConnectNum, timeCache, genAddrInfos(p.subCfg.WhitePeerList))))
+	options = append(options, libp2p.ConnectionGater(manage.NewConnGater(&p.host, p.subCfg.MaxConnectNum, timeCache, p.peerScores, genAddrInfos(p.subCfg.WhitePeerList))))
 	//关闭ping
 	options = append(options, libp2p.Ping(false))
 	return options
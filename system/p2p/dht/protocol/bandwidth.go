@@ -0,0 +1,286 @@
+package protocol
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	types2 "github.com/33cn/chain33/system/p2p/dht/types"
+	"github.com/33cn/chain33/types"
+	"github.com/libp2p/go-libp2p-core/metrics"
+	"github.com/libp2p/go-libp2p-core/protocol"
+)
+
+// 协议带宽优先级, 数值越小优先级越高, 未注册的协议默认为最高优先级
+const (
+	PriorityBroadcast = iota
+	PriorityTxRelay
+	PriorityDownload
+	PriorityStore
+)
+
+//全局带宽中为更高优先级保留的比例, 低优先级协议只能在剩余带宽高于保留比例时使用
+var priorityReserve = [...]float64{
+	PriorityBroadcast: 0,
+	PriorityTxRelay:   0.1,
+	PriorityDownload:  0.3,
+	PriorityStore:     0.5,
+}
+
+const (
+	//单次等待的最长时间, 避免长时间持有调度
+	maxThrottleSleep = 100 * time.Millisecond
+)
+
+var (
+	priorities   = make(map[protocol.ID]int)
+	priorityLock sync.RWMutex
+
+	bandwidthLimiter atomic.Value
+)
+
+// RegisterProtocolPriority 注册协议的带宽优先级
+func RegisterProtocolPriority(id protocol.ID, priority int) {
+	if priority < PriorityBroadcast || priority > PriorityStore {
+		panic("RegisterProtocolPriority, invalid priority")
+	}
+	priorityLock.Lock()
+	defer priorityLock.Unlock()
+	priorities[id] = priority
+}
+
+func protocolPriority(id protocol.ID) int {
+	priorityLock.RLock()
+	defer priorityLock.RUnlock()
+	return priorities[id]
+}
+
+// SetBandwidthLimiter 设置stream读写使用的带宽限制, nil表示不限制
+func SetBandwidthLimiter(l *BandwidthLimiter) {
+	bandwidthLimiter.Store(l)
+}
+
+// GetBandwidthLimiter 获取当前的带宽限制
+func GetBandwidthLimiter() *BandwidthLimiter {
+	l, _ := bandwidthLimiter.Load().(*BandwidthLimiter)
+	return l
+}
+
+//bandwidthBucket 令牌桶, 容量为1秒的流量, 允许单个消息透支, 透支部分需要等待补充
+type bandwidthBucket struct {
+	lock     sync.Mutex
+	rate     float64
+	tokens   float64
+	last     time.Time
+	throttle int64
+}
+
+func newBandwidthBucket(kbps int64) *bandwidthBucket {
+	rate := float64(kbps * 1024)
+	return &bandwidthBucket{rate: rate, tokens: rate, last: time.Now()}
+}
+
+func (b *bandwidthBucket) refill(now time.Time) {
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.rate {
+		b.tokens = b.rate
+	}
+	b.last = now
+}
+
+//wait 扣除n个令牌后剩余令牌不低于保留比例时直接扣除, 否则等待令牌补充,
+//超过桶容量的大消息在桶满时透支
+func (b *bandwidthBucket) wait(n int, reserve float64) {
+	if b == nil || b.rate <= 0 {
+		return
+	}
+	start := time.Now()
+	for {
+		b.lock.Lock()
+		b.refill(time.Now())
+		threshold := b.rate * reserve
+		if need := float64(n); need < b.rate-threshold {
+			threshold += need
+		} else {
+			threshold = b.rate
+		}
+		if b.tokens >= threshold {
+			b.tokens -= float64(n)
+			b.lock.Unlock()
+			break
+		}
+		sleep := time.Duration((threshold - b.tokens) / b.rate * float64(time.Second))
+		b.lock.Unlock()
+		if sleep > maxThrottleSleep {
+			sleep = maxThrottleSleep
+		}
+		time.Sleep(sleep + time.Millisecond)
+	}
+	if waited := time.Since(start); waited > time.Millisecond {
+		atomic.AddInt64(&b.throttle, int64(waited))
+	}
+}
+
+//consume 扣除不经过stream读写的流量, 如pubsub广播, 不等待
+func (b *bandwidthBucket) consume(n int64) {
+	if b == nil || b.rate <= 0 || n <= 0 {
+		return
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.refill(time.Now())
+	b.tokens -= float64(n)
+	//最多透支1秒的流量, 避免长时间阻塞低优先级协议
+	if b.tokens < -b.rate {
+		b.tokens = -b.rate
+	}
+}
+
+func (b *bandwidthBucket) limit() int64 {
+	if b == nil {
+		return 0
+	}
+	return int64(b.rate)
+}
+
+func (b *bandwidthBucket) throttled() int64 {
+	if b == nil {
+		return 0
+	}
+	return atomic.LoadInt64(&b.throttle) / int64(time.Millisecond)
+}
+
+type protocolBandwidth struct {
+	in, out *bandwidthBucket
+}
+
+// BandwidthLimiter 全局和各协议的带宽限制
+type BandwidthLimiter struct {
+	in, out   *bandwidthBucket
+	protocols map[protocol.ID]*protocolBandwidth
+	reporter  metrics.Reporter
+	//经过stream读写限制的协议, 其他协议的流量定期从全局带宽中扣除
+	wrapped   sync.Map
+	lastStats map[protocol.ID]metrics.Stats
+}
+
+// NewBandwidthLimiter new bandwidth limiter, reporter用于统计实时带宽和扣除未经过stream读写的流量
+func NewBandwidthLimiter(ctx context.Context, cfg *types2.BandwidthConfig, reporter metrics.Reporter) *BandwidthLimiter {
+	l := &BandwidthLimiter{
+		in:        newBandwidthBucket(cfg.MaxIn),
+		out:       newBandwidthBucket(cfg.MaxOut),
+		protocols: make(map[protocol.ID]*protocolBandwidth),
+		reporter:  reporter,
+		lastStats: make(map[protocol.ID]metrics.Stats),
+	}
+	for _, p := range cfg.Protocols {
+		l.protocols[protocol.ID(p.Protocol)] = &protocolBandwidth{
+			in:  newBandwidthBucket(p.MaxIn),
+			out: newBandwidthBucket(p.MaxOut),
+		}
+	}
+	if reporter != nil && (cfg.MaxIn > 0 || cfg.MaxOut > 0) {
+		go l.consumeUnwrapped(ctx)
+	}
+	return l
+}
+
+// WaitIn 接收n字节数据后等待带宽
+func (l *BandwidthLimiter) WaitIn(id protocol.ID, n int) {
+	if l == nil {
+		return
+	}
+	l.wrapped.Store(id, struct{}{})
+	if p, ok := l.protocols[id]; ok {
+		p.in.wait(n, 0)
+	}
+	l.in.wait(n, priorityReserve[protocolPriority(id)])
+}
+
+// WaitOut 发送n字节数据前等待带宽
+func (l *BandwidthLimiter) WaitOut(id protocol.ID, n int) {
+	if l == nil {
+		return
+	}
+	l.wrapped.Store(id, struct{}{})
+	if p, ok := l.protocols[id]; ok {
+		p.out.wait(n, 0)
+	}
+	l.out.wait(n, priorityReserve[protocolPriority(id)])
+}
+
+//consumeUnwrapped 定期从全局带宽中扣除pubsub等不经过stream读写的流量
+func (l *BandwidthLimiter) consumeUnwrapped(ctx context.Context) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for id, stat := range l.reporter.GetBandwidthByProtocol() {
+				if _, ok := l.wrapped.Load(id); ok || id == "" {
+					continue
+				}
+				last, ok := l.lastStats[id]
+				l.lastStats[id] = stat
+				if !ok {
+					continue
+				}
+				l.in.consume(stat.TotalIn - last.TotalIn)
+				l.out.consume(stat.TotalOut - last.TotalOut)
+			}
+		}
+	}
+}
+
+// Usage 返回全局和各协议的带宽使用情况, 协议按照优先级排序
+func (l *BandwidthLimiter) Usage() (*types.BandwidthUsage, []*types.BandwidthUsage) {
+	if l == nil {
+		return nil, nil
+	}
+	total := &types.BandwidthUsage{
+		LimitIn:     l.in.limit(),
+		LimitOut:    l.out.limit(),
+		ThrottleIn:  l.in.throttled(),
+		ThrottleOut: l.out.throttled(),
+	}
+	usages := make(map[protocol.ID]*types.BandwidthUsage)
+	getUsage := func(id protocol.ID) *types.BandwidthUsage {
+		usage, ok := usages[id]
+		if !ok {
+			usage = &types.BandwidthUsage{Protocol: string(id), Priority: int32(protocolPriority(id))}
+			usages[id] = usage
+		}
+		return usage
+	}
+	if l.reporter != nil {
+		stat := l.reporter.GetBandwidthTotals()
+		total.RateIn, total.RateOut = int64(stat.RateIn), int64(stat.RateOut)
+		for id, stat := range l.reporter.GetBandwidthByProtocol() {
+			if id == "" || stat.RateIn+stat.RateOut < 1 {
+				continue
+			}
+			usage := getUsage(id)
+			usage.RateIn, usage.RateOut = int64(stat.RateIn), int64(stat.RateOut)
+		}
+	}
+	for id, p := range l.protocols {
+		usage := getUsage(id)
+		usage.LimitIn, usage.LimitOut = p.in.limit(), p.out.limit()
+		usage.ThrottleIn, usage.ThrottleOut = p.in.throttled(), p.out.throttled()
+	}
+	list := make([]*types.BandwidthUsage, 0, len(usages))
+	for _, usage := range usages {
+		list = append(list, usage)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Priority == list[j].Priority {
+			return list[i].Protocol < list[j].Protocol
+		}
+		return list[i].Priority < list[j].Priority
+	})
+	return total, list
+}
@@ -0,0 +1,94 @@
+package protocol
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types2 "github.com/33cn/chain33/system/p2p/dht/types"
+	"github.com/libp2p/go-libp2p-core/metrics"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBandwidthBucket(t *testing.T) {
+
+	var nilBucket *bandwidthBucket
+	nilBucket.wait(1024, 0)
+	nilBucket.consume(1024)
+	require.Equal(t, int64(0), nilBucket.limit())
+
+	b := newBandwidthBucket(10)
+	require.Equal(t, int64(10*1024), b.limit())
+	//保留比例以内的令牌低优先级不能使用
+	b.wait(5*1024, 0.5)
+	start := time.Now()
+	b.wait(1024, 0.5)
+	require.True(t, time.Since(start) >= 50*time.Millisecond)
+	require.True(t, b.throttled() > 0)
+
+	//高优先级可以使用全部令牌
+	b = newBandwidthBucket(10)
+	start = time.Now()
+	b.wait(10*1024, 0)
+	require.True(t, time.Since(start) < 50*time.Millisecond)
+
+	//超过桶容量的消息在桶满时透支
+	b = newBandwidthBucket(1)
+	b.wait(4096, 0.5)
+	require.True(t, b.tokens < 0)
+
+	b = newBandwidthBucket(1)
+	b.consume(10 * 1024)
+	require.Equal(t, -b.rate, b.tokens)
+}
+
+func TestBandwidthLimiter(t *testing.T) {
+
+	var nilLimiter *BandwidthLimiter
+	nilLimiter.WaitIn("test", 1024)
+	nilLimiter.WaitOut("test", 1024)
+	total, list := nilLimiter.Usage()
+	require.Nil(t, total)
+	require.Nil(t, list)
+
+	RegisterProtocolPriority("/test/store", PriorityStore)
+	RegisterProtocolPriority("/test/download", PriorityDownload)
+	require.Equal(t, PriorityStore, protocolPriority("/test/store"))
+	require.Equal(t, PriorityBroadcast, protocolPriority("/test/unknown"))
+	require.Panics(t, func() { RegisterProtocolPriority("/test/invalid", PriorityStore+1) })
+
+	reporter := metrics.NewBandwidthCounter()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	l := NewBandwidthLimiter(ctx, &types2.BandwidthConfig{
+		MaxIn:  100,
+		MaxOut: 100,
+		Protocols: []*types2.ProtocolBandwidthConfig{
+			{Protocol: "/test/store", MaxOut: 10},
+		},
+	}, reporter)
+	SetBandwidthLimiter(l)
+	defer SetBandwidthLimiter(nil)
+	require.Equal(t, l, GetBandwidthLimiter())
+
+	l.WaitIn("/test/download", 1024)
+	l.WaitOut("/test/store", 10*1024)
+	start := time.Now()
+	l.WaitOut("/test/store", 1024)
+	require.True(t, time.Since(start) >= 50*time.Millisecond)
+	_, ok := l.wrapped.Load(protocol.ID("/test/store"))
+	require.True(t, ok)
+
+	reporter.LogSentMessageStream(1024, "/test/download", "")
+	total, list = l.Usage()
+	require.Equal(t, int64(100*1024), total.LimitIn)
+	require.Equal(t, int64(100*1024), total.LimitOut)
+	require.True(t, len(list) >= 1)
+	last := list[len(list)-1]
+	require.Equal(t, "/test/store", last.Protocol)
+	require.Equal(t, int32(PriorityStore), last.Priority)
+	require.Equal(t, int64(10*1024), last.LimitOut)
+	require.Equal(t, int64(0), last.LimitIn)
+	require.True(t, last.ThrottleOut > 0)
+}
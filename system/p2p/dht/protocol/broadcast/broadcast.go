@@ -42,6 +42,7 @@ type broadcastProtocol struct {
 	lock        sync.RWMutex
 	ltB         *ltBroadcast
 	val         *validator
+	txRecon     *txReconciler
 }
 
 // InitProtocol init protocol
@@ -73,6 +74,9 @@ func (p *broadcastProtocol) init(env *protocol.P2PEnv) {
 	if !p.cfg.DisableBatchTx {
 		go p.handleSendBatchTx(p.ps.Sub(psBatchTxTopic))
 	}
+	if p.cfg.EnableTxReconcile {
+		p.txRecon = initTxReconciler(p)
+	}
 }
 
 func (p *broadcastProtocol) getSyncStatus() bool {
@@ -118,11 +122,24 @@ func (p *broadcastProtocol) handleBroadcastSend(msg *queue.Message) {
 
 	var topic, hash string
 	var filter *utils.Filterdata
+	// 未开启集合协调或者存在不支持协调的节点时, 交易通过pubsub广播
+	flood := true
 	broadcastData := msg.GetData().(types.Message)
 	if tx, ok := broadcastData.(*types.Transaction); ok {
-		hash = hex.EncodeToString(tx.Hash())
+		txHash := tx.Hash()
+		hash = hex.EncodeToString(txHash)
 		filter = p.txFilter
 		topic = psTxTopic
+		if p.txRecon != nil {
+			flood = p.txRecon.needFlood()
+		}
+		// mempool接收的交易加入集合协调, 交易来源节点在接收时记录在过滤器中,
+		// 通过pubsub广播的交易已经发送给所有订阅节点, 不再重复加入集合协调
+		if !flood {
+			val, _ := filter.Get(hash)
+			from, _ := val.(peer.ID)
+			p.txRecon.addTx(types.CalcTxShortHash(txHash), from)
+		}
 	} else if block, ok := broadcastData.(*types.Block); ok {
 		hash = hex.EncodeToString(block.Hash(p.ChainCfg))
 		filter = p.blockFilter
@@ -146,6 +163,10 @@ func (p *broadcastProtocol) handleBroadcastSend(msg *queue.Message) {
 		return
 	}
 	filter.Add(hash, struct{}{})
+	// 所有订阅交易的节点均支持集合协调时, 不再通过pubsub广播交易
+	if topic == psTxTopic && !flood {
+		return
+	}
 	// 交易批量广播单独处理
 	if topic == psTxTopic && !p.cfg.DisableBatchTx {
 		p.ps.Pub(broadcastData, psBatchTxTopic)
@@ -287,7 +308,7 @@ func (p *broadcastProtocol) postBlockChain(blockHash, receiveFrom string, block
 
 func (p *broadcastProtocol) postMempool(txHash string, tx *types.Transaction, publisher peer.ID) error {
 	msg, err := p.P2PManager.PubBroadCast(txHash, tx, types.EventTx)
-	if err == nil {
+	if err == nil && p.val != nil {
 		p.val.addBroadcastMsg(&broadcastMsg{msg: msg, publisher: publisher, hash: txHash})
 	}
 	return err
@@ -316,4 +337,8 @@ func (p *broadcastProtocol) setDefaultConfig() {
 	if p.cfg.MaxBatchTxInterval <= 0 {
 		p.cfg.MaxBatchTxInterval = defaultMaxBatchTxInterval
 	}
+
+	if p.cfg.TxReconcileInterval <= 0 {
+		p.cfg.TxReconcileInterval = defaultTxReconcileInterval
+	}
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	net "github.com/33cn/chain33/system/p2p/dht/extension"
+	"github.com/33cn/chain33/system/p2p/dht/manage"
 	"github.com/libp2p/go-libp2p"
 	core "github.com/libp2p/go-libp2p-core"
 	"github.com/libp2p/go-libp2p-core/crypto"
@@ -72,6 +73,7 @@ func newTestEnv(q queue.Queue) (*prototypes.P2PEnv, context.CancelFunc) {
 		P2PManager:      mgr,
 		SubConfig:       subCfg,
 		Ctx:             context.Background(),
+		PeerScores:      manage.NewPeerScoreManager(context.Background(), nil, nil),
 	}
 	ctx, cancel := context.WithCancel(context.Background())
 	env.Ctx = ctx
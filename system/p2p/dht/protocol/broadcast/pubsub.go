@@ -97,6 +97,8 @@ func (p *pubSub) handlePubMsg(out chan interface{}) {
 			err = p.Pubsub.Publish(psMsg.topic, raw)
 			if err != nil {
 				log.Error("handlePubMsg", "topic", psMsg.topic, "publish err", err)
+			} else if psMsg.topic == psTxTopic || psMsg.topic == psBatchTxTopic {
+				floodBytesOut.Inc(int64(len(raw)))
 			}
 
 		case <-p.Ctx.Done():
@@ -5,8 +5,10 @@
 package broadcast
 
 import (
+	"context"
 	"testing"
 
+	"github.com/33cn/chain33/system/p2p/dht/manage"
 	prototypes "github.com/33cn/chain33/system/p2p/dht/protocol"
 	"github.com/33cn/chain33/types"
 	"github.com/33cn/chain33/util"
@@ -26,7 +28,7 @@ func testRecvMsg(p *pubSub, topic string, data []byte, buf *[]byte) (types.Messa
 
 func newTestPubSub() *pubSub {
 	p := &pubSub{broadcastProtocol: &broadcastProtocol{}}
-	p.P2PEnv = &prototypes.P2PEnv{}
+	p.P2PEnv = &prototypes.P2PEnv{PeerScores: manage.NewPeerScoreManager(context.Background(), nil, nil)}
 	p.ChainCfg = testnode.GetDefaultConfig()
 
 	return p
@@ -0,0 +1,399 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package broadcast
+
+import (
+	"context"
+	"encoding/hex"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	cmetrics "github.com/33cn/chain33/metrics"
+	"github.com/33cn/chain33/system/p2p/dht/protocol"
+	"github.com/33cn/chain33/types"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	go_metrics "github.com/rcrowley/go-metrics"
+)
+
+const (
+	txReconcileID = "/chain33/tx-reconcile/1.0.0"
+	//默认交易集合协调间隔, 单位毫秒
+	defaultTxReconcileInterval = 1000
+	//单个节点待协调的最大交易数量
+	maxReconcileSetSize = txRecvFilterCacheNum
+	//概要单元和交易短哈希编码后的大约字节数, 用于选择发送概要还是完整的短哈希列表
+	sketchCellBytes = 20
+	shortHashBytes  = 12
+)
+
+//交易广播流量统计, 用于比较pubsub广播和集合协调两种方式的带宽消耗
+var (
+	floodBytesIn      = go_metrics.GetOrRegisterCounter(cmetrics.Name("p2p_tx_relay_bytes", "mode", "flood", "direction", "in"), nil)
+	floodBytesOut     = go_metrics.GetOrRegisterCounter(cmetrics.Name("p2p_tx_relay_bytes", "mode", "flood", "direction", "out"), nil)
+	reconcileBytesIn  = go_metrics.GetOrRegisterCounter(cmetrics.Name("p2p_tx_relay_bytes", "mode", "reconcile", "direction", "in"), nil)
+	reconcileBytesOut = go_metrics.GetOrRegisterCounter(cmetrics.Name("p2p_tx_relay_bytes", "mode", "reconcile", "direction", "out"), nil)
+)
+
+// txReconciler 交易集合协调广播, 参考Erlay
+// 对每个支持协调的节点记录待同步的交易短哈希, 定期和对方交换集合概要,
+// 只传输双方集合的差异部分, 不支持协调的节点仍然通过pubsub广播交易
+type txReconciler struct {
+	*broadcastProtocol
+	lock  sync.RWMutex
+	peers map[peer.ID]*reconcilePeer
+}
+
+type reconcilePeer struct {
+	//待协调的交易短哈希
+	set map[string]struct{}
+	//是否正在协调, 同一节点同时只进行一次协调
+	busy int32
+}
+
+func initTxReconciler(b *broadcastProtocol) *txReconciler {
+	r := &txReconciler{broadcastProtocol: b, peers: make(map[peer.ID]*reconcilePeer)}
+	protocol.RegisterStreamHandler(r.Host, txReconcileID, r.handleStreamReconcile)
+	protocol.RegisterProtocolPriority(txReconcileID, protocol.PriorityTxRelay)
+	go r.reconcileLoop()
+	return r
+}
+
+func (r *txReconciler) supportReconcile(pid peer.ID) bool {
+	protocols, err := r.Host.Peerstore().SupportsProtocols(pid, txReconcileID)
+	return err == nil && len(protocols) > 0
+}
+
+//refreshPeers 更新支持协调的连接节点, 清除已断开节点的记录
+func (r *txReconciler) refreshPeers() []peer.ID {
+	connected := make(map[peer.ID]bool)
+	for _, pid := range r.Host.Network().Peers() {
+		if r.supportReconcile(pid) {
+			connected[pid] = true
+		}
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for pid := range r.peers {
+		if !connected[pid] {
+			delete(r.peers, pid)
+		}
+	}
+	pids := make([]peer.ID, 0, len(connected))
+	for pid := range connected {
+		if _, ok := r.peers[pid]; !ok {
+			r.peers[pid] = &reconcilePeer{set: make(map[string]struct{})}
+		}
+		pids = append(pids, pid)
+	}
+	return pids
+}
+
+// addTx 新交易加入除来源节点外所有节点的待协调集合
+func (r *txReconciler) addTx(shortHash string, from peer.ID) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for pid, info := range r.peers {
+		if pid == from || len(info.set) >= maxReconcileSetSize {
+			continue
+		}
+		info.set[shortHash] = struct{}{}
+	}
+}
+
+// needFlood 订阅交易的节点中存在不支持协调的节点时, 仍需要通过pubsub广播
+func (r *txReconciler) needFlood() bool {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	if len(r.peers) == 0 {
+		return true
+	}
+	for _, topic := range []string{psTxTopic, psBatchTxTopic} {
+		for _, pid := range r.Pubsub.FetchTopicPeers(topic) {
+			if _, ok := r.peers[pid]; !ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+//snapshot 获取节点当前的待协调集合, 节点不支持协调时返回false
+func (r *txReconciler) snapshot(pid peer.ID) ([]string, bool) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	info, ok := r.peers[pid]
+	if !ok {
+		return nil, false
+	}
+	hashes := make([]string, 0, len(info.set))
+	for hash := range info.set {
+		hashes = append(hashes, hash)
+	}
+	return hashes, true
+}
+
+//remove 协调完成后移除已同步的交易, 协调期间新加入的交易留到下一次协调
+func (r *txReconciler) remove(pid peer.ID, hashes []string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	info, ok := r.peers[pid]
+	if !ok {
+		return
+	}
+	for _, hash := range hashes {
+		delete(info.set, hash)
+	}
+}
+
+func (r *txReconciler) acquire(pid peer.ID) bool {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	info, ok := r.peers[pid]
+	return ok && atomic.CompareAndSwapInt32(&info.busy, 0, 1)
+}
+
+func (r *txReconciler) release(pid peer.ID) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	if info, ok := r.peers[pid]; ok {
+		atomic.StoreInt32(&info.busy, 0)
+	}
+}
+
+func (r *txReconciler) reconcileLoop() {
+	ticker := time.NewTicker(time.Duration(r.cfg.TxReconcileInterval) * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.Ctx.Done():
+			return
+		case <-ticker.C:
+			for _, pid := range r.refreshPeers() {
+				//由节点ID较小的一方发起协调
+				if r.Host.ID() < pid {
+					go r.reconcile(pid)
+				}
+			}
+		}
+	}
+}
+
+// reconcile 发起交易集合协调
+func (r *txReconciler) reconcile(pid peer.ID) {
+	if !r.acquire(pid) {
+		return
+	}
+	defer r.release(pid)
+	local, ok := r.snapshot(pid)
+	if !ok {
+		return
+	}
+	ctx, cancel := context.WithTimeout(r.Ctx, time.Second*10)
+	defer cancel()
+	stream, err := r.Host.NewStream(ctx, pid, txReconcileID)
+	if err != nil {
+		log.Error("reconcile", "pid", pid, "new stream err", err)
+		return
+	}
+	defer protocol.CloseStream(stream)
+	_ = stream.SetDeadline(time.Now().Add(time.Second * 30))
+	if err = r.initiate(stream, local); err != nil {
+		log.Error("reconcile", "pid", pid, "err", err)
+		return
+	}
+	r.remove(pid, local)
+}
+
+func (r *txReconciler) initiate(stream network.Stream, local []string) error {
+	pid := stream.Conn().RemotePeer()
+	err := writeReconcileMsg(&types.TxReconcileReq{SetSize: int32(len(local))}, stream)
+	if err != nil {
+		return err
+	}
+	sketch := &types.TxSketch{}
+	if err = readReconcileMsg(sketch, stream); err != nil {
+		return err
+	}
+	var localOnly, remoteOnly []string
+	decoded := true
+	if len(sketch.Cells) == 0 {
+		localOnly, remoteOnly = diffShortHashes(local, sketch.ShortHashes)
+	} else {
+		localOnly, remoteOnly, decoded = decodeSketchDiff(local, sketch.Cells)
+	}
+	//概要解码失败, 发送完整的短哈希列表, 由对方计算差异
+	if !decoded {
+		if err = writeReconcileMsg(&types.TxReconcileData{ShortHashes: local}, stream); err != nil {
+			return err
+		}
+		reply := &types.TxReconcileData{}
+		if err = readReconcileMsg(reply, stream); err != nil {
+			return err
+		}
+		r.recvTxs(reply.Txs, pid)
+		return writeReconcileMsg(&types.TxReconcileData{Txs: r.getTxs(reply.Missing)}, stream)
+	}
+	err = writeReconcileMsg(&types.TxReconcileData{Missing: remoteOnly, Txs: r.getTxs(localOnly)}, stream)
+	if err != nil {
+		return err
+	}
+	reply := &types.TxReconcileData{}
+	if err = readReconcileMsg(reply, stream); err != nil {
+		return err
+	}
+	r.recvTxs(reply.Txs, pid)
+	return nil
+}
+
+func (r *txReconciler) handleStreamReconcile(stream network.Stream) {
+	pid := stream.Conn().RemotePeer()
+	req := &types.TxReconcileReq{}
+	if err := readReconcileMsg(req, stream); err != nil {
+		return
+	}
+	//协调期间新连接的节点可能尚未记录
+	if !r.acquire(pid) {
+		r.refreshPeers()
+		if !r.acquire(pid) {
+			return
+		}
+	}
+	defer r.release(pid)
+	local, ok := r.snapshot(pid)
+	if !ok {
+		return
+	}
+	if err := r.respond(stream, local, int(req.SetSize)); err != nil {
+		log.Error("handleStreamReconcile", "pid", pid, "err", err)
+		return
+	}
+	r.remove(pid, local)
+}
+
+func (r *txReconciler) respond(stream network.Stream, local []string, remoteSize int) error {
+	pid := stream.Conn().RemotePeer()
+	sketch := &types.TxSketch{}
+	cells := sketchCapacity(len(local), remoteSize)
+	//差异较大时概要比完整列表更大, 直接发送列表
+	if cells > maxSketchCells || cells*sketchCellBytes >= len(local)*shortHashBytes {
+		sketch.ShortHashes = local
+	} else {
+		s := newTxSketch(cells)
+		for _, hash := range local {
+			_ = s.add(hash)
+		}
+		sketch.Cells = s.cells
+	}
+	if err := writeReconcileMsg(sketch, stream); err != nil {
+		return err
+	}
+	data := &types.TxReconcileData{}
+	if err := readReconcileMsg(data, stream); err != nil {
+		return err
+	}
+	r.recvTxs(data.Txs, pid)
+	if len(data.ShortHashes) == 0 {
+		return writeReconcileMsg(&types.TxReconcileData{Txs: r.getTxs(data.Missing)}, stream)
+	}
+	//对方概要解码失败, 根据完整列表计算差异
+	remoteOnly, localOnly := diffShortHashes(data.ShortHashes, local)
+	err := writeReconcileMsg(&types.TxReconcileData{Missing: remoteOnly, Txs: r.getTxs(localOnly)}, stream)
+	if err != nil {
+		return err
+	}
+	data = &types.TxReconcileData{}
+	if err = readReconcileMsg(data, stream); err != nil {
+		return err
+	}
+	r.recvTxs(data.Txs, pid)
+	return nil
+}
+
+//getTxs 通过短哈希从mempool获取交易
+func (r *txReconciler) getTxs(hashes []string) []*types.Transaction {
+	if len(hashes) == 0 {
+		return nil
+	}
+	resp, err := r.QueryModule("mempool", types.EventTxListByHash, &types.ReqTxHashList{Hashes: hashes, IsShortHash: true})
+	if err != nil {
+		log.Error("reconcile getTxs", "err", err)
+		return nil
+	}
+	txList, ok := resp.(*types.ReplyTxList)
+	if !ok {
+		return nil
+	}
+	txs := make([]*types.Transaction, 0, len(txList.GetTxs()))
+	for _, tx := range txList.GetTxs() {
+		if tx != nil {
+			txs = append(txs, tx)
+		}
+	}
+	return txs
+}
+
+//recvTxs 协调获得的交易发送至mempool, 并记录交易来源节点
+func (r *txReconciler) recvTxs(txs []*types.Transaction, pid peer.ID) {
+	for _, tx := range txs {
+		hash := hex.EncodeToString(tx.Hash())
+		if r.txFilter.AddWithCheckAtomic(hash, pid) {
+			continue
+		}
+		if err := r.postMempool(hash, tx, pid); err != nil {
+			log.Error("reconcile recvTxs", "hash", hash, "err", err)
+		}
+	}
+}
+
+//decodeSketchDiff 本地集合概要减去对方概要并解码, 返回本地独有和对方独有的交易短哈希
+func decodeSketchDiff(local []string, cells []*types.TxSketchCell) ([]string, []string, bool) {
+	s := newTxSketch(len(cells))
+	if len(s.cells) != len(cells) {
+		return nil, nil, false
+	}
+	for _, hash := range local {
+		_ = s.add(hash)
+	}
+	if err := s.subtract(&txSketch{cells: cells}); err != nil {
+		return nil, nil, false
+	}
+	return s.decode()
+}
+
+//diffShortHashes 返回只在a中和只在b中的短哈希
+func diffShortHashes(a, b []string) (aOnly, bOnly []string) {
+	set := make(map[string]bool, len(b))
+	for _, hash := range b {
+		set[hash] = true
+	}
+	for _, hash := range a {
+		if set[hash] {
+			delete(set, hash)
+			continue
+		}
+		aOnly = append(aOnly, hash)
+	}
+	for _, hash := range b {
+		if set[hash] {
+			bOnly = append(bOnly, hash)
+		}
+	}
+	return aOnly, bOnly
+}
+
+func writeReconcileMsg(msg types.Message, stream network.Stream) error {
+	reconcileBytesOut.Inc(int64(types.Size(msg)))
+	return protocol.WriteStream(msg, stream)
+}
+
+func readReconcileMsg(msg types.Message, stream network.Stream) error {
+	if err := protocol.ReadStream(msg, stream); err != nil {
+		return err
+	}
+	reconcileBytesIn.Inc(int64(types.Size(msg)))
+	return nil
+}
@@ -0,0 +1,215 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package broadcast
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/client"
+	"github.com/33cn/chain33/common/pubsub"
+	"github.com/33cn/chain33/p2p"
+	"github.com/33cn/chain33/p2p/utils"
+	"github.com/33cn/chain33/queue"
+	net "github.com/33cn/chain33/system/p2p/dht/extension"
+	"github.com/33cn/chain33/system/p2p/dht/manage"
+	prototypes "github.com/33cn/chain33/system/p2p/dht/protocol"
+	p2pty "github.com/33cn/chain33/system/p2p/dht/types"
+	"github.com/33cn/chain33/types"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/require"
+)
+
+// 模拟mempool, 记录通过协调接收的交易
+type testReconcileMempool struct {
+	lock sync.Mutex
+	txs  map[string]*types.Transaction
+	recv map[string]bool
+}
+
+func (m *testReconcileMempool) handle(client queue.Client) {
+	client.Sub("mempool")
+	for msg := range client.Recv() {
+		m.lock.Lock()
+		switch msg.Ty {
+		case types.EventTxListByHash:
+			reply := &types.ReplyTxList{}
+			for _, hash := range msg.GetData().(*types.ReqTxHashList).Hashes {
+				reply.Txs = append(reply.Txs, m.txs[hash])
+			}
+			msg.Reply(client.NewMessage("", 0, reply))
+		case types.EventTx:
+			tx := msg.GetData().(*types.Transaction)
+			m.recv[types.CalcTxShortHash(tx.Hash())] = true
+			msg.Reply(client.NewMessage("", 0, &types.Reply{IsOk: true}))
+		}
+		m.lock.Unlock()
+	}
+}
+
+func (m *testReconcileMempool) addTxs(txs []*types.Transaction) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for _, tx := range txs {
+		m.txs[types.CalcTxShortHash(tx.Hash())] = tx
+	}
+}
+
+func (m *testReconcileMempool) received() int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return len(m.recv)
+}
+
+func newReconcileNode(t *testing.T, port int32, txs []*types.Transaction) (*txReconciler, *testReconcileMempool) {
+	q := queue.New("test")
+	cfg := types.NewChain33Config(types.ReadFile("../../../../../cmd/chain33/chain33.test.toml"))
+	q.SetConfig(cfg)
+	go q.Start()
+	mgr := p2p.NewP2PMgr(cfg)
+	mgr.Client = q.Client()
+	mgr.SysAPI, _ = client.New(mgr.Client, nil)
+	env := &prototypes.P2PEnv{
+		ChainCfg:    cfg,
+		QueueClient: q.Client(),
+		Host:        newHost(port),
+		P2PManager:  mgr,
+		SubConfig:   &p2pty.P2PSubConfig{},
+		Ctx:         context.Background(),
+		PeerScores:  manage.NewPeerScoreManager(context.Background(), nil, nil),
+	}
+	var err error
+	env.Pubsub, err = net.NewPubSub(env.Ctx, env.Host, &p2pty.PubSubConfig{})
+	require.Nil(t, err)
+	p := &broadcastProtocol{P2PEnv: env, txFilter: utils.NewFilter(txRecvFilterCacheNum)}
+	p.cfg.TxReconcileInterval = 100000
+	mempool := &testReconcileMempool{txs: make(map[string]*types.Transaction), recv: make(map[string]bool)}
+	mempool.addTxs(txs)
+	go mempool.handle(q.Client())
+	return initTxReconciler(p), mempool
+}
+
+func testReconcileTxs(start, count int) []*types.Transaction {
+	txs := make([]*types.Transaction, 0, count)
+	for i := start; i < start+count; i++ {
+		txs = append(txs, &types.Transaction{Execer: []byte("coins"), Nonce: int64(i)})
+	}
+	return txs
+}
+
+func TestTxReconcile(t *testing.T) {
+
+	common := testReconcileTxs(0, 300)
+	txs1 := append(testReconcileTxs(1000, 5), common...)
+	txs2 := append(testReconcileTxs(2000, 8), common...)
+	r1, mempool1 := newReconcileNode(t, 13911, txs1)
+	r2, mempool2 := newReconcileNode(t, 13912, txs2)
+	defer r1.Host.Close()
+	require.Nil(t, r1.Host.Connect(context.Background(), peer.AddrInfo{ID: r2.Host.ID(), Addrs: r2.Host.Addrs()}))
+	for i := 0; i < 100 && (len(r1.refreshPeers()) == 0 || len(r2.refreshPeers()) == 0); i++ {
+		time.Sleep(time.Millisecond * 50)
+	}
+	require.Equal(t, []peer.ID{r2.Host.ID()}, r1.refreshPeers())
+	require.Equal(t, []peer.ID{r1.Host.ID()}, r2.refreshPeers())
+	require.False(t, r1.needFlood())
+
+	addTxs := func(r *txReconciler, txs []*types.Transaction) {
+		for _, tx := range txs {
+			r.addTx(types.CalcTxShortHash(tx.Hash()), "")
+		}
+	}
+	waitRecv := func(m *testReconcileMempool, count int) {
+		for i := 0; i < 100 && m.received() < count; i++ {
+			time.Sleep(time.Millisecond * 10)
+		}
+		require.Equal(t, count, m.received())
+	}
+	//概要解码, 只传输差异交易
+	addTxs(r1, txs1)
+	addTxs(r2, txs2)
+	//来源节点不加入集合
+	r1.addTx("0000000000", r2.Host.ID())
+	hashes, _ := r1.snapshot(r2.Host.ID())
+	require.Equal(t, len(txs1), len(hashes))
+	out := reconcileBytesOut.Count()
+	r1.reconcile(r2.Host.ID())
+	waitRecv(mempool1, 8)
+	waitRecv(mempool2, 5)
+	require.True(t, reconcileBytesOut.Count() > out)
+	hashes, _ = r1.snapshot(r2.Host.ID())
+	require.Equal(t, 0, len(hashes))
+	hashes, _ = r2.snapshot(r1.Host.ID())
+	require.Equal(t, 0, len(hashes))
+
+	//差异较大时概要解码失败, 通过完整短哈希列表协调
+	txs1 = testReconcileTxs(3000, 200)
+	txs2 = testReconcileTxs(4000, 200)
+	mempool1.addTxs(txs1)
+	mempool2.addTxs(txs2)
+	addTxs(r1, txs1)
+	addTxs(r2, txs2)
+	r2.reconcile(r1.Host.ID())
+	waitRecv(mempool1, 208)
+	waitRecv(mempool2, 205)
+
+	r2.Host.Close()
+	for i := 0; i < 100 && len(r1.refreshPeers()) > 0; i++ {
+		time.Sleep(time.Millisecond * 10)
+	}
+	require.Equal(t, 0, len(r1.refreshPeers()))
+	require.True(t, r1.needFlood())
+}
+
+//存在不支持协调的订阅节点时交易通过pubsub广播, 不再加入集合协调
+func TestTxReconcileMixedFlood(t *testing.T) {
+	r1, _ := newReconcileNode(t, 13913, nil)
+	r2, _ := newReconcileNode(t, 13914, nil)
+	defer r1.Host.Close()
+	defer r2.Host.Close()
+	r1.txRecon = r1
+	r1.ps = pubsub.NewPubSub(1024)
+	flooded := r1.ps.Sub(psBatchTxTopic)
+	//不支持协调的节点
+	h3 := newHost(13915)
+	defer h3.Close()
+	ps3, err := net.NewPubSub(context.Background(), h3, &p2pty.PubSubConfig{})
+	require.Nil(t, err)
+	noop := func(topic string, msg net.SubMsg) {}
+	require.Nil(t, ps3.JoinAndSubTopic(psTxTopic, noop))
+	require.Nil(t, r1.Pubsub.JoinAndSubTopic(psTxTopic, noop))
+	require.Nil(t, r1.Host.Connect(context.Background(), peer.AddrInfo{ID: r2.Host.ID(), Addrs: r2.Host.Addrs()}))
+	require.Nil(t, r1.Host.Connect(context.Background(), peer.AddrInfo{ID: h3.ID(), Addrs: h3.Addrs()}))
+	for i := 0; i < 100 && (len(r1.refreshPeers()) == 0 || len(r2.refreshPeers()) == 0 || !r1.needFlood()); i++ {
+		time.Sleep(time.Millisecond * 50)
+	}
+	require.Equal(t, []peer.ID{r2.Host.ID()}, r1.refreshPeers())
+	require.True(t, r1.needFlood())
+
+	//双方集合为空时一次协调的流量
+	out := reconcileBytesOut.Count()
+	r1.reconcile(r2.Host.ID())
+	base := reconcileBytesOut.Count() - out
+
+	txs := testReconcileTxs(5000, 50)
+	for _, tx := range txs {
+		r1.handleBroadcastSend(r1.QueueClient.NewMessage("p2p", types.EventTxBroadcast, tx))
+	}
+	for range txs {
+		select {
+		case <-flooded:
+		case <-time.After(time.Second):
+			t.Fatal("tx not flooded")
+		}
+	}
+	hashes, _ := r1.snapshot(r2.Host.ID())
+	require.Equal(t, 0, len(hashes))
+	out = reconcileBytesOut.Count()
+	in := reconcileBytesIn.Count()
+	r1.reconcile(r2.Host.ID())
+	require.Equal(t, base, reconcileBytesOut.Count()-out)
+	require.Equal(t, base, reconcileBytesIn.Count()-in)
+}
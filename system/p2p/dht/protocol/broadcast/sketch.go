@@ -0,0 +1,165 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package broadcast
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/33cn/chain33/types"
+)
+
+const (
+	//每个元素映射的单元数量
+	sketchHashNum = 3
+	//概要的最小和最大单元数量
+	minSketchCells = 4 * sketchHashNum
+	maxSketchCells = 4096
+)
+
+// txSketch 基于可逆布隆查找表(IBLT)的交易集合概要,
+// 双方概要相减后只保留集合差异部分, 差异不超过容量时可以解码出各自独有的元素
+type txSketch struct {
+	cells []*types.TxSketchCell
+}
+
+// newTxSketch 创建指定单元数量的概要, 单元数量按哈希函数数量对齐
+func newTxSketch(size int) *txSketch {
+	if size < minSketchCells {
+		size = minSketchCells
+	}
+	if size > maxSketchCells {
+		size = maxSketchCells
+	}
+	size = (size + sketchHashNum - 1) / sketchHashNum * sketchHashNum
+	s := &txSketch{cells: make([]*types.TxSketchCell, size)}
+	for i := range s.cells {
+		s.cells[i] = &types.TxSketchCell{}
+	}
+	return s
+}
+
+// sketchCapacity 根据双方集合大小估计差异数量, 返回所需的概要单元数量
+func sketchCapacity(localSize, remoteSize int) int {
+	diff := localSize - remoteSize
+	if diff < 0 {
+		diff = -diff
+	}
+	min := localSize
+	if remoteSize < min {
+		min = remoteSize
+	}
+	//集合差异包括数量差异和双方各自独有的部分, 后者按较小集合的1/4估计
+	return 2*(diff+min/4) + minSketchCells
+}
+
+//splitmix64 整数哈希
+func splitmix64(x uint64) uint64 {
+	x += 0x9e3779b97f4a7c15
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	return x ^ (x >> 31)
+}
+
+func sketchCheckSum(key uint64) uint64 {
+	return splitmix64(key ^ 0x5bd1e9955bd1e995)
+}
+
+//cellIndex 第i个哈希函数映射的单元, 每个哈希函数使用独立的分区, 避免同一元素映射到相同单元
+func (s *txSketch) cellIndex(key uint64, i int) int {
+	part := len(s.cells) / sketchHashNum
+	return i*part + int(splitmix64(key+uint64(i))%uint64(part))
+}
+
+func (s *txSketch) update(key uint64, count int32) {
+	sum := sketchCheckSum(key)
+	for i := 0; i < sketchHashNum; i++ {
+		cell := s.cells[s.cellIndex(key, i)]
+		cell.Count += count
+		cell.KeySum ^= key
+		cell.HashSum ^= sum
+	}
+}
+
+// add 添加交易短哈希
+func (s *txSketch) add(shortHash string) error {
+	key, err := shortHashKey(shortHash)
+	if err != nil {
+		return err
+	}
+	s.update(key, 1)
+	return nil
+}
+
+// subtract 减去对方的概要, 双方单元数量必须一致
+func (s *txSketch) subtract(other *txSketch) error {
+	if len(s.cells) != len(other.cells) {
+		return types.ErrInvalidParam
+	}
+	for i, cell := range s.cells {
+		cell.Count -= other.cells[i].Count
+		cell.KeySum ^= other.cells[i].KeySum
+		cell.HashSum ^= other.cells[i].HashSum
+	}
+	return nil
+}
+
+// decode 解码相减后的概要, 返回本地独有和对方独有的交易短哈希, 差异超过概要容量时解码失败
+func (s *txSketch) decode() (local, remote []string, ok bool) {
+	var pure []int
+	for i := range s.cells {
+		if s.isPure(i) {
+			pure = append(pure, i)
+		}
+	}
+	for len(pure) > 0 {
+		i := pure[len(pure)-1]
+		pure = pure[:len(pure)-1]
+		if !s.isPure(i) {
+			continue
+		}
+		cell := s.cells[i]
+		key, count := cell.KeySum, cell.Count
+		if count > 0 {
+			local = append(local, keyShortHash(key))
+		} else {
+			remote = append(remote, keyShortHash(key))
+		}
+		s.update(key, -count)
+		for j := 0; j < sketchHashNum; j++ {
+			if index := s.cellIndex(key, j); s.isPure(index) {
+				pure = append(pure, index)
+			}
+		}
+	}
+	for _, cell := range s.cells {
+		if cell.Count != 0 || cell.KeySum != 0 || cell.HashSum != 0 {
+			return nil, nil, false
+		}
+	}
+	return local, remote, true
+}
+
+func (s *txSketch) isPure(i int) bool {
+	cell := s.cells[i]
+	return (cell.Count == 1 || cell.Count == -1) && cell.HashSum == sketchCheckSum(cell.KeySum)
+}
+
+//shortHashKey 交易短哈希转换为概要元素, 短哈希为交易哈希的前5字节
+func shortHashKey(shortHash string) (uint64, error) {
+	b, err := hex.DecodeString(shortHash)
+	if err != nil || len(b) == 0 || len(b) > 8 {
+		return 0, types.ErrInvalidParam
+	}
+	var key uint64
+	for _, v := range b {
+		key = key<<8 | uint64(v)
+	}
+	return key, nil
+}
+
+func keyShortHash(key uint64) string {
+	return fmt.Sprintf("%010x", key)
+}
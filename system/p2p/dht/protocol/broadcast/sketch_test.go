@@ -0,0 +1,78 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package broadcast
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+)
+
+func testShortHashes(start, count int) []string {
+	hashes := make([]string, 0, count)
+	for i := start; i < start+count; i++ {
+		tx := &types.Transaction{Execer: []byte("coins"), Nonce: int64(i)}
+		hashes = append(hashes, types.CalcTxShortHash(tx.Hash()))
+	}
+	return hashes
+}
+
+func TestTxSketch(t *testing.T) {
+
+	common := testShortHashes(0, 500)
+	localOnly := testShortHashes(1000, 10)
+	remoteOnly := testShortHashes(2000, 15)
+
+	local := newTxSketch(sketchCapacity(510, 515))
+	remote := newTxSketch(sketchCapacity(515, 510))
+	require.Equal(t, len(local.cells), len(remote.cells))
+	require.Equal(t, 0, len(local.cells)%sketchHashNum)
+	for _, hash := range append(common, localOnly...) {
+		require.Nil(t, local.add(hash))
+	}
+	for _, hash := range append(common, remoteOnly...) {
+		require.Nil(t, remote.add(hash))
+	}
+	require.Nil(t, local.subtract(remote))
+	l, r, ok := local.decode()
+	require.True(t, ok)
+	sort.Strings(l)
+	sort.Strings(r)
+	sort.Strings(localOnly)
+	sort.Strings(remoteOnly)
+	require.Equal(t, localOnly, l)
+	require.Equal(t, remoteOnly, r)
+
+	//差异超过容量时解码失败
+	local = newTxSketch(minSketchCells)
+	for _, hash := range localOnly {
+		require.Nil(t, local.add(hash))
+	}
+	remote = newTxSketch(minSketchCells)
+	for _, hash := range remoteOnly {
+		require.Nil(t, remote.add(hash))
+	}
+	require.Nil(t, local.subtract(remote))
+	_, _, ok = local.decode()
+	require.False(t, ok)
+
+	require.Equal(t, types.ErrInvalidParam, local.subtract(newTxSketch(maxSketchCells+1)))
+	require.Equal(t, maxSketchCells-maxSketchCells%sketchHashNum+sketchHashNum, len(newTxSketch(maxSketchCells+1).cells))
+	require.NotNil(t, local.add("xyz"))
+	key, err := shortHashKey(common[0])
+	require.Nil(t, err)
+	require.Equal(t, common[0], keyShortHash(key))
+}
+
+func TestDiffShortHashes(t *testing.T) {
+	a, b := diffShortHashes([]string{"01", "02", "03"}, []string{"02", "04"})
+	require.Equal(t, []string{"01", "03"}, a)
+	require.Equal(t, []string{"04"}, b)
+	a, b = diffShortHashes(nil, []string{"02"})
+	require.Nil(t, a)
+	require.Equal(t, []string{"02"}, b)
+}
@@ -13,24 +13,18 @@ import (
 	"sync/atomic"
 	"time"
 
+	p2pty "github.com/33cn/chain33/system/p2p/dht/types"
 	"github.com/33cn/chain33/types"
 	"github.com/libp2p/go-libp2p-core/peer"
 	ps "github.com/libp2p/go-libp2p-pubsub"
 )
 
-type deniedInfo struct {
-	freeTimestamp int64
-	count         int
-}
-
 // validator 结合区块链业务逻辑, 实现pubsub数据验证接口
 type validator struct {
 	*pubSub
 	blkHeaderCache   map[int64]*types.Header
 	maxRecvBlkHeight int64
 	headerLock       sync.Mutex
-	deniedPeers      map[peer.ID]*deniedInfo //广播屏蔽节点
-	peerLock         sync.RWMutex
 	msgList          *list.List
 	msgLock          sync.Mutex
 	msgBuf           []*broadcastMsg
@@ -39,7 +33,6 @@ type validator struct {
 func newValidator(p *pubSub) *validator {
 	v := &validator{pubSub: p}
 	v.blkHeaderCache = make(map[int64]*types.Header)
-	v.deniedPeers = make(map[peer.ID]*deniedInfo)
 	v.msgBuf = make([]*broadcastMsg, 0, 1024)
 	v.msgList = list.New()
 	return v
@@ -47,25 +40,22 @@ func newValidator(p *pubSub) *validator {
 
 func initValidator(p *pubSub) *validator {
 	val := newValidator(p)
-	go val.manageDeniedPeer()
+	go val.manageBroadcastReply()
 	return val
 }
 
-// manageDeniedPeer  广播屏蔽节点管理
+// manageBroadcastReply  广播校验结果处理
 // 对每次接收的广播数据, 由blockchain或mempool校验后反馈结果,
-// 如果出错则对始发节点进行若干时长屏蔽
-// 单次错误影响不大, 总屏蔽时长根据错误次数不断累计, 主要限制错误广播频率
-// 屏蔽时间结束后可恢复为正常节点
-func (v *validator) manageDeniedPeer() {
+// 并上报节点评分, 错误广播累计扣分达到阈值后该节点被临时禁止
+// 正确的广播可逐步恢复分数
+func (v *validator) manageBroadcastReply() {
 
 	waitMsgReplyTicker := time.NewTicker(2 * time.Second)
-	recoverDeniedPeerTicker := time.NewTicker(10 * time.Minute)
 	for {
 
 		select {
 		case <-v.Ctx.Done():
 			waitMsgReplyTicker.Stop()
-			recoverDeniedPeerTicker.Stop()
 			return
 
 		case <-waitMsgReplyTicker.C:
@@ -76,7 +66,7 @@ func (v *validator) manageDeniedPeer() {
 				msg, err := v.P2PEnv.QueueClient.Wait(bcMsg.msg)
 				// 理论上不会出错, 只做日志记录
 				if msg == nil || err != nil {
-					log.Error("manageDeniedPeer", "wait msg err", err)
+					log.Error("manageBroadcastReply", "wait msg err", err)
 					continue
 				}
 				reply, ok := msg.Data.(*types.Reply)
@@ -86,25 +76,15 @@ func (v *validator) manageDeniedPeer() {
 				v.handleBroadcastReply(reply, bcMsg)
 
 			}
-
-		case <-recoverDeniedPeerTicker.C:
-			v.recoverDeniedPeers()
 		}
 	}
 }
 
-const (
-	//单位秒, 广播错误交易单次屏蔽时长
-	errTxDenyTime = 10
-	//单位秒, 广播错误区块单次屏蔽时长
-	errBlockDenyTime = 60 * 60
-)
-
 func (v *validator) handleBroadcastReply(reply *types.Reply, msg *broadcastMsg) {
 
 	if reply.IsOk {
-		// 收到正确的广播, 减少错误次数
-		v.reduceDeniedCount(msg.publisher)
+		// 收到正确的广播, 恢复节点分数
+		v.PeerScores.Report(msg.publisher, p2pty.ScoreValidMsg)
 		return
 	}
 	errMsg := string(reply.GetMsg())
@@ -114,67 +94,12 @@ func (v *validator) handleBroadcastReply(reply *types.Reply, msg *broadcastMsg)
 		errMsg == types.ErrBlockExist.Error() {
 		return
 	}
-	denyTime := int64(errBlockDenyTime)
+	reason := p2pty.ScoreInvalidBlock
 	if msg.msg.Ty == types.EventTx {
-		denyTime = errTxDenyTime
-	}
-	peerName := msg.publisher.Pretty()
-	log.Debug("handleBcRep", "errMsg", errMsg, "hash", msg.hash, "peer", peerName)
-	v.addDeniedPeer(msg.publisher, denyTime)
-	// 尝试断开并拉黑处理
-	if v.P2PEnv.Host != nil {
-		v.P2PEnv.Host.Network().ClosePeer(msg.publisher)
-		v.P2PEnv.ConnBlackList.Add(peerName, time.Hour*24)
-	}
-}
-
-func (v *validator) reduceDeniedCount(id peer.ID) {
-
-	v.peerLock.Lock()
-	defer v.peerLock.Unlock()
-	info, ok := v.deniedPeers[id]
-	if ok && info.count > 0 {
-		info.count--
-	}
-}
-
-func (v *validator) addDeniedPeer(id peer.ID, denyTime int64) {
-	v.peerLock.Lock()
-	defer v.peerLock.Unlock()
-	info, ok := v.deniedPeers[id]
-	if !ok {
-		info = &deniedInfo{}
-		v.deniedPeers[id] = info
-	}
-	now := types.Now().Unix()
-	if info.freeTimestamp < now {
-		info.freeTimestamp = now
-	}
-	info.count++
-	// 屏蔽时长随错误次数指数上升
-	info.freeTimestamp += int64(1<<info.count) * denyTime
-}
-
-// 检测是否为屏蔽节点
-func (v *validator) isDeniedPeer(id peer.ID) bool {
-	v.peerLock.RLock()
-	defer v.peerLock.RUnlock()
-	info, ok := v.deniedPeers[id]
-	return ok && info.freeTimestamp > types.Now().Unix()
-}
-
-// 恢复为正常节点
-func (v *validator) recoverDeniedPeers() {
-	v.peerLock.Lock()
-	defer v.peerLock.Unlock()
-	now := types.Now().Unix()
-
-	for id, info := range v.deniedPeers {
-		log.Debug("recoverDeniedPeers", "peer", id.Pretty(), "count", info.count, "freeTime", info.freeTimestamp)
-		if info.count <= 0 && info.freeTimestamp <= now {
-			delete(v.deniedPeers, id)
-		}
+		reason = p2pty.ScoreInvalidTx
 	}
+	log.Debug("handleBcRep", "errMsg", errMsg, "hash", msg.hash, "peer", msg.publisher.Pretty())
+	v.PeerScores.Report(msg.publisher, reason)
 }
 
 func (v *validator) addBroadcastMsg(msg *broadcastMsg) {
@@ -215,8 +140,8 @@ func (v *validator) validateBlock(ctx context.Context, _ peer.ID, msg *ps.Messag
 		return ps.ValidationAccept
 	}
 
-	if v.isDeniedPeer(id) {
-		log.Debug("validateBlock", "denied peer", id.Pretty())
+	if v.PeerScores.IsBanned(id) {
+		log.Debug("validateBlock", "banned peer", id.Pretty())
 		return ps.ValidationReject
 	}
 
@@ -283,8 +208,8 @@ func (v *validator) validateBlock(ctx context.Context, _ peer.ID, msg *ps.Messag
 //
 func (v *validator) validatePeer(ctx context.Context, _ peer.ID, msg *ps.Message) ps.ValidationResult {
 	id := msg.GetFrom()
-	if v.isDeniedPeer(id) {
-		log.Debug("validatePeer", "topic", *msg.Topic, "denied peer", id.Pretty())
+	if v.PeerScores.IsBanned(id) {
+		log.Debug("validatePeer", "topic", *msg.Topic, "banned peer", id.Pretty())
 		return ps.ValidationReject
 	}
 	return ps.ValidationAccept
@@ -301,6 +226,7 @@ func (v *validator) validateTx(ctx context.Context, id peer.ID, msg *ps.Message)
 		return ps.ValidationAccept
 	}
 
+	floodBytesIn.Inc(int64(len(msg.Data)))
 	tx := &types.Transaction{}
 	err := v.decodeMsg(msg.Data, nil, tx)
 	if err != nil {
@@ -308,8 +234,8 @@ func (v *validator) validateTx(ctx context.Context, id peer.ID, msg *ps.Message)
 		return ps.ValidationReject
 	}
 
-	//重复检测
-	if v.txFilter.AddWithCheckAtomic(hex.EncodeToString(tx.Hash()), struct{}{}) {
+	//重复检测, 记录交易来源节点
+	if v.txFilter.AddWithCheckAtomic(hex.EncodeToString(tx.Hash()), msg.ReceivedFrom) {
 		return ps.ValidationIgnore
 	}
 
@@ -331,6 +257,7 @@ func (v *validator) validateBatchTx(ctx context.Context, id peer.ID, msg *ps.Mes
 		return ps.ValidationAccept
 	}
 
+	floodBytesIn.Inc(int64(len(msg.Data)))
 	txs := &types.Transactions{}
 	err := v.decodeMsg(msg.Data, nil, txs)
 	if err != nil {
@@ -342,7 +269,7 @@ func (v *validator) validateBatchTx(ctx context.Context, id peer.ID, msg *ps.Mes
 
 	for _, tx := range txs.GetTxs() {
 		//重复检测
-		if v.txFilter.AddWithCheckAtomic(hex.EncodeToString(tx.Hash()), struct{}{}) {
+		if v.txFilter.AddWithCheckAtomic(hex.EncodeToString(tx.Hash()), msg.ReceivedFrom) {
 			continue
 		}
 
@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/33cn/chain33/queue"
+	p2pty "github.com/33cn/chain33/system/p2p/dht/types"
 	"github.com/33cn/chain33/types"
 	ps "github.com/libp2p/go-libp2p-pubsub"
 	pubsub_pb "github.com/libp2p/go-libp2p-pubsub/pb"
@@ -25,39 +26,26 @@ func Test_handleBroadcastReply(t *testing.T) {
 	val := newValidator(newTestPubSub())
 	reply := &types.Reply{IsOk: true}
 	val.handleBroadcastReply(reply, &broadcastMsg{})
-	require.Equal(t, 0, len(val.deniedPeers))
+	require.Equal(t, 0, len(val.PeerScores.List().Scores))
 	reply.IsOk = false
 	reply.Msg = []byte(types.ErrMemFull.Error())
 	val.handleBroadcastReply(reply, nil)
-	require.Equal(t, 0, len(val.deniedPeers))
+	require.Equal(t, 0, len(val.PeerScores.List().Scores))
 	msg := &broadcastMsg{msg: &queue.Message{Ty: types.EventTx}, publisher: "testpid1"}
 	reply.Msg = []byte(types.ErrNoBalance.Error())
 	val.handleBroadcastReply(reply, msg)
-	require.Equal(t, 1, len(val.deniedPeers))
-	info, ok := val.deniedPeers["testpid1"]
-	require.True(t, ok)
-	require.Equal(t, 1, info.count)
-}
-
-func Test_deniedPeerMethod(t *testing.T) {
-
-	val := newValidator(newTestPubSub())
-	val.addDeniedPeer("testpid1", 0)
-	val.addDeniedPeer("testpid2", 10)
-	require.False(t, val.isDeniedPeer("testpid1"))
-	require.True(t, val.isDeniedPeer("testpid2"))
-	require.Equal(t, 2, len(val.deniedPeers))
-	val.recoverDeniedPeers()
-	require.Equal(t, 2, len(val.deniedPeers))
-	val.reduceDeniedCount("testpid1")
-	val.recoverDeniedPeers()
-	require.Equal(t, 1, len(val.deniedPeers))
-	info := val.deniedPeers["testpid2"]
-	currFreeTimestamp := info.freeTimestamp
-	val.addDeniedPeer("testpid2", 1)
-	val.addDeniedPeer("testpid2", 1)
-	require.Equal(t, 3, info.count)
-	require.Equal(t, currFreeTimestamp+4+8, info.freeTimestamp)
+	require.Equal(t, 1, len(val.PeerScores.List().Scores))
+	score := val.PeerScores.Score("testpid1")
+	require.True(t, score < 0)
+	require.False(t, val.PeerScores.IsBanned("testpid1"))
+	reply.IsOk = true
+	val.handleBroadcastReply(reply, msg)
+	require.Equal(t, score+1, val.PeerScores.Score("testpid1"))
+	//错误区块直接禁止
+	reply.IsOk = false
+	msg.msg.Ty = types.EventBroadcastAddBlock
+	val.handleBroadcastReply(reply, msg)
+	require.True(t, val.PeerScores.IsBanned("testpid1"))
 }
 
 func Test_broadcastMsg(t *testing.T) {
@@ -74,10 +62,10 @@ func Test_broadcastMsg(t *testing.T) {
 	require.Equal(t, 0, val.msgList.Len())
 	require.Equal(t, 1, len(val.msgBuf))
 	val.addBroadcastMsg(msg)
-	go val.manageDeniedPeer()
+	go val.manageBroadcastReply()
 	testDone := make(chan struct{})
 	go func() {
-		for !val.isDeniedPeer("testpid1") {
+		for val.PeerScores.Score("testpid1") == 0 {
 			time.Sleep(time.Millisecond * 100)
 		}
 		testDone <- struct{}{}
@@ -106,7 +94,7 @@ func Test_validateBlock(t *testing.T) {
 	val.broadcastProtocol = proto
 	msg := &ps.Message{Message: &pubsub_pb.Message{From: []byte(val.Host.ID())}}
 	require.Equal(t, ps.ValidationAccept, val.validateBlock(val.Ctx, val.Host.ID(), msg))
-	val.addDeniedPeer("errpid", 10)
+	val.PeerScores.Report("errpid", p2pty.ScoreInvalidBlock)
 	msg = &ps.Message{Message: &pubsub_pb.Message{From: []byte("errpid")}}
 	require.Equal(t, ps.ValidationReject, val.validateBlock(val.Ctx, "errpid", msg))
 	msg = &ps.Message{Message: &pubsub_pb.Message{Data: []byte("errmsg")}}
@@ -124,7 +112,7 @@ func Test_validateBlock(t *testing.T) {
 
 func Test_validatePeer(t *testing.T) {
 	val := newValidator(newTestPubSub())
-	val.addDeniedPeer("errpid", 10)
+	val.PeerScores.Report("errpid", p2pty.ScoreInvalidBlock)
 	topic := "tx"
 	msg := &ps.Message{Message: &pubsub_pb.Message{Topic: &topic, From: []byte("errpid")}}
 	require.Equal(t, ps.ValidationReject, val.validatePeer(val.Ctx, "", msg))
@@ -0,0 +1,402 @@
+package download
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common/merkle"
+	"github.com/33cn/chain33/common/trace"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/system/p2p/dht/protocol"
+	p2pty "github.com/33cn/chain33/system/p2p/dht/types"
+	"github.com/33cn/chain33/types"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+)
+
+const (
+	//单次请求的最大区块头数量
+	maxHeaderBatch = 1024
+	//单次请求区块体数量的窗口范围, 根据节点的下载速度调整
+	minBodyWindow  = 4
+	initBodyWindow = 16
+	maxBodyWindow  = 128
+	//单次批量请求期望的耗时, 窗口按照该时长内节点能够传输的区块数量调整
+	targetBatchTime = time.Second
+	//区块体响应的单个消息大小, 超过后拆分成多个消息
+	maxBodysMsgSize = 4 * 1024 * 1024
+)
+
+var (
+	errHeaderLinkage = errors.New("header linkage mismatch")
+	errBodyMismatch  = errors.New("block body mismatch header")
+)
+
+func (p *Protocol) handleStreamDownloadHeaders(stream network.Stream) {
+	var req types.ReqBlocks
+	err := protocol.ReadStream(&req, stream)
+	if err != nil {
+		log.Error("handleStreamDownloadHeaders", "read stream err", err)
+		return
+	}
+	if req.End-req.Start >= maxHeaderBatch || req.End < req.Start {
+		log.Error("handleStreamDownloadHeaders", "error", "wrong parameter", "start", req.Start, "end", req.End)
+		return
+	}
+	resp, err := p.QueryModule("blockchain", types.EventGetHeaders, &req)
+	if err != nil {
+		log.Error("handleStreamDownloadHeaders", "get headers err", err)
+		return
+	}
+	headers, ok := resp.(*types.Headers)
+	if !ok {
+		return
+	}
+	err = protocol.WriteStream(headers, stream)
+	if err != nil {
+		log.Error("handleStreamDownloadHeaders", "WriteStream err", err, "remote pid", stream.Conn().RemotePeer().String())
+	}
+}
+
+func (p *Protocol) handleStreamDownloadBodies(stream network.Stream) {
+	var req types.ReqBlocks
+	err := protocol.ReadStream(&req, stream)
+	if err != nil {
+		log.Error("handleStreamDownloadBodies", "read stream err", err)
+		return
+	}
+	if req.End-req.Start >= maxBodyWindow || req.End < req.Start {
+		log.Error("handleStreamDownloadBodies", "error", "wrong parameter", "start", req.Start, "end", req.End)
+		return
+	}
+	resp, err := p.QueryModule("blockchain", types.EventGetBlocks, &types.ReqBlocks{Start: req.Start, End: req.End})
+	if err != nil {
+		log.Error("handleStreamDownloadBodies", "get blocks err", err)
+		return
+	}
+	details, ok := resp.(*types.BlockDetails)
+	if !ok {
+		return
+	}
+	//区块较多时拆分成多个消息发送, 避免超过单个消息的大小限制
+	bodys := &types.BlockBodys{}
+	size := 0
+	for _, detail := range details.Items {
+		block := detail.GetBlock()
+		bodys.Items = append(bodys.Items, &types.BlockBody{
+			Txs:        block.Txs,
+			MainHash:   block.MainHash,
+			MainHeight: block.MainHeight,
+			Height:     block.Height,
+		})
+		size += block.Size()
+		if size < maxBodysMsgSize {
+			continue
+		}
+		if err = protocol.WriteStream(bodys, stream); err != nil {
+			log.Error("handleStreamDownloadBodies", "WriteStream err", err, "remote pid", stream.Conn().RemotePeer().String())
+			return
+		}
+		bodys.Items = bodys.Items[:0]
+		size = 0
+	}
+	if len(bodys.Items) > 0 {
+		if err = protocol.WriteStream(bodys, stream); err != nil {
+			log.Error("handleStreamDownloadBodies", "WriteStream err", err, "remote pid", stream.Conn().RemotePeer().String())
+		}
+	}
+}
+
+func (p *Protocol) fetchHeadersFromPeer(start, end int64, pid peer.ID) ([]*types.Header, error) {
+	ctx, cancel := context.WithTimeout(p.Ctx, time.Second*10)
+	defer cancel()
+	stream, err := p.Host.NewStream(ctx, pid, downloadHeaders)
+	if err != nil {
+		return nil, err
+	}
+	defer protocol.CloseStream(stream)
+	_ = stream.SetDeadline(time.Now().Add(time.Second * 30))
+	err = protocol.WriteStream(&types.ReqBlocks{Start: start, End: end}, stream)
+	if err != nil {
+		return nil, err
+	}
+	var headers types.Headers
+	err = protocol.ReadStream(&headers, stream)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(headers.Items)) != end-start+1 {
+		return nil, p2pty.ErrLength
+	}
+	return headers.Items, nil
+}
+
+//checkHeaders 校验区块头高度连续且每个区块头的parentHash指向前一个区块头
+func checkHeaders(start int64, headers []*types.Header) error {
+	for i, header := range headers {
+		if header.GetHeight() != start+int64(i) {
+			return errHeaderLinkage
+		}
+		if i > 0 && !bytes.Equal(header.GetParentHash(), headers[i-1].GetHash()) {
+			return errHeaderLinkage
+		}
+	}
+	return nil
+}
+
+//fetchHeaders 按批次下载连续的区块头, 区块头需要和前一批次首尾相连
+func (p *Protocol) fetchHeaders(start, end int64, peers []peer.ID) ([]*types.Header, error) {
+	headers := make([]*types.Header, 0, end-start+1)
+	for batchStart := start; batchStart <= end; batchStart += maxHeaderBatch {
+		batchEnd := batchStart + maxHeaderBatch - 1
+		if batchEnd > end {
+			batchEnd = end
+		}
+		var batch []*types.Header
+		var err error
+		for _, pid := range peers {
+			batch, err = p.fetchHeadersFromPeer(batchStart, batchEnd, pid)
+			if err == nil {
+				err = checkHeaders(batchStart, batch)
+				if err == nil && len(headers) > 0 && !bytes.Equal(batch[0].GetParentHash(), headers[len(headers)-1].GetHash()) {
+					err = errHeaderLinkage
+				}
+				if err != nil {
+					p.PeerScores.Report(pid, p2pty.ScoreInvalidBlock)
+				}
+			} else if p.Ctx.Err() == nil {
+				p.PeerScores.Report(pid, p2pty.ScoreDownloadTimeout)
+			}
+			if err == nil {
+				break
+			}
+			log.Error("fetchHeaders", "pid", pid, "start", batchStart, "end", batchEnd, "err", err)
+		}
+		if err != nil {
+			return nil, err
+		}
+		headers = append(headers, batch...)
+	}
+	return headers, nil
+}
+
+//fetchBodiesFromPeer 批量下载区块体, 和对应的区块头组装成区块并校验
+func (p *Protocol) fetchBodiesFromPeer(headers []*types.Header, pid peer.ID) ([]*types.Block, error) {
+	start, end := headers[0].Height, headers[len(headers)-1].Height
+	ctx, cancel := context.WithTimeout(p.Ctx, time.Second*10)
+	defer cancel()
+	stream, err := p.Host.NewStream(ctx, pid, downloadBodies)
+	if err != nil {
+		return nil, err
+	}
+	defer protocol.CloseStream(stream)
+	_ = stream.SetDeadline(time.Now().Add(time.Minute))
+	err = protocol.WriteStream(&types.ReqBlocks{Start: start, End: end}, stream)
+	if err != nil {
+		return nil, err
+	}
+	blocks := make([]*types.Block, 0, len(headers))
+	for len(blocks) < len(headers) {
+		var bodys types.BlockBodys
+		if err = protocol.ReadStream(&bodys, stream); err != nil {
+			return nil, err
+		}
+		if len(bodys.Items) == 0 || len(blocks)+len(bodys.Items) > len(headers) {
+			return nil, errBodyMismatch
+		}
+		for _, body := range bodys.Items {
+			header := headers[len(blocks)]
+			block := &types.Block{MainHash: body.MainHash, MainHeight: body.MainHeight, Txs: body.Txs}
+			block.SetHeader(header)
+			if body.Height != header.Height ||
+				!bytes.Equal(merkle.CalcMerkleRoot(p.ChainCfg, block.Height, block.Txs), header.TxHash) ||
+				!bytes.Equal(block.Hash(p.ChainCfg), header.Hash) {
+				return nil, errBodyMismatch
+			}
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks, nil
+}
+
+//bodyScheduler 分配待下载的区块体高度区间, 下载失败的区间重新分配给其他节点
+type bodyScheduler struct {
+	mtx         sync.Mutex
+	next        int64
+	end         int64
+	retry       []*types.ReqBlocks
+	downloading int
+	done        map[int64]bool
+}
+
+//take 获取不超过window个区块的下载区间, 没有可分配的区间时返回nil
+func (s *bodyScheduler) take(window int64) *types.ReqBlocks {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	var r *types.ReqBlocks
+	if len(s.retry) > 0 {
+		r = s.retry[0]
+		if r.End-r.Start+1 > window {
+			s.retry[0] = &types.ReqBlocks{Start: r.Start + window, End: r.End}
+			r = &types.ReqBlocks{Start: r.Start, End: r.Start + window - 1}
+		} else {
+			s.retry = s.retry[1:]
+		}
+	} else if s.next <= s.end {
+		r = &types.ReqBlocks{Start: s.next, End: s.next + window - 1}
+		if r.End > s.end {
+			r.End = s.end
+		}
+		s.next = r.End + 1
+	} else {
+		return nil
+	}
+	s.downloading++
+	return r
+}
+
+//finish 下载区间结束, 失败时放回等待重新分配
+func (s *bodyScheduler) finish(r *types.ReqBlocks, ok bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.downloading--
+	if !ok {
+		s.retry = append(s.retry, r)
+		return
+	}
+	for height := r.Start; height <= r.End; height++ {
+		s.done[height] = true
+	}
+}
+
+//pending 是否还有正在下载或等待分配的区间
+func (s *bodyScheduler) pending() bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.downloading > 0 || len(s.retry) > 0 || s.next <= s.end
+}
+
+//undone 未能下载成功的区块高度
+func (s *bodyScheduler) undone(start int64) []int64 {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	var heights []int64
+	for height := start; height <= s.end; height++ {
+		if !s.done[height] {
+			heights = append(heights, height)
+		}
+	}
+	return heights
+}
+
+//adjustWindow 按照本次请求测得的吞吐量调整节点的请求窗口
+func adjustWindow(window int64, count int, cost time.Duration) int64 {
+	if cost <= 0 {
+		cost = time.Millisecond
+	}
+	expect := int64(float64(count) * float64(targetBatchTime) / float64(cost))
+	//平滑处理, 避免单次网络抖动导致窗口剧烈变化
+	window = (window + expect) / 2
+	if window < minBodyWindow {
+		window = minBodyWindow
+	}
+	if window > maxBodyWindow {
+		window = maxBodyWindow
+	}
+	return window
+}
+
+//supportBatchPeers 返回支持批量下载协议且高度满足要求的节点, 按时延从低到高排序
+func (p *Protocol) supportBatchPeers(tasks tasks, end int64) []peer.ID {
+	var peers []peer.ID
+	for _, task := range tasks.Sort() {
+		if p.PeerInfoManager.PeerHeight(task.Pid) < end {
+			continue
+		}
+		protocols, err := p.Host.Peerstore().SupportsProtocols(task.Pid, downloadHeaders, downloadBodies)
+		if err != nil || len(protocols) != 2 {
+			continue
+		}
+		peers = append(peers, task.Pid)
+	}
+	return peers
+}
+
+//batchDownload 先下载连续的区块头并校验链接关系, 再从多个节点并行批量下载区块体,
+//返回未能下载的区块高度, 由逐个区块下载的方式补充
+func (p *Protocol) batchDownload(req *types.ReqBlocks, tasks tasks, parent trace.SpanContext) (undone []int64) {
+	start, end := req.GetStart(), req.GetEnd()
+	span := trace.StartSpan("p2p batch download blocks", parent)
+	span.SetAttribute(trace.AttrBlockHeight, fmt.Sprintf("%d-%d", start, end))
+	defer func() {
+		span.SetAttribute("undone", fmt.Sprint(len(undone)))
+		span.End()
+	}()
+	all := make([]int64, 0, end-start+1)
+	for height := start; height <= end; height++ {
+		all = append(all, height)
+	}
+	peers := p.supportBatchPeers(tasks, end)
+	if len(peers) == 0 {
+		return all
+	}
+	headers, err := p.fetchHeaders(start, end, peers)
+	if err != nil {
+		span.SetError(err)
+		return all
+	}
+
+	scheduler := &bodyScheduler{next: start, end: end, done: make(map[int64]bool)}
+	var wg sync.WaitGroup
+	for _, pid := range peers {
+		wg.Add(1)
+		go func(pid peer.ID) {
+			defer wg.Done()
+			p.downloadBodies(pid, start, headers, scheduler, span.Context())
+		}(pid)
+	}
+	wg.Wait()
+	return scheduler.undone(start)
+}
+
+//downloadBodies 从单个节点循环下载区块体, 出错后该节点退出本次下载
+func (p *Protocol) downloadBodies(pid peer.ID, start int64, headers []*types.Header, scheduler *bodyScheduler, parent trace.SpanContext) {
+	window := int64(initBodyWindow)
+	remotePid := pid.Pretty()
+	for p.Ctx.Err() == nil {
+		r := scheduler.take(window)
+		if r == nil {
+			if !scheduler.pending() {
+				return
+			}
+			//其他节点的下载可能失败, 等待重新分配
+			time.Sleep(time.Millisecond * 100)
+			continue
+		}
+		begin := time.Now()
+		blocks, err := p.fetchBodiesFromPeer(headers[r.Start-start:r.End-start+1], pid)
+		if err != nil {
+			scheduler.finish(r, false)
+			log.Error("downloadBodies", "pid", pid, "start", r.Start, "end", r.End, "err", err)
+			if err == errBodyMismatch {
+				p.PeerScores.Report(pid, p2pty.ScoreInvalidBlock)
+			} else if p.Ctx.Err() == nil {
+				p.PeerScores.Report(pid, p2pty.ScoreDownloadTimeout)
+			}
+			return
+		}
+		cost := time.Since(begin)
+		for _, block := range blocks {
+			msg := queue.WithTrace(p.QueueClient, parent).NewMessage("blockchain", types.EventSyncBlock, &types.BlockPid{Pid: remotePid, Block: block})
+			_ = p.QueueClient.Send(msg, false)
+		}
+		scheduler.finish(r, true)
+		p.PeerScores.Report(pid, p2pty.ScoreValidMsg)
+		window = adjustWindow(window, len(blocks), cost)
+		log.Debug("downloadBodies", "pid", pid, "start", r.Start, "end", r.End, "cost", cost, "window", window)
+	}
+}
@@ -0,0 +1,173 @@
+package download
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/common/merkle"
+	"github.com/33cn/chain33/common/trace"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/system/p2p/dht/manage"
+	"github.com/33cn/chain33/system/p2p/dht/protocol"
+	p2pty "github.com/33cn/chain33/system/p2p/dht/types"
+	"github.com/33cn/chain33/types"
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBodyScheduler(t *testing.T) {
+	s := &bodyScheduler{next: 1, end: 10, done: make(map[int64]bool)}
+	r1 := s.take(4)
+	require.Equal(t, int64(1), r1.Start)
+	require.Equal(t, int64(4), r1.End)
+	r2 := s.take(8)
+	require.Equal(t, int64(5), r2.Start)
+	require.Equal(t, int64(10), r2.End)
+	require.Nil(t, s.take(4))
+	require.True(t, s.pending())
+
+	//失败的区间优先重新分配, 按照窗口拆分
+	s.finish(r2, false)
+	s.finish(r1, true)
+	r3 := s.take(4)
+	require.Equal(t, int64(5), r3.Start)
+	require.Equal(t, int64(8), r3.End)
+	s.finish(r3, true)
+	require.Equal(t, []int64{9, 10}, s.undone(1))
+	r4 := s.take(4)
+	require.Equal(t, int64(9), r4.Start)
+	require.Equal(t, int64(10), r4.End)
+	s.finish(r4, true)
+	require.False(t, s.pending())
+	require.Equal(t, 0, len(s.undone(1)))
+
+	require.Equal(t, int64(minBodyWindow), adjustWindow(minBodyWindow, 1, time.Second))
+	require.Equal(t, int64(maxBodyWindow), adjustWindow(maxBodyWindow, 1000, time.Second))
+	require.Equal(t, int64(24), adjustWindow(initBodyWindow, 16, time.Second/2))
+}
+
+func TestCheckHeaders(t *testing.T) {
+	cfg := types.NewChain33Config(types.ReadFile("../../../../../cmd/chain33/chain33.test.toml"))
+	blocks := testChain(cfg, 5)
+	headers := make([]*types.Header, 0, len(blocks))
+	for _, block := range blocks {
+		headers = append(headers, block.GetHeader(cfg))
+	}
+	require.Nil(t, checkHeaders(0, headers))
+	require.Equal(t, errHeaderLinkage, checkHeaders(1, headers))
+	headers[2], headers[3] = headers[3], headers[2]
+	require.Equal(t, errHeaderLinkage, checkHeaders(0, headers))
+}
+
+//testChain 生成指定数量的区块, 区块之间通过parentHash链接
+func testChain(cfg *types.Chain33Config, count int) []*types.Block {
+	var blocks []*types.Block
+	var parent []byte
+	for height := int64(0); height < int64(count); height++ {
+		block := &types.Block{Height: height, ParentHash: parent, BlockTime: height}
+		for i := 0; i < 3; i++ {
+			block.Txs = append(block.Txs, &types.Transaction{Execer: []byte("coins"), Nonce: height*10 + int64(i)})
+		}
+		block.TxHash = merkle.CalcMerkleRoot(cfg, height, block.Txs)
+		parent = block.Hash(cfg)
+		blocks = append(blocks, block)
+	}
+	return blocks
+}
+
+func TestBatchDownload(t *testing.T) {
+	cfg := types.NewChain33Config(types.ReadFile("../../../../../cmd/chain33/chain33.test.toml"))
+	mcfg := &p2pty.P2PSubConfig{}
+	types.MustDecode(cfg.GetSubConfig().P2P[p2pty.DHTTypeName], mcfg)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	q := queue.New("test")
+	blocks := testChain(cfg, 300)
+
+	var protocols []*Protocol
+	for _, port := range []int{13808, 13809} {
+		m, err := multiaddr.NewMultiaddr(fmt.Sprintf("/ip4/127.0.0.1/tcp/%d", port))
+		require.Nil(t, err)
+		h, err := libp2p.New(ctx, libp2p.ListenAddrs(m))
+		require.Nil(t, err)
+		defer h.Close()
+		env := &protocol.P2PEnv{
+			Ctx:             ctx,
+			ChainCfg:        cfg,
+			QueueClient:     q.Client(),
+			Host:            h,
+			SubConfig:       mcfg,
+			PeerInfoManager: &peerInfoManager{},
+			PeerScores:      manage.NewPeerScoreManager(ctx, nil, nil),
+		}
+		p := &Protocol{P2PEnv: env}
+		protocol.RegisterStreamHandler(h, downloadHeaders, p.handleStreamDownloadHeaders)
+		protocol.RegisterStreamHandler(h, downloadBodies, p.handleStreamDownloadBodies)
+		protocols = append(protocols, p)
+	}
+	p1, p2 := protocols[0], protocols[1]
+	require.Nil(t, p2.Host.Connect(ctx, peer.AddrInfo{ID: p1.Host.ID(), Addrs: p1.Host.Addrs()}))
+
+	var mtx sync.Mutex
+	synced := make(map[int64]bool)
+	client := q.Client()
+	client.Sub("blockchain")
+	go func() {
+		for msg := range client.Recv() {
+			req, _ := msg.GetData().(*types.ReqBlocks)
+			switch msg.Ty {
+			case types.EventGetHeaders:
+				headers := &types.Headers{}
+				for height := req.Start; height <= req.End; height++ {
+					headers.Items = append(headers.Items, blocks[height].GetHeader(cfg))
+				}
+				msg.Reply(client.NewMessage("", types.EventHeaders, headers))
+			case types.EventGetBlocks:
+				details := &types.BlockDetails{}
+				for height := req.Start; height <= req.End; height++ {
+					details.Items = append(details.Items, &types.BlockDetail{Block: blocks[height]})
+				}
+				msg.Reply(client.NewMessage("", types.EventBlocks, details))
+			case types.EventSyncBlock:
+				block := msg.GetData().(*types.BlockPid).Block
+				require.Equal(t, blocks[block.Height].Hash(cfg), block.Hash(cfg))
+				mtx.Lock()
+				synced[block.Height] = true
+				mtx.Unlock()
+			}
+		}
+	}()
+
+	jobs := tasks{&taskInfo{Pid: p1.Host.ID()}}
+	for i := 0; i < 100 && len(p2.supportBatchPeers(jobs, 299)) == 0; i++ {
+		time.Sleep(time.Millisecond * 50)
+	}
+	require.Equal(t, 1, len(p2.supportBatchPeers(jobs, 299)))
+
+	undone := p2.batchDownload(&types.ReqBlocks{Start: 1, End: 299}, jobs, trace.SpanContext{})
+	require.Equal(t, 0, len(undone))
+	for i := 0; i < 100; i++ {
+		mtx.Lock()
+		count := len(synced)
+		mtx.Unlock()
+		if count == 299 {
+			break
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+	mtx.Lock()
+	require.Equal(t, 299, len(synced))
+	mtx.Unlock()
+	require.Equal(t, int32(0), p2.PeerScores.Score(p1.Host.ID()))
+
+	//区块体和区块头不一致时扣分, 返回未下载的高度
+	blocks[100].Txs = blocks[100].Txs[1:]
+	undone = p2.batchDownload(&types.ReqBlocks{Start: 100, End: 103}, jobs, trace.SpanContext{})
+	require.Equal(t, []int64{100, 101, 102, 103}, undone)
+	require.True(t, p2.PeerScores.Score(p1.Host.ID()) < 0)
+}
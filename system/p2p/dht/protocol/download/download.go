@@ -3,12 +3,17 @@ package download
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/common/trace"
+	"github.com/33cn/chain33/queue"
 	"github.com/33cn/chain33/system/p2p/dht/protocol"
+	p2pty "github.com/33cn/chain33/system/p2p/dht/types"
 	"github.com/33cn/chain33/types"
 	"github.com/libp2p/go-libp2p-core/peer"
+	core "github.com/libp2p/go-libp2p-core/protocol"
 )
 
 var (
@@ -23,6 +28,9 @@ const (
 	// Deprecated: old version, use downloadBlock instead
 	downloadBlockOld = "/chain33/downloadBlockReq/1.0.0"
 	downloadBlock    = "/chain33/download-block/1.0.0"
+	//批量下载, 先下载区块头再并行下载区块体
+	downloadHeaders = "/chain33/download-headers/1.0.0"
+	downloadBodies  = "/chain33/download-bodies/1.0.0"
 )
 
 // Protocol ...
@@ -38,13 +46,24 @@ func InitProtocol(env *protocol.P2PEnv) {
 	//注册p2p通信协议，用于处理节点之间请求
 	protocol.RegisterStreamHandler(p.Host, downloadBlockOld, p.handleStreamDownloadBlockOld)
 	protocol.RegisterStreamHandler(p.Host, downloadBlock, p.handleStreamDownloadBlock)
+	protocol.RegisterStreamHandler(p.Host, downloadHeaders, p.handleStreamDownloadHeaders)
+	protocol.RegisterStreamHandler(p.Host, downloadBodies, p.handleStreamDownloadBodies)
+	for _, id := range []core.ID{downloadBlockOld, downloadBlock, downloadHeaders, downloadBodies} {
+		protocol.RegisterProtocolPriority(id, protocol.PriorityDownload)
+	}
 	//注册事件处理函数
 	protocol.RegisterEventHandler(types.EventFetchBlocks, p.handleEventDownloadBlock)
 
 }
 
-func (p *Protocol) downloadBlock(height int64, tasks tasks) error {
+func (p *Protocol) downloadBlock(height int64, tasks tasks, parent trace.SpanContext) (err error) {
 
+	span := trace.StartSpan("p2p download block", parent)
+	span.SetAttribute(trace.AttrBlockHeight, fmt.Sprint(height))
+	defer func() {
+		span.SetError(err)
+		span.End()
+	}()
 	var retryCount uint
 	tasks.Sort() //对任务节点时延进行排序，优先选择时延低的节点进行下载
 ReDownload:
@@ -72,9 +91,12 @@ ReDownload:
 	}
 
 	var downloadStart = time.Now().UnixNano()
-	block, err := p.downloadBlockFromPeerOld(height, task.Pid)
+	block, err := p.downloadBlockFromPeerOld(height, task.Pid, span.Context())
 	if err != nil {
 		log.Error("handleEventDownloadBlock", "SendRecvPeer", err, "pid", task.Pid)
+		if p.Ctx.Err() == nil {
+			p.PeerScores.Report(task.Pid, p2pty.ScoreDownloadTimeout)
+		}
 		p.releaseJob(task)
 		tasks = tasks.Remove(task)
 		goto ReDownload
@@ -85,8 +107,9 @@ ReDownload:
 	log.Debug("download+++++", "from", remotePid, "height", block.GetHeight(),
 		"blockSize (bytes)", block.Size(), "costTime ms", costTime)
 
-	msg := p.QueueClient.NewMessage("blockchain", types.EventSyncBlock, &types.BlockPid{Pid: remotePid, Block: block}) //加入到输出通道)
+	msg := queue.WithTrace(p.QueueClient, span.Context()).NewMessage("blockchain", types.EventSyncBlock, &types.BlockPid{Pid: remotePid, Block: block}) //加入到输出通道)
 	_ = p.QueueClient.Send(msg, false)
+	p.PeerScores.Report(task.Pid, p2pty.ScoreValidMsg)
 	p.releaseJob(task)
 
 	return nil
@@ -115,7 +138,7 @@ func (p *Protocol) downloadBlockFromPeer(height int64, pid peer.ID) (*types.Bloc
 	return &block, nil
 }
 
-func (p *Protocol) downloadBlockFromPeerOld(height int64, pid peer.ID) (*types.Block, error) {
+func (p *Protocol) downloadBlockFromPeerOld(height int64, pid peer.ID, parent trace.SpanContext) (*types.Block, error) {
 	ctx, cancel := context.WithTimeout(p.Ctx, time.Second*10)
 	defer cancel()
 	p.Host.ConnManager().Protect(pid, downloadBlockOld)
@@ -126,6 +149,7 @@ func (p *Protocol) downloadBlockFromPeerOld(height int64, pid peer.ID) (*types.B
 	}
 	defer stream.Close()
 	blockReq := types.MessageGetBlocksReq{
+		MessageData: &types.MessageComm{Traceparent: parent.Traceparent()},
 		Message: &types.P2PGetBlocks{
 			StartHeight: height,
 			EndHeight:   height,
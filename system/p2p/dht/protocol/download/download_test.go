@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/system/p2p/dht/manage"
 	"github.com/33cn/chain33/system/p2p/dht/protocol"
 	p2pty "github.com/33cn/chain33/system/p2p/dht/types"
 	"github.com/33cn/chain33/types"
@@ -61,6 +62,7 @@ func initEnv(t *testing.T, q queue.Queue) *Protocol {
 		SubConfig:       mcfg,
 		RoutingTable:    kademliaDHT1.RoutingTable(),
 		PeerInfoManager: &peerInfoManager{},
+		PeerScores:      manage.NewPeerScoreManager(context.Background(), nil, nil),
 	}
 	InitProtocol(&env1)
 
@@ -83,6 +85,7 @@ func initEnv(t *testing.T, q queue.Queue) *Protocol {
 		SubConfig:       mcfg,
 		RoutingTable:    kademliaDHT2.RoutingTable(),
 		PeerInfoManager: &peerInfoManager{},
+		PeerScores:      manage.NewPeerScoreManager(context.Background(), nil, nil),
 	}
 	p2 := &Protocol{
 		P2PEnv: &env2,
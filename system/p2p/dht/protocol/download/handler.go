@@ -64,8 +64,10 @@ func (p *Protocol) handleStreamDownloadBlockOld(stream network.Stream) {
 		log.Error("handleStreamDownloadBlock", "error", "wrong parameter")
 		return
 	}
+	span := protocol.StartStreamSpan(stream, data.GetMessageData().GetTraceparent())
+	defer span.End()
 
-	msg := p.QueueClient.NewMessage("blockchain", types.EventGetBlocks, &req)
+	msg := queue.WithTrace(p.QueueClient, span.Context()).NewMessage("blockchain", types.EventGetBlocks, &req)
 	err = p.QueueClient.Send(msg, true)
 	if err != nil {
 		return
@@ -127,7 +129,9 @@ func (p *Protocol) handleEventDownloadBlock(msg *queue.Message) {
 	var reDownload = make(map[string]interface{})
 	var startTime = time.Now().UnixNano()
 
-	for height := req.GetStart(); height <= req.GetEnd(); height++ {
+	//优先从支持批量下载的节点下载, 未完成的区块再逐个下载
+	heights := p.batchDownload(req, jobS, msg.Trace)
+	for _, height := range heights {
 		wg.Add(1)
 	Wait:
 		if atomic.LoadInt32(&maxGoroutine) > 50 {
@@ -136,7 +140,7 @@ func (p *Protocol) handleEventDownloadBlock(msg *queue.Message) {
 		}
 		atomic.AddInt32(&maxGoroutine, 1)
 		go func(blockheight int64, tasks tasks) {
-			err := p.downloadBlock(blockheight, tasks)
+			err := p.downloadBlock(blockheight, tasks, msg.Trace)
 			if err != nil {
 				mutex.Lock()
 				defer mutex.Unlock()
@@ -168,7 +172,7 @@ func (p *Protocol) handleEventDownloadBlock(msg *queue.Message) {
 	}
 
 	wg.Wait()
-	p.checkTask(taskID, pids, reDownload)
+	p.checkTask(taskID, pids, reDownload, msg.Trace)
 	log.Debug("Download Job Complete!", "TaskID++++++++++++++", taskID,
 		"cost time", fmt.Sprintf("cost time:%d ms", (time.Now().UnixNano()-startTime)/1e6),
 		"from", pids)
@@ -5,6 +5,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/33cn/chain33/common/trace"
 	"github.com/libp2p/go-libp2p-core/peer"
 )
 
@@ -88,7 +89,7 @@ func (p *Protocol) initJob(pids []string, jobID string) tasks {
 	return JobPeerIds
 }
 
-func (p *Protocol) checkTask(taskID string, pids []string, faildJobs map[string]interface{}) {
+func (p *Protocol) checkTask(taskID string, pids []string, faildJobs map[string]interface{}, parent trace.SpanContext) {
 
 	select {
 	case <-p.Ctx.Done():
@@ -107,7 +108,7 @@ func (p *Protocol) checkTask(taskID string, pids []string, faildJobs map[string]
 	for blockheight := range faildJob {
 		jobS := p.initJob(pids, taskID)
 		log.Warn("checkTask<<<<<<<<<<", "taskID", taskID, "faildJob", blockheight)
-		p.downloadBlock(blockheight, jobS)
+		p.downloadBlock(blockheight, jobS, parent)
 
 	}
 }
@@ -317,7 +317,7 @@ func (p *Protocol) handleStreamFetchChunk(stream network.Stream) {
 
 func (p *Protocol) handleStreamGetHeader(req *types.P2PRequest, res *types.P2PResponse) error {
 	param := req.Request.(*types.P2PRequest_ReqBlocks)
-	msg := p.QueueClient.NewMessage("blockchain", types.EventGetHeaders, param.ReqBlocks)
+	msg := protocol.TraceClient(p.QueueClient, req).NewMessage("blockchain", types.EventGetHeaders, param.ReqBlocks)
 	err := p.QueueClient.Send(msg, true)
 	if err != nil {
 		return err
@@ -334,6 +334,27 @@ func (p *Protocol) handleStreamGetHeader(req *types.P2PRequest, res *types.P2PRe
 	return types.ErrNotFound
 }
 
+func (p *Protocol) handleStreamFetchTxProof(req *types.P2PRequest, res *types.P2PResponse) error {
+	param, ok := req.Request.(*types.P2PRequest_ReqTxProof)
+	if !ok {
+		return types2.ErrInvalidParam
+	}
+	msg := protocol.TraceClient(p.QueueClient, req).NewMessage("blockchain", types.EventQueryTx, param.ReqTxProof)
+	err := p.QueueClient.Send(msg, true)
+	if err != nil {
+		return err
+	}
+	resp, err := p.QueueClient.Wait(msg)
+	if err != nil {
+		return err
+	}
+	if detail, ok := resp.GetData().(*types.TransactionDetail); ok {
+		res.Response = &types.P2PResponse_TxDetail{TxDetail: detail}
+		return nil
+	}
+	return types.ErrNotFound
+}
+
 func (p *Protocol) handleStreamGetHeaderOld(stream network.Stream) {
 	var req types.MessageHeaderReq
 	err := protocol.ReadStream(&req, stream)
@@ -472,6 +493,17 @@ func (p *Protocol) handleEventGetHeaders(m *queue.Message) {
 	_, _ = p.QueueClient.WaitTimeout(msg, time.Second)
 }
 
+func (p *Protocol) handleEventFetchTxProof(m *queue.Message) {
+	req := m.GetData().(*types.ReqHash)
+	detail, err := p.fetchTxProof(req)
+	if err != nil {
+		log.Error("handleEventFetchTxProof", "hash", hex.EncodeToString(req.GetHash()), "error", err)
+		m.Reply(p.QueueClient.NewMessage("blockchain", types.EventTransactionDetail, err))
+		return
+	}
+	m.Reply(p.QueueClient.NewMessage("blockchain", types.EventTransactionDetail, detail))
+}
+
 func writeBodys(bodys *types.BlockBodys, stream network.Stream) {
 	if bodys == nil {
 		return
@@ -22,6 +22,7 @@ const (
 	getChunkRecord = "/chain33/chunk-record/1.0.0"
 	fullNode       = "/chain33/full-node/1.0.0"
 	fetchPeerAddr  = "/chain33/fetch-peer-addr/1.0.0"
+	fetchTxProof   = "/chain33/tx-proof/1.0.0"
 	// Deprecated: old version, use getHeader instead
 	getHeaderOld = "/chain33/headerinfoReq/1.0.0"
 
@@ -105,17 +106,27 @@ func InitProtocol(env *protocol.P2PEnv) {
 	protocol.RegisterStreamHandler(p.Host, fetchShardPeer, protocol.HandlerWithRW(p.handleStreamFetchShardPeers))
 	protocol.RegisterStreamHandler(p.Host, getHeaderOld, p.handleStreamGetHeaderOld)
 	protocol.RegisterStreamHandler(p.Host, getHeader, protocol.HandlerWithAuthAndSign(p.handleStreamGetHeader))
+	//轻节点本身没有交易数据，不对外提供交易证明
+	if !p.ChainCfg.GetModuleConfig().BlockChain.LightMode {
+		protocol.RegisterStreamHandler(p.Host, fetchTxProof, protocol.HandlerWithAuthAndSign(p.handleStreamFetchTxProof))
+	}
 	if !p.SubConfig.DisableShard {
 		protocol.RegisterStreamHandler(p.Host, fullNode, protocol.HandlerWithWrite(p.handleStreamIsFullNode))
 		protocol.RegisterStreamHandler(p.Host, fetchChunk, p.handleStreamFetchChunk) //数据较大，采用特殊写入方式
 		protocol.RegisterStreamHandler(p.Host, getChunkRecord, protocol.HandlerWithAuthAndSign(p.handleStreamGetChunkRecord))
 	}
+	//chunk数据传输优先级最低, 避免占用区块广播和下载的带宽
+	protocol.RegisterProtocolPriority(fetchChunk, protocol.PriorityStore)
+	protocol.RegisterProtocolPriority(getHeader, protocol.PriorityStore)
+	protocol.RegisterProtocolPriority(getHeaderOld, protocol.PriorityStore)
+	protocol.RegisterProtocolPriority(fetchTxProof, protocol.PriorityStore)
 	//同时注册eventHandler，用于处理blockchain模块发来的请求
 	protocol.RegisterEventHandler(types.EventNotifyStoreChunk, p.handleEventNotifyStoreChunk)
 	protocol.RegisterEventHandler(types.EventGetChunkBlock, p.handleEventGetChunkBlock)
 	protocol.RegisterEventHandler(types.EventGetChunkBlockBody, p.handleEventGetChunkBlockBody)
 	protocol.RegisterEventHandler(types.EventGetChunkRecord, p.handleEventGetChunkRecord)
 	protocol.RegisterEventHandler(types.EventFetchBlockHeaders, p.handleEventGetHeaders)
+	protocol.RegisterEventHandler(types.EventFetchTxProof, p.handleEventFetchTxProof)
 
 	go p.processLocalChunk()
 	go p.updateRoutine()
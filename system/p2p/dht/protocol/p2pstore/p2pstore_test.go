@@ -11,6 +11,7 @@ import (
 	"github.com/33cn/chain33/client"
 	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/system/p2p/dht/manage"
 	"github.com/33cn/chain33/system/p2p/dht/protocol"
 	types2 "github.com/33cn/chain33/system/p2p/dht/types"
 	"github.com/33cn/chain33/types"
@@ -144,6 +145,13 @@ func TestInit(t *testing.T) {
 	require.Equal(t, p2.Host.ID().Pretty(), msg.Data.(*types.HeadersPid).GetPid())
 	require.Equal(t, 100, len(msg.Data.(*types.HeadersPid).GetHeaders().Items))
 
+	//轻节点通过peer获取交易证明
+	msg, err = testFetchTxProof(t, client, p2pA, &types.ReqHash{Hash: []byte("txhash")})
+	require.Nil(t, err)
+	require.Equal(t, int64(100), msg.Data.(*types.TransactionDetail).GetHeight())
+	_, err = testFetchTxProof(t, client, p2pA, &types.ReqHash{})
+	require.Equal(t, types.ErrTxNotExist, err)
+
 	//　更新数据后应该能查到数据
 	p2.refreshLocalChunk()
 	for i := 0; i < 14; i++ {
@@ -204,6 +212,15 @@ func testGetHeaders(t *testing.T, client queue.Client, topic string, req *types.
 	return msg
 }
 
+func testFetchTxProof(t *testing.T, client queue.Client, topic string, req *types.ReqHash) (*queue.Message, error) {
+	msg := client.NewMessage(topic, types.EventFetchTxProof, req)
+	err := client.Send(msg, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client.Wait(msg)
+}
+
 func initMockBlockchain(q queue.Queue) <-chan *queue.Message {
 	client := q.Client()
 	client.Sub("blockchain")
@@ -236,6 +253,13 @@ func initMockBlockchain(q queue.Queue) <-chan *queue.Message {
 					Items: items,
 				}
 				msg.Reply(queue.NewMessage(0, "", 0, headers))
+			case types.EventQueryTx:
+				req := msg.Data.(*types.ReqHash)
+				if len(req.Hash) == 0 {
+					msg.Reply(queue.NewMessage(0, "", 0, types.ErrTxNotExist))
+					break
+				}
+				msg.Reply(queue.NewMessage(0, "", 0, &types.TransactionDetail{Tx: &types.Transaction{Execer: []byte("coins")}, Height: 100}))
 			case types.EventGetChunkRecord:
 				req := msg.Data.(*types.ReqChunkRecords)
 				if req.Start > req.End || req.End > blockHeight/chunkNum {
@@ -285,6 +309,7 @@ func makeProtocol(name string, q queue.Queue, h host.Host) *Protocol {
 		SubConfig:       mcfg,
 		RoutingTable:    rt,
 		PeerInfoManager: &peerInfoManager{},
+		PeerScores:      manage.NewPeerScoreManager(context.Background(), nil, nil),
 		DB:              dbm.NewDB(name, "leveldb", dataDir, 128),
 		Discovery:       &defaultDiscovery{},
 	}
@@ -315,6 +340,7 @@ func makeProtocol(name string, q queue.Queue, h host.Host) *Protocol {
 	protocol.RegisterStreamHandler(p.Host, fetchChunk, p.handleStreamFetchChunk) //数据较大，采用特殊写入方式
 	protocol.RegisterStreamHandler(p.Host, getHeader, protocol.HandlerWithAuthAndSign(p.handleStreamGetHeader))
 	protocol.RegisterStreamHandler(p.Host, getChunkRecord, protocol.HandlerWithAuthAndSign(p.handleStreamGetChunkRecord))
+	protocol.RegisterStreamHandler(p.Host, fetchTxProof, protocol.HandlerWithAuthAndSign(p.handleStreamFetchTxProof))
 
 	cli.Sub(name)
 
@@ -331,6 +357,8 @@ func makeProtocol(name string, q queue.Queue, h host.Host) *Protocol {
 				protocol.EventHandlerWithRecover(p.handleEventGetChunkRecord)(msg)
 			case types.EventFetchBlockHeaders:
 				protocol.EventHandlerWithRecover(p.handleEventGetHeaders)(msg)
+			case types.EventFetchTxProof:
+				protocol.EventHandlerWithRecover(p.handleEventFetchTxProof)(msg)
 			}
 		}
 	}()
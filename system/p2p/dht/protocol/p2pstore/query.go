@@ -340,6 +340,55 @@ func (p *Protocol) getHeadersFromPeer(param *types.ReqBlocks, pid peer.ID) (*typ
 	return res.Response.(*types.P2PResponse_BlockHeaders).BlockHeaders, nil
 }
 
+//fetchTxProof 依次向连接的节点请求交易详情，返回第一个包含该交易的结果，merkle证明由blockchain模块验证
+func (p *Protocol) fetchTxProof(param *types.ReqHash) (*types.TransactionDetail, error) {
+	for _, pid := range p.RoutingTable.ListPeers() {
+		detail, err := p.fetchTxProofFromPeer(param, pid)
+		if err != nil {
+			log.Debug("fetchTxProof", "peer", pid, "error", err)
+			continue
+		}
+		if detail.GetTx() == nil {
+			continue
+		}
+		return detail, nil
+	}
+	return nil, types.ErrTxNotExist
+}
+
+func (p *Protocol) fetchTxProofFromPeer(param *types.ReqHash, pid peer.ID) (*types.TransactionDetail, error) {
+	childCtx, cancel := context.WithTimeout(p.Ctx, time.Second*3)
+	defer cancel()
+	stream, err := p.Host.NewStream(childCtx, pid, fetchTxProof)
+	if err != nil {
+		return nil, err
+	}
+	_ = stream.SetDeadline(time.Now().Add(time.Second * 10))
+	defer stream.Close()
+	msg := types.P2PRequest{
+		Request: &types.P2PRequest_ReqTxProof{
+			ReqTxProof: param,
+		},
+	}
+	err = protocol.SignAndWriteStream(&msg, stream)
+	if err != nil {
+		return nil, err
+	}
+	var res types.P2PResponse
+	err = protocol.ReadStreamAndAuthenticate(&res, stream)
+	if err != nil {
+		return nil, err
+	}
+	if res.Error != "" {
+		return nil, errors.New(res.Error)
+	}
+	detail, ok := res.Response.(*types.P2PResponse_TxDetail)
+	if !ok {
+		return nil, types2.ErrInvalidResponse
+	}
+	return detail.TxDetail, nil
+}
+
 func (p *Protocol) getChunkRecords(param *types.ReqChunkRecords) *types.ChunkRecords {
 	for _, sPid := range param.Pid {
 		pid, err := peer.Decode(sPid)
@@ -601,6 +650,12 @@ func (p *Protocol) fetchChunkFromPeer(params *types.ChunkInfoMsg, pid peer.ID) (
 			Items: bodys,
 		}, closerPeers, nil
 	}
+	//返回了部分或多余的区块数据, 属于格式错误的chunk
+	if len(bodys) != 0 {
+		log.Error("fetchChunkFromPeer", "invalid chunk from", pid, "start", params.Start, "end", params.End, "body length", len(bodys))
+		p.PeerScores.Report(pid, types2.ScoreInvalidChunk)
+		return nil, nil, types2.ErrLength
+	}
 
 	if len(closerPeers) == 0 {
 		return nil, nil, fmt.Errorf(res.Error)
@@ -15,6 +15,7 @@ import (
 
 	"github.com/33cn/chain33/queue"
 	"github.com/33cn/chain33/system/p2p/dht/protocol"
+	types2 "github.com/33cn/chain33/system/p2p/dht/types"
 	"github.com/33cn/chain33/types"
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/multiformats/go-multiaddr"
@@ -40,8 +41,8 @@ func (p *Protocol) handleStreamVersion(stream network.Stream) {
 		return
 	}
 	if req.GetVersion() != p.SubConfig.Channel {
-		// 不是同一条链，拉黑且断开连接
-		p.ConnBlackList.Add(stream.Conn().RemotePeer().Pretty(), time.Hour*24)
+		// 不是同一条链，扣分禁止且断开连接
+		p.PeerScores.Report(stream.Conn().RemotePeer(), types2.ScoreVersionMismatch)
 		_ = stream.Conn().Close()
 		return
 	}
@@ -110,8 +111,8 @@ func (p *Protocol) handleStreamVersionOld(stream network.Stream) {
 	}
 	msg := req.Message
 	if msg.GetVersion() != p.SubConfig.Channel {
-		// 不是同一条链，拉黑且断开连接
-		p.ConnBlackList.Add(stream.Conn().RemotePeer().Pretty(), time.Hour*24)
+		// 不是同一条链，扣分禁止且断开连接
+		p.PeerScores.Report(stream.Conn().RemotePeer(), types2.ScoreVersionMismatch)
 		_ = stream.Conn().Close()
 		return
 	}
@@ -147,6 +148,8 @@ func (p *Protocol) handleEventPeerInfo(msg *queue.Message) {
 	var peerList types.PeerList
 	for _, pid := range peers {
 		if info := p.PeerInfoManager.Fetch(pid); info != nil {
+			info = types.Clone(info).(*types.Peer)
+			info.Score = p.PeerScores.Score(pid)
 			peerList.Peers = append(peerList.Peers, info)
 		}
 	}
@@ -191,6 +194,7 @@ func (p *Protocol) handleEventNetInfo(msg *queue.Message) {
 	netinfo.Ratein = p.ConnManager.RateCalculate(netstat.RateIn)
 	netinfo.Rateout = p.ConnManager.RateCalculate(netstat.RateOut)
 	netinfo.Ratetotal = p.ConnManager.RateCalculate(netstat.RateOut + netstat.RateIn)
+	netinfo.Bandwidth, netinfo.ProtocolBandwidth = protocol.GetBandwidthLimiter().Usage()
 	msg.Reply(p.QueueClient.NewMessage("rpc", types.EventReplyNetInfo, &netinfo))
 }
 
@@ -257,9 +261,19 @@ func (p *Protocol) handleEventDelBlacklist(msg *queue.Message) {
 		msg.Reply(p.QueueClient.NewMessage("rpc", types.EventReply, &types.Reply{IsOk: true, Msg: []byte("success")}))
 		return
 	}
+	//同时清除节点评分导致的禁止
+	if pid, e := peer.Decode(blackPeer.GetPeerName()); e == nil && p.PeerScores.Reset(pid) {
+		msg.Reply(p.QueueClient.NewMessage("rpc", types.EventReply, &types.Reply{IsOk: true, Msg: []byte("success")}))
+		return
+	}
 	err = errors.New("no this peerName")
 }
 
+//show scores of all misbehaving peers
+func (p *Protocol) handleEventPeerScores(msg *queue.Message) {
+	msg.Reply(p.QueueClient.NewMessage("rpc", types.EventPeerScores, p.PeerScores.List()))
+}
+
 //show all peers from blacklist
 func (p *Protocol) handleEventShowBlacklist(msg *queue.Message) {
 	peers := p.P2PEnv.ConnBlackList.List()
@@ -125,6 +125,8 @@ func InitProtocol(env *protocol.P2PEnv) {
 	protocol.RegisterEventHandler(types.EventDelBlacklist, p.handleEventDelBlacklist)
 	//获取当前的黑名单节点列表
 	protocol.RegisterEventHandler(types.EventShowBlacklist, p.handleEventShowBlacklist)
+	//获取节点评分列表
+	protocol.RegisterEventHandler(types.EventPeerScores, p.handleEventPeerScores)
 	//连接指定的节点
 	protocol.RegisterEventHandler(types.EventDialPeer, p.handleEventDialPeer)
 	//关闭指定的节点
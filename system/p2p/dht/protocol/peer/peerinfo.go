@@ -119,10 +119,8 @@ func (p *Protocol) refreshPeerInfo(peers []peer.ID) {
 			if p.checkVersionLimit(pInfo.GetVersion()) {
 				p.PeerInfoManager.Refresh(pInfo)
 			} else {
-				//add blacklist
-				log.Info("refreshPeerInfo", "AddBlacklist,peerName:", pInfo.GetName(), "version:", pInfo.GetVersion(), "runningTime:", pInfo.GetRunningTime())
-				p.P2PEnv.Host.Network().ClosePeer(peer.ID(pInfo.GetName()))
-				p.P2PEnv.ConnBlackList.Add(pInfo.GetName(), time.Hour*24)
+				log.Info("refreshPeerInfo", "version mismatch, peerName:", pInfo.GetName(), "version:", pInfo.GetVersion(), "runningTime:", pInfo.GetRunningTime())
+				p.PeerScores.Report(pid, types2.ScoreVersionMismatch)
 			}
 
 		}(remoteID)
@@ -16,6 +16,7 @@ import (
 
 	"github.com/33cn/chain33/queue"
 	"github.com/33cn/chain33/system/p2p/dht/extension"
+	"github.com/33cn/chain33/system/p2p/dht/manage"
 	"github.com/33cn/chain33/system/p2p/dht/protocol"
 	p2pty "github.com/33cn/chain33/system/p2p/dht/types"
 	"github.com/33cn/chain33/types"
@@ -75,6 +76,7 @@ func initEnv(t *testing.T, q queue.Queue) (*Protocol, context.CancelFunc) {
 		SubConfig:       mcfg,
 		RoutingTable:    kademliaDHT1.RoutingTable(),
 		PeerInfoManager: &peerInfoManager{},
+		PeerScores:      manage.NewPeerScoreManager(context.Background(), nil, nil),
 		ConnManager:     &connManager{},
 		Pubsub:          ps1,
 	}
@@ -104,6 +106,7 @@ func initEnv(t *testing.T, q queue.Queue) (*Protocol, context.CancelFunc) {
 		SubConfig:       mcfg,
 		RoutingTable:    kademliaDHT2.RoutingTable(),
 		PeerInfoManager: &peerInfoManager{},
+		PeerScores:      manage.NewPeerScoreManager(context.Background(), nil, nil),
 		ConnManager:     &connManager{},
 		Pubsub:          ps2,
 	}
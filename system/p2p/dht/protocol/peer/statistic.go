@@ -26,6 +26,7 @@ func (p *Protocol) handlerStreamStatistical(stream network.Stream) {
 	statistical.Nodeinfo.Ratein = p.ConnManager.RateCalculate(netstat.RateIn)
 	statistical.Nodeinfo.Rateout = p.ConnManager.RateCalculate(netstat.RateOut)
 	statistical.Nodeinfo.Ratetotal = p.ConnManager.RateCalculate(netstat.RateOut + netstat.RateIn)
+	statistical.Nodeinfo.Bandwidth, statistical.Nodeinfo.ProtocolBandwidth = protocol.GetBandwidthLimiter().Usage()
 
 	err := protocol.WriteStream(&statistical, stream)
 	if err != nil {
@@ -32,6 +32,7 @@ type P2PEnv struct {
 	PeerInfoManager IPeerInfoManager
 	ConnManager     IConnManager
 	ConnBlackList   iLRU
+	PeerScores      IPeerScoreManager
 	Pubsub          *extension.PubSub
 	RoutingTable    *kbt.RoutingTable
 	Discovery       discovery.Discovery
@@ -61,6 +62,15 @@ type IConnManager interface {
 	RateCalculate(ratebytes float64) string
 }
 
+// IPeerScoreManager is interface of PeerScoreManager
+type IPeerScoreManager interface {
+	Report(pid peer.ID, reason string)
+	IsBanned(pid peer.ID) bool
+	Score(pid peer.ID) int32
+	Reset(pid peer.ID) bool
+	List() *types.PeerScores
+}
+
 // QueryModule sends message to other module and waits response
 func (p *P2PEnv) QueryModule(topic string, ty int64, data interface{}) (interface{}, error) {
 	msg := p.QueueClient.NewMessage(topic, ty, data)
@@ -0,0 +1,78 @@
+package snapshot
+
+import (
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/system/p2p/dht/protocol"
+	"github.com/33cn/chain33/types"
+	"github.com/libp2p/go-libp2p-core/network"
+)
+
+func (p *Protocol) handleStreamSnapshotInfo(stream network.Stream) {
+	var req types.ReqSnapshotInfo
+	err := protocol.ReadStream(&req, stream)
+	if err != nil {
+		log.Error("handleStreamSnapshotInfo", "err", err)
+		return
+	}
+	info, err := p.getLocalSnapshotInfo(req.GetHeight())
+	if err != nil {
+		log.Error("handleStreamSnapshotInfo", "height", req.GetHeight(), "err", err)
+		return
+	}
+	err = protocol.WriteStream(info, stream)
+	if err != nil {
+		log.Error("WriteStream", "error", err, "remote pid", stream.Conn().RemotePeer().String())
+		return
+	}
+	log.Debug("handleStreamSnapshotInfo", "height", req.GetHeight(), "remote peer", stream.Conn().RemotePeer().String())
+}
+
+func (p *Protocol) handleStreamSnapshotChunk(stream network.Stream) {
+	var req types.ReqSnapshotChunk
+	err := protocol.ReadStream(&req, stream)
+	if err != nil {
+		log.Error("handleStreamSnapshotChunk", "err", err)
+		return
+	}
+	if req.GetStart() < 0 || req.GetCount() <= 0 || req.GetCount() > maxChunkNodes {
+		log.Error("handleStreamSnapshotChunk", "error", "wrong parameter")
+		return
+	}
+	resp, err := p.queryModule("store", types.EventStoreGetSnapshot, &types.ReqSnapshotNodes{StateHash: req.GetStateHash(), Start: req.GetStart(), Count: req.GetCount()})
+	if err != nil {
+		log.Error("handleStreamSnapshotChunk", "start", req.GetStart(), "err", err)
+		return
+	}
+	nodes, ok := resp.(*types.SnapshotNodes)
+	if !ok {
+		return
+	}
+	err = protocol.WriteStream(nodes, stream)
+	if err != nil {
+		log.Error("WriteStream", "error", err, "remote pid", stream.Conn().RemotePeer().String())
+		return
+	}
+	log.Debug("handleStreamSnapshotChunk", "start", req.GetStart(), "count", len(nodes.GetNodes()), "remote peer", stream.Conn().RemotePeer().String())
+}
+
+func (p *Protocol) handleEventFetchSnapshotInfo(msg *queue.Message) {
+	req := msg.GetData().(*types.ReqSnapshotInfo)
+	info, err := p.fetchSnapshotInfo(req)
+	if err != nil {
+		log.Error("handleEventFetchSnapshotInfo", "height", req.GetHeight(), "error", err)
+		msg.Reply(p.QueueClient.NewMessage("blockchain", types.EventFetchSnapshotInfo, err))
+		return
+	}
+	msg.Reply(p.QueueClient.NewMessage("blockchain", types.EventFetchSnapshotInfo, info))
+}
+
+func (p *Protocol) handleEventFetchSnapshotChunk(msg *queue.Message) {
+	req := msg.GetData().(*types.ReqSnapshotChunk)
+	nodes, err := p.fetchSnapshotChunk(req)
+	if err != nil {
+		log.Error("handleEventFetchSnapshotChunk", "start", req.GetStart(), "error", err)
+		msg.Reply(p.QueueClient.NewMessage("blockchain", types.EventFetchSnapshotChunk, err))
+		return
+	}
+	msg.Reply(p.QueueClient.NewMessage("blockchain", types.EventFetchSnapshotChunk, nodes))
+}
@@ -0,0 +1,191 @@
+// Package snapshot 状态快照同步协议，新节点从peer下载检查点高度的状态树快照
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/system/p2p/dht/protocol"
+	"github.com/33cn/chain33/types"
+	"github.com/libp2p/go-libp2p-core/peer"
+)
+
+var (
+	log = log15.New("module", "p2p.snapshot")
+)
+
+func init() {
+	protocol.RegisterProtocolInitializer(InitProtocol)
+}
+
+const (
+	snapshotInfo  = "/chain33/snapshot-info/1.0.0"
+	snapshotChunk = "/chain33/snapshot-chunk/1.0.0"
+	//单次请求最多返回的状态树节点个数
+	maxChunkNodes = 4096
+	//一次快照同步最多使用的peer个数
+	maxSnapshotPeers = 8
+)
+
+// Protocol ...
+type Protocol struct {
+	*protocol.P2PEnv
+}
+
+// InitProtocol initials protocol
+func InitProtocol(env *protocol.P2PEnv) {
+	p := &Protocol{
+		P2PEnv: env,
+	}
+	//轻节点没有状态数据，不对外提供快照
+	if !p.ChainCfg.GetModuleConfig().BlockChain.LightMode {
+		protocol.RegisterStreamHandler(p.Host, snapshotInfo, p.handleStreamSnapshotInfo)
+		protocol.RegisterStreamHandler(p.Host, snapshotChunk, p.handleStreamSnapshotChunk)
+	}
+	protocol.RegisterEventHandler(types.EventFetchSnapshotInfo, p.handleEventFetchSnapshotInfo)
+	protocol.RegisterEventHandler(types.EventFetchSnapshotChunk, p.handleEventFetchSnapshotChunk)
+}
+
+//fetchSnapshotInfo 向高度不低于检查点的peer请求检查点区块，区块hash和请求一致的peer都可以提供快照，
+//同一个状态hash对应的状态树节点总数一定相同，不一致的peer不使用
+func (p *Protocol) fetchSnapshotInfo(req *types.ReqSnapshotInfo) (*types.SnapshotInfo, error) {
+	var info *types.SnapshotInfo
+	for _, pid := range p.RoutingTable.ListPeers() {
+		if p.PeerInfoManager.PeerHeight(pid) < req.GetHeight() {
+			continue
+		}
+		remote, err := p.fetchSnapshotInfoFromPeer(&types.ReqSnapshotInfo{Height: req.GetHeight()}, pid)
+		if err != nil {
+			log.Debug("fetchSnapshotInfo", "peer", pid, "error", err)
+			continue
+		}
+		block := remote.GetBlock().GetBlock()
+		if block == nil || block.Height != req.GetHeight() || !bytes.Equal(block.Hash(p.ChainCfg), req.GetBlockHash()) {
+			log.Debug("fetchSnapshotInfo", "peer", pid, "error", types.ErrBlockHashNoMatch)
+			continue
+		}
+		if info == nil {
+			info = &types.SnapshotInfo{Block: remote.Block, NodeCount: remote.NodeCount}
+		} else if info.NodeCount != remote.NodeCount {
+			log.Debug("fetchSnapshotInfo", "peer", pid, "nodeCount", remote.NodeCount, "expect", info.NodeCount)
+			continue
+		}
+		info.Pids = append(info.Pids, pid.Pretty())
+		if len(info.Pids) >= maxSnapshotPeers {
+			break
+		}
+	}
+	if info == nil {
+		return nil, types.ErrNotFound
+	}
+	return info, nil
+}
+
+func (p *Protocol) fetchSnapshotInfoFromPeer(req *types.ReqSnapshotInfo, pid peer.ID) (*types.SnapshotInfo, error) {
+	ctx, cancel := context.WithTimeout(p.Ctx, time.Second*10)
+	defer cancel()
+	stream, err := p.Host.NewStream(ctx, pid, snapshotInfo)
+	if err != nil {
+		return nil, err
+	}
+	_ = stream.SetDeadline(time.Now().Add(time.Second * 20))
+	defer stream.Close()
+	err = protocol.WriteStream(req, stream)
+	if err != nil {
+		return nil, err
+	}
+	var info types.SnapshotInfo
+	err = protocol.ReadStream(&info, stream)
+	if err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+//fetchSnapshotChunk 从提供快照的peer中依次请求，不同的分片从不同的peer开始请求，分散下载压力，
+//节点内容的正确性由导入时的校验保证，这里只检查节点个数
+func (p *Protocol) fetchSnapshotChunk(req *types.ReqSnapshotChunk) (*types.SnapshotNodes, error) {
+	pids := req.GetPids()
+	if len(pids) == 0 || req.GetCount() <= 0 || req.GetCount() > maxChunkNodes {
+		return nil, types.ErrInvalidParam
+	}
+	offset := int(req.GetStart()/req.GetCount()) % len(pids)
+	param := &types.ReqSnapshotChunk{StateHash: req.GetStateHash(), Start: req.GetStart(), Count: req.GetCount()}
+	for i := range pids {
+		pid, err := peer.Decode(pids[(offset+i)%len(pids)])
+		if err != nil {
+			continue
+		}
+		nodes, err := p.fetchSnapshotChunkFromPeer(param, pid)
+		if err != nil {
+			log.Debug("fetchSnapshotChunk", "peer", pid, "start", req.GetStart(), "error", err)
+			continue
+		}
+		if int64(len(nodes.GetNodes())) != req.GetCount() {
+			log.Debug("fetchSnapshotChunk", "peer", pid, "start", req.GetStart(), "count", len(nodes.GetNodes()))
+			continue
+		}
+		return nodes, nil
+	}
+	return nil, types.ErrNotFound
+}
+
+func (p *Protocol) fetchSnapshotChunkFromPeer(req *types.ReqSnapshotChunk, pid peer.ID) (*types.SnapshotNodes, error) {
+	ctx, cancel := context.WithTimeout(p.Ctx, time.Second*10)
+	defer cancel()
+	p.Host.ConnManager().Protect(pid, snapshotChunk)
+	defer p.Host.ConnManager().Unprotect(pid, snapshotChunk)
+	stream, err := p.Host.NewStream(ctx, pid, snapshotChunk)
+	if err != nil {
+		return nil, err
+	}
+	_ = stream.SetDeadline(time.Now().Add(time.Minute))
+	defer stream.Close()
+	err = protocol.WriteStream(req, stream)
+	if err != nil {
+		return nil, err
+	}
+	var nodes types.SnapshotNodes
+	err = protocol.ReadStream(&nodes, stream)
+	if err != nil {
+		return nil, err
+	}
+	return &nodes, nil
+}
+
+//queryModule 查询其他模块，模块返回的错误作为error返回
+func (p *Protocol) queryModule(topic string, ty int64, data interface{}) (interface{}, error) {
+	resp, err := p.QueryModule(topic, ty, data)
+	if err != nil {
+		return nil, err
+	}
+	if err, ok := resp.(error); ok {
+		return nil, err
+	}
+	return resp, nil
+}
+
+//getLocalSnapshotInfo 本地检查点高度的区块以及状态树节点总数，状态数据被裁剪时无法提供快照
+func (p *Protocol) getLocalSnapshotInfo(height int64) (*types.SnapshotInfo, error) {
+	resp, err := p.queryModule("blockchain", types.EventGetBlocks, &types.ReqBlocks{Start: height, End: height, IsDetail: true})
+	if err != nil {
+		return nil, err
+	}
+	details, ok := resp.(*types.BlockDetails)
+	if !ok || len(details.GetItems()) == 0 || details.Items[0].GetBlock() == nil {
+		return nil, types.ErrBlockNotFound
+	}
+	//区块的kv数据不需要发送
+	detail := &types.BlockDetail{Block: details.Items[0].Block, Receipts: details.Items[0].Receipts}
+	resp, err = p.queryModule("store", types.EventStoreGetSnapshot, &types.ReqSnapshotNodes{StateHash: detail.Block.StateHash})
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := resp.(*types.SnapshotNodes)
+	if !ok {
+		return nil, types.ErrInvalidParam
+	}
+	return &types.SnapshotInfo{Block: detail, NodeCount: nodes.Total}, nil
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/common/trace"
 	"github.com/33cn/chain33/queue"
 	types2 "github.com/33cn/chain33/system/p2p/dht/types"
 	"github.com/33cn/chain33/types"
@@ -49,6 +50,8 @@ func ReadStream(data types.Message, stream network.Stream) error {
 		log.Error("ReadStream", "pid", stream.Conn().RemotePeer().Pretty(), "protocolID", stream.Protocol(), "read msg err", err)
 		return err
 	}
+	//接收后等待带宽, 限制对方的发送速度
+	GetBandwidthLimiter().WaitIn(stream.Protocol(), messageHeaderLen+len(msg))
 	err = types.Decode(msg, data)
 	if err != nil {
 		log.Error("ReadStream", "pid", stream.Conn().RemotePeer().Pretty(), "protocolID", stream.Protocol(), "decode err", err)
@@ -60,12 +63,13 @@ func ReadStream(data types.Message, stream network.Stream) error {
 // WriteStream writes message to stream.
 func WriteStream(data types.Message, stream network.Stream) error {
 
+	msg := types.Encode(data)
+	GetBandwidthLimiter().WaitOut(stream.Protocol(), messageHeaderLen+len(msg))
 	_, err := stream.Write(messageHeader)
 	if err != nil {
 		log.Error("WriteStream", "pid", stream.Conn().RemotePeer().Pretty(), "protocolID", stream.Protocol(), "write header err", err)
 		return err
 	}
-	msg := types.Encode(data)
 	writer := msgio.NewWriter(stream)
 	err = writer.WriteMsg(msg)
 	if err != nil {
@@ -157,9 +161,10 @@ func SignAndWriteStream(message types.Message, stream network.Stream) error {
 	switch t := message.(type) {
 	case *types.P2PRequest:
 		t.Headers = &types.P2PMessageHeaders{
-			Version:   types2.Version,
-			Timestamp: time.Now().Unix(),
-			Id:        rand.Int63(),
+			Version:     types2.Version,
+			Timestamp:   time.Now().Unix(),
+			Id:          rand.Int63(),
+			Traceparent: t.GetHeaders().GetTraceparent(),
 		}
 		sign, err := signProtoMessage(t, stream)
 		if err != nil {
@@ -184,6 +189,37 @@ func SignAndWriteStream(message types.Message, stream network.Stream) error {
 	return WriteStream(message, stream)
 }
 
+// StartStreamSpan 请求带有traceparent时开始处理stream请求的span，继续发送方的追踪
+func StartStreamSpan(stream network.Stream, traceparent string) *trace.Span {
+	if traceparent == "" {
+		return nil
+	}
+	parent, err := trace.ParseTraceparent(traceparent)
+	if err != nil {
+		return nil
+	}
+	span := trace.StartSpan("p2p stream "+string(stream.Protocol()), parent)
+	span.SetAttribute(trace.AttrPeer, stream.Conn().RemotePeer().Pretty())
+	return span
+}
+
+//setStreamTrace 把请求的traceparent替换为处理stream请求的span，handler通过TraceClient在发送的消息中继续追踪
+func setStreamTrace(req *types.P2PRequest, span *trace.Span) {
+	if req.GetHeaders() == nil {
+		return
+	}
+	req.Headers.Traceparent = span.Context().Traceparent()
+}
+
+// TraceClient 返回的client新建的消息以处理stream请求的span为父span
+func TraceClient(client queue.Client, req *types.P2PRequest) queue.Client {
+	parent, err := trace.ParseTraceparent(req.GetHeaders().GetTraceparent())
+	if err != nil {
+		return client
+	}
+	return queue.WithTrace(client, parent)
+}
+
 // HandlerWithClose wraps handler with closing stream and recovering from panic.
 func HandlerWithClose(f network.StreamHandler) network.StreamHandler {
 	return func(stream network.Stream) {
@@ -228,6 +264,9 @@ func HandlerWithRead(f func(request *types.P2PRequest)) network.StreamHandler {
 			log.Error("HandlerWithRead", "read stream error", err)
 			return
 		}
+		span := StartStreamSpan(stream, req.GetHeaders().GetTraceparent())
+		defer span.End()
+		setStreamTrace(&req, span)
 		f(&req)
 	}
 }
@@ -243,6 +282,9 @@ func HandlerWithAuth(f func(request *types.P2PRequest)) network.StreamHandler {
 		if !AuthenticateMessage(&req, stream) {
 			return
 		}
+		span := StartStreamSpan(stream, req.GetHeaders().GetTraceparent())
+		defer span.End()
+		setStreamTrace(&req, span)
 		f(&req)
 	}
 }
@@ -255,6 +297,9 @@ func HandlerWithRW(f func(request *types.P2PRequest, response *types.P2PResponse
 			log.Error("HandlerWithRW", "read stream error", err)
 			return
 		}
+		span := StartStreamSpan(stream, req.GetHeaders().GetTraceparent())
+		defer span.End()
+		setStreamTrace(&req, span)
 		var res types.P2PResponse
 		err := f(&req, &res)
 		if err != nil {
@@ -284,6 +329,9 @@ func HandlerWithAuthAndSign(f func(request *types.P2PRequest, response *types.P2
 		if !AuthenticateMessage(&req, stream) {
 			return
 		}
+		span := StartStreamSpan(stream, req.GetHeaders().GetTraceparent())
+		defer span.End()
+		setStreamTrace(&req, span)
 		var res types.P2PResponse
 		err := f(&req, &res)
 		if err != nil {
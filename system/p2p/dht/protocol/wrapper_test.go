@@ -0,0 +1,37 @@
+package protocol
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common/trace"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+)
+
+type nopExporter struct{}
+
+func (nopExporter) ExportSpans([]*trace.SpanData) error { return nil }
+func (nopExporter) Shutdown() error                     { return nil }
+
+func TestTraceClient(t *testing.T) {
+	q := queue.New("channel")
+	defer q.Close()
+	client := q.Client()
+
+	//请求没有traceparent时不设置上下文
+	req := &types.P2PRequest{}
+	setStreamTrace(req, nil)
+	require.Equal(t, client, TraceClient(client, req))
+
+	trace.Start(nopExporter{}, 1)
+	defer trace.Stop()
+	sender := trace.StartSpan("sender", trace.SpanContext{})
+	req.Headers = &types.P2PMessageHeaders{Traceparent: sender.Context().Traceparent()}
+	span := trace.StartSpan("p2p stream", sender.Context())
+	setStreamTrace(req, span)
+
+	//handler发送的消息以处理stream请求的span为父span
+	msg := TraceClient(client, req).NewMessage("blockchain", types.EventGetHeaders, nil)
+	require.Equal(t, span.Context(), msg.Trace)
+}
@@ -27,6 +27,22 @@ const (
 	DefaultPercentage = 30
 )
 
+//节点评分上报的原因, 对应的分值由manage.PeerScoreManager定义
+const (
+	// ScoreInvalidBlock 广播的区块校验失败
+	ScoreInvalidBlock = "InvalidBlock"
+	// ScoreInvalidTx 广播的交易校验失败
+	ScoreInvalidTx = "InvalidTx"
+	// ScoreDownloadTimeout 下载区块超时或失败
+	ScoreDownloadTimeout = "DownloadTimeout"
+	// ScoreInvalidChunk 返回的chunk数据格式错误
+	ScoreInvalidChunk = "InvalidChunk"
+	// ScoreVersionMismatch 节点版本或者链不一致
+	ScoreVersionMismatch = "VersionMismatch"
+	// ScoreValidMsg 正常的广播或下载, 逐步恢复分数
+	ScoreValidMsg = "ValidMsg"
+)
+
 var (
 	// ErrLength err length
 	ErrLength = errors.New("length not equal")
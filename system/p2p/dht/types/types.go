@@ -53,6 +53,26 @@ type P2PSubConfig struct {
 	//广播子配置
 	Broadcast BroadcastConfig `json:"broadcast,omitempty"`
 	VerLimit  string          `json:"verLimit,omitempty"`
+	//带宽限制配置
+	Bandwidth BandwidthConfig `json:"bandwidth,omitempty"`
+}
+
+// BandwidthConfig 带宽限制配置, 单位KB/s, 0表示不限制
+// 全局带宽紧张时按照协议优先级分配, 区块广播 > 交易广播 > 区块下载 > p2pstore
+type BandwidthConfig struct {
+	//全局接收带宽
+	MaxIn int64 `json:"maxIn,omitempty"`
+	//全局发送带宽
+	MaxOut int64 `json:"maxOut,omitempty"`
+	//按协议ID限制带宽
+	Protocols []*ProtocolBandwidthConfig `json:"protocols,omitempty"`
+}
+
+// ProtocolBandwidthConfig 单个协议的带宽限制, 单位KB/s, 0表示不限制
+type ProtocolBandwidthConfig struct {
+	Protocol string `json:"protocol,omitempty"`
+	MaxIn    int64  `json:"maxIn,omitempty"`
+	MaxOut   int64  `json:"maxOut,omitempty"`
 }
 
 // BroadcastConfig broadcast config
@@ -76,6 +96,10 @@ type BroadcastConfig struct {
 	MaxBatchTxInterval int `json:"maxBatchTxInterval,omitempty"`
 	//关闭广播数据验证, 适用于联盟链私有链
 	DisableValidation bool `json:"disableValidation,omitempty"`
+	//开启交易集合协调广播, 和同样开启的节点定期交换交易集合概要, 只同步缺少的交易, 减少交易重复广播
+	EnableTxReconcile bool `json:"enableTxReconcile,omitempty"`
+	//交易集合协调间隔(毫秒), 默认1000 ms
+	TxReconcileInterval int `json:"txReconcileInterval,omitempty"`
 }
 
 // PubSubConfig pubsub config
@@ -6,13 +6,16 @@ package store
 
 import (
 	"sync"
+	"time"
 
 	dbm "github.com/33cn/chain33/common/db"
 	clog "github.com/33cn/chain33/common/log"
 	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/metrics"
 	"github.com/33cn/chain33/queue"
 	"github.com/33cn/chain33/types"
 	"github.com/33cn/chain33/util"
+	go_metrics "github.com/rcrowley/go-metrics"
 )
 
 /*
@@ -64,6 +67,8 @@ type BaseStore struct {
 	done    chan struct{}
 	child   SubStore
 	wg      sync.WaitGroup
+	//状态提交耗时，没有开启metrics时为nil
+	commitTimer go_metrics.Timer
 }
 
 // NewBaseStore new base store struct
@@ -79,6 +84,9 @@ func NewBaseStore(cfg *types.Store) *BaseStore {
 // SetQueueClient set client queue for recv msg
 func (store *BaseStore) SetQueueClient(c queue.Client) {
 	store.qclient = c
+	if metrics.Enabled(c.GetConfig()) {
+		store.commitTimer = go_metrics.GetOrRegisterTimer("store_commit_seconds", nil)
+	}
 	store.qclient.Sub("store")
 	//recv 消息的处理
 	go func() {
@@ -141,11 +149,15 @@ func (store *BaseStore) processMessage(msg *queue.Message) {
 			req := msg.GetData().(*types.ReqHash)
 			var hash []byte
 			var err error
+			beg := time.Now()
 			if req.Upgrade {
 				hash, err = store.child.CommitUpgrade(req)
 			} else {
 				hash, err = store.child.Commit(req)
 			}
+			if store.commitTimer != nil {
+				store.commitTimer.UpdateSince(beg)
+			}
 			if hash == nil {
 				msg.Reply(client.NewMessage("", types.EventStoreCommit, types.ErrHashNotFound))
 				if err == types.ErrDataBaseDamage { //如果是数据库写失败，需要上报给用户
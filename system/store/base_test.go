@@ -79,6 +79,7 @@ func TestBaseStore_Queue(t *testing.T) {
 	assert.NotNil(t, store)
 
 	var q = queue.New("channel")
+	q.SetConfig(types.NewChain33Config(types.GetDefaultCfgstring()))
 	store.SetQueueClient(q.Client())
 	queueClinet := store.GetQueueClient()
 
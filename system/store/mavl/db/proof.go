@@ -7,6 +7,7 @@ package mavl
 import (
 	"bytes"
 
+	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/types"
 	"github.com/golang/protobuf/proto"
 )
@@ -136,3 +137,50 @@ func (t *Tree) ConstructProof(key []byte) (value []byte, proof *Proof) {
 	}
 	return nil, nil
 }
+
+// KeyProof 获取key的状态证明，key不存在时返回排序上相邻的前后两个叶子节点的证明
+func (t *Tree) KeyProof(key []byte) *types.StateKeyProof {
+	proof := &types.StateKeyProof{Key: key}
+	if t.root == nil {
+		return proof
+	}
+	//key不存在时index为key在叶子节点中应插入的位置
+	index, _, exists := t.Get(key)
+	if exists {
+		value, leaf := t.ConstructProof(key)
+		proof.Value = value
+		proof.Exists = true
+		proof.InnerNodes = leaf.InnerNodes
+		return proof
+	}
+	if index > 0 {
+		proof.Left = t.leafProofByIndex(index - 1)
+	}
+	if index < t.Size() {
+		proof.Right = t.leafProofByIndex(index)
+	}
+	return proof
+}
+
+func (t *Tree) leafProofByIndex(index int32) *types.MAVLLeafProof {
+	key, _ := t.GetByIndex(index)
+	value, proof := t.ConstructProof(key)
+	if proof == nil {
+		return nil
+	}
+	return &types.MAVLLeafProof{Key: key, Value: value, InnerNodes: proof.InnerNodes}
+}
+
+// GetStateProof 获取statehash对应状态下多个key的状态证明
+func GetStateProof(db dbm.DB, req *types.StoreGet, treeCfg *TreeConfig) (*types.StateProof, error) {
+	tree := NewTree(db, true, treeCfg)
+	err := tree.Load(req.StateHash)
+	if err != nil {
+		return nil, err
+	}
+	reply := &types.StateProof{StateHash: req.StateHash}
+	for _, key := range req.Keys {
+		reply.Proofs = append(reply.Proofs, tree.KeyProof(key))
+	}
+	return reply, nil
+}
@@ -0,0 +1,168 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mavl
+
+import (
+	"bytes"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+)
+
+//状态树快照：
+//mavl树的结构和节点的插入顺序有关，只导出叶子节点无法重建出相同roothash的树，
+//所以按后序遍历的顺序导出所有节点，导入时用栈还原出完全相同的树结构。
+//内部节点的hash不包含key，导入时需要校验key的顺序，否则错误的key不会影响roothash但会导致查询出错。
+//开启前缀树或者mvcc时节点hash或者叶子节点数据和区块高度相关，不支持快照
+
+func isSnapshotSupport(treeCfg *TreeConfig) bool {
+	return treeCfg == nil || (!treeCfg.EnableMavlPrefix && !treeCfg.EnableMVCC)
+}
+
+//snapshotNodeCount 以node为根的子树的节点总数，mavl树的内部节点都有两个子节点
+func snapshotNodeCount(node *Node) int64 {
+	return int64(node.size)*2 - 1
+}
+
+//exportNodes 后序遍历以node为根的子树，跳过前skip个节点，fn返回true时停止遍历，
+//根据子树的size直接跳过整个子树，不需要从db中加载被跳过的节点
+func (node *Node) exportNodes(t *Tree, skip *int64, fn func(*Node) bool) bool {
+	count := snapshotNodeCount(node)
+	if *skip >= count {
+		*skip -= count
+		return false
+	}
+	if node.height > 0 {
+		if node.getLeftNode(t).exportNodes(t, skip, fn) {
+			return true
+		}
+		if node.getRightNode(t).exportNodes(t, skip, fn) {
+			return true
+		}
+	}
+	return fn(node)
+}
+
+// GetSnapshotNodes 按后序遍历的顺序获取statehash对应状态树中从start开始的count个节点，同时返回节点总数
+func GetSnapshotNodes(db dbm.DB, req *types.ReqSnapshotNodes, treeCfg *TreeConfig) (*types.SnapshotNodes, error) {
+	if !isSnapshotSupport(treeCfg) {
+		return nil, types.ErrNotSupport
+	}
+	tree := NewTree(db, true, treeCfg)
+	err := tree.Load(req.StateHash)
+	if err != nil {
+		return nil, err
+	}
+	reply := &types.SnapshotNodes{}
+	if tree.root == nil {
+		return reply, nil
+	}
+	reply.Total = snapshotNodeCount(tree.root)
+	if req.Start < 0 || req.Count <= 0 {
+		return reply, nil
+	}
+	skip := req.Start
+	tree.root.exportNodes(tree, &skip, func(node *Node) bool {
+		reply.Nodes = append(reply.Nodes, &types.SnapshotNode{Key: node.key, Value: node.value, Height: node.height})
+		return int64(len(reply.Nodes)) >= req.Count
+	})
+	return reply, nil
+}
+
+//importNode 导入过程中已经保存的子树，记录子树中最小和最大的key用于校验父节点的key
+type importNode struct {
+	node   *Node
+	minKey []byte
+	maxKey []byte
+}
+
+// SnapshotImporter 按后序遍历的顺序导入状态树的节点，已经构建完成的子树直接保存到db中，
+// 内存中只保留栈上子树的根节点
+type SnapshotImporter struct {
+	tree      *Tree
+	stateHash []byte
+	stack     []*importNode
+	lastKey   []byte
+	count     int64
+}
+
+// NewSnapshotImporter 新建状态树导入，height为状态对应的区块高度
+func NewSnapshotImporter(db dbm.DB, stateHash []byte, height int64, treeCfg *TreeConfig) (*SnapshotImporter, error) {
+	if !isSnapshotSupport(treeCfg) {
+		return nil, types.ErrNotSupport
+	}
+	tree := NewTree(db, true, treeCfg)
+	tree.SetBlockHeight(height)
+	return &SnapshotImporter{tree: tree, stateHash: stateHash}, nil
+}
+
+// StateHash 导入的状态hash
+func (imp *SnapshotImporter) StateHash() []byte {
+	return imp.stateHash
+}
+
+// Count 已经导入的节点个数
+func (imp *SnapshotImporter) Count() int64 {
+	return imp.count
+}
+
+// Add 导入一批节点，叶子节点的key必须严格递增，内部节点的key必须大于左子树并且不大于右子树的所有key
+func (imp *SnapshotImporter) Add(nodes []*types.SnapshotNode) error {
+	t := imp.tree
+	for _, sn := range nodes {
+		var item *importNode
+		if sn.GetHeight() == 0 {
+			if imp.lastKey != nil && bytes.Compare(imp.lastKey, sn.GetKey()) >= 0 {
+				return types.ErrSnapshotNode
+			}
+			imp.lastKey = sn.GetKey()
+			item = &importNode{node: NewNode(sn.GetKey(), sn.GetValue()), minKey: sn.GetKey(), maxKey: sn.GetKey()}
+		} else {
+			n := len(imp.stack)
+			if n < 2 {
+				return types.ErrSnapshotNode
+			}
+			left, right := imp.stack[n-2], imp.stack[n-1]
+			imp.stack = imp.stack[:n-2]
+			if sn.GetHeight() != maxInt32(left.node.height, right.node.height)+1 ||
+				bytes.Compare(left.maxKey, sn.GetKey()) >= 0 || bytes.Compare(sn.GetKey(), right.minKey) > 0 {
+				return types.ErrSnapshotNode
+			}
+			node := &Node{
+				key:       sn.GetKey(),
+				height:    sn.GetHeight(),
+				size:      left.node.size + right.node.size,
+				leftHash:  left.node.hash,
+				rightHash: right.node.hash,
+			}
+			item = &importNode{node: node, minKey: left.minKey, maxKey: right.maxKey}
+		}
+		//子节点已经保存，只需要保存当前节点
+		item.node.save(t)
+		imp.stack = append(imp.stack, item)
+		imp.count++
+	}
+	dbm.MustWrite(t.ndb.batch)
+	t.ndb.batch.Reset()
+	return nil
+}
+
+// Commit 完成导入，所有节点必须组成一棵树并且roothash和stateHash一致，
+// 校验失败时已经写入db的节点不会被任何roothash引用
+func (imp *SnapshotImporter) Commit() ([]byte, error) {
+	if len(imp.stack) == 0 && bytes.Equal(imp.stateHash, emptyRoot[:]) {
+		return imp.stateHash, nil
+	}
+	if len(imp.stack) != 1 {
+		return nil, types.ErrSnapshotNode
+	}
+	root := imp.stack[0].node
+	if !bytes.Equal(root.hash, imp.stateHash) {
+		treelog.Error("SnapshotImporter Commit", "count", imp.count, "err", types.ErrStateHashMismatch)
+		return nil, types.ErrStateHashMismatch
+	}
+	imp.tree.root = root
+	return root.hash, nil
+}
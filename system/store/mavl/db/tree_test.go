@@ -2049,3 +2049,81 @@ func TestSize1(t *testing.T) {
 	PrintMemStats(1)
 	fmt.Println(unsafe.Sizeof(a), unsafe.Sizeof(b), unsafe.Sizeof(c), unsafe.Sizeof(d), len(d.Key), cap(d.Key))
 }
+
+func TestSnapshotNodes(t *testing.T) {
+	dir, err := ioutil.TempDir("", "datastore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	srcdb := db.NewDB("src", "leveldb", dir, 100)
+	dstdb := db.NewDB("dst", "leveldb", dir, 100)
+
+	//插入和删除之后的树结构和插入顺序有关
+	tree := NewTree(srcdb, true, nil)
+	records := make(map[string]string)
+	for i := 0; i < 300; i++ {
+		key := randstr(16)
+		records[key] = randstr(20)
+		tree.Set([]byte(key), []byte(records[key]))
+	}
+	i := 0
+	for key := range records {
+		if i%5 == 0 {
+			tree.Remove([]byte(key))
+			delete(records, key)
+		}
+		i++
+	}
+	hash := tree.Save()
+
+	reply, err := GetSnapshotNodes(srcdb, &types.ReqSnapshotNodes{StateHash: hash}, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(len(records)*2-1), reply.Total)
+	var nodes []*types.SnapshotNode
+	for start := int64(0); start < reply.Total; start += 64 {
+		chunk, err := GetSnapshotNodes(srcdb, &types.ReqSnapshotNodes{StateHash: hash, Start: start, Count: 64}, nil)
+		require.NoError(t, err)
+		nodes = append(nodes, chunk.Nodes...)
+	}
+	require.Equal(t, int(reply.Total), len(nodes))
+
+	imp, err := NewSnapshotImporter(dstdb, hash, 10, nil)
+	require.NoError(t, err)
+	for start := 0; start < len(nodes); start += 100 {
+		end := start + 100
+		if end > len(nodes) {
+			end = len(nodes)
+		}
+		require.NoError(t, imp.Add(nodes[start:end]))
+	}
+	root, err := imp.Commit()
+	require.NoError(t, err)
+	require.Equal(t, hash, root)
+	dst := NewTree(dstdb, true, nil)
+	require.NoError(t, dst.Load(hash))
+	for key, value := range records {
+		_, v, exists := dst.Get([]byte(key))
+		require.True(t, exists)
+		require.Equal(t, value, string(v))
+	}
+
+	//内部节点的key不在hash中，错误的key需要在导入时发现
+	last := nodes[len(nodes)-1]
+	bad := append(append([]*types.SnapshotNode{}, nodes[:len(nodes)-1]...), &types.SnapshotNode{Key: nodes[0].Key, Height: last.Height})
+	imp, err = NewSnapshotImporter(dstdb, hash, 10, nil)
+	require.NoError(t, err)
+	require.Equal(t, types.ErrSnapshotNode, imp.Add(bad))
+	imp, err = NewSnapshotImporter(dstdb, hash, 10, nil)
+	require.NoError(t, err)
+	require.Equal(t, types.ErrSnapshotNode, imp.Add([]*types.SnapshotNode{nodes[1], nodes[0]}))
+
+	//叶子节点的数据错误时roothash不一致
+	value := &types.SnapshotNode{Key: nodes[0].Key, Value: []byte("bad")}
+	imp, err = NewSnapshotImporter(dstdb, hash, 10, nil)
+	require.NoError(t, err)
+	require.NoError(t, imp.Add(append([]*types.SnapshotNode{value}, nodes[1:]...)))
+	_, err = imp.Commit()
+	require.Equal(t, types.ErrStateHashMismatch, err)
+
+	_, err = NewSnapshotImporter(dstdb, hash, 10, &TreeConfig{EnableMavlPrefix: true})
+	require.Equal(t, types.ErrNotSupport, err)
+}
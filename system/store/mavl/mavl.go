@@ -6,6 +6,7 @@
 package mavl
 
 import (
+	"bytes"
 	"sync"
 
 	"github.com/33cn/chain33/common"
@@ -34,6 +35,9 @@ type Store struct {
 	*drivers.BaseStore
 	trees   *sync.Map
 	treeCfg *mavl.TreeConfig
+	//快照同步时正在导入的状态树
+	importer    *mavl.SnapshotImporter
+	importerMtx sync.Mutex
 }
 
 func init() {
@@ -76,7 +80,7 @@ func New(cfg *types.Store, sub []byte, chain33cfg *types.Chain33Config) queue.Mo
 		EnableMemVal:     subcfg.EnableMemVal,
 		TkCloseCacheLen:  subcfg.TkCloseCacheLen,
 	}
-	mavls := &Store{bs, &sync.Map{}, treeCfg}
+	mavls := &Store{BaseStore: bs, trees: &sync.Map{}, treeCfg: treeCfg}
 	mavl.InitGlobalMem(treeCfg)
 	bs.SetChild(mavls)
 	return mavls
@@ -219,14 +223,87 @@ func (mavls *Store) IterateRangeByStateHash(statehash []byte, start []byte, end
 	mavl.IterateRangeByStateHash(mavls.GetDB(), statehash, start, end, ascending, mavls.treeCfg, fn)
 }
 
-// ProcEvent not support message
+// ProcEvent 处理mavl特有的消息，支持获取状态证明以及状态树快照的导出和导入
 func (mavls *Store) ProcEvent(msg *queue.Message) {
 	if msg == nil {
 		return
 	}
+	if msg.Ty == types.EventStoreGetSnapshot {
+		req, ok := msg.GetData().(*types.ReqSnapshotNodes)
+		if !ok {
+			msg.ReplyErr("Store", types.ErrInvalidParam)
+			return
+		}
+		nodes, err := mavl.GetSnapshotNodes(mavls.GetDB(), req, mavls.treeCfg)
+		if err != nil {
+			mlog.Error("store mavl get snapshot", "StateHash", common.ToHex(req.StateHash), "err", err)
+			msg.Reply(mavls.GetQueueClient().NewMessage("", types.EventStoreGetSnapshot, err))
+			return
+		}
+		msg.Reply(mavls.GetQueueClient().NewMessage("", types.EventStoreGetSnapshot, nodes))
+		return
+	}
+	if msg.Ty == types.EventStoreImportSnapshot {
+		req, ok := msg.GetData().(*types.SnapshotImport)
+		if !ok {
+			msg.ReplyErr("Store", types.ErrInvalidParam)
+			return
+		}
+		hash, err := mavls.importSnapshot(req)
+		if err != nil {
+			mlog.Error("store mavl import snapshot", "StateHash", common.ToHex(req.StateHash), "start", req.Start, "err", err)
+			msg.Reply(mavls.GetQueueClient().NewMessage("", types.EventStoreImportSnapshot, err))
+			return
+		}
+		msg.Reply(mavls.GetQueueClient().NewMessage("", types.EventStoreImportSnapshot, &types.ReplyHash{Hash: hash}))
+		return
+	}
+	if msg.Ty == types.EventStoreGetProof {
+		req, ok := msg.GetData().(*types.StoreGet)
+		if !ok {
+			msg.ReplyErr("Store", types.ErrInvalidParam)
+			return
+		}
+		proof, err := mavl.GetStateProof(mavls.GetDB(), req, mavls.treeCfg)
+		if err != nil {
+			mlog.Error("store mavl get proof", "StateHash", common.ToHex(req.StateHash), "err", err)
+			msg.Reply(mavls.GetQueueClient().NewMessage("", types.EventStoreGetProof, err))
+			return
+		}
+		msg.Reply(mavls.GetQueueClient().NewMessage("", types.EventStoreGetProof, proof))
+		return
+	}
 	msg.ReplyErr("Store", types.ErrActionNotSupport)
 }
 
+//importSnapshot 按顺序导入状态树的快照节点，start为0时重新开始导入，
+//导入出错后需要从头开始，最后一批节点导入后校验roothash
+func (mavls *Store) importSnapshot(req *types.SnapshotImport) ([]byte, error) {
+	mavls.importerMtx.Lock()
+	defer mavls.importerMtx.Unlock()
+	if req.Start == 0 {
+		importer, err := mavl.NewSnapshotImporter(mavls.GetDB(), req.StateHash, req.Height, mavls.treeCfg)
+		if err != nil {
+			return nil, err
+		}
+		mavls.importer = importer
+	}
+	importer := mavls.importer
+	if importer == nil || !bytes.Equal(importer.StateHash(), req.StateHash) || importer.Count() != req.Start {
+		return nil, types.ErrInvalidParam
+	}
+	err := importer.Add(req.Nodes)
+	if err != nil {
+		mavls.importer = nil
+		return nil, err
+	}
+	if !req.Last {
+		return nil, nil
+	}
+	mavls.importer = nil
+	return importer.Commit()
+}
+
 // Del ...
 func (mavls *Store) Del(req *types.StoreDel) ([]byte, error) {
 	//not support
@@ -190,6 +190,83 @@ func TestProcEvent(t *testing.T) {
 	store.ProcEvent(&queue.Message{})
 }
 
+func TestProcEventGetProof(t *testing.T) {
+	dir, err := ioutil.TempDir("", "example")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir) // clean up
+	var storeCfg = newStoreCfg(dir)
+	store := New(storeCfg, nil, nil).(*Store)
+	assert.NotNil(t, store)
+	q := queue.New("channel")
+	q.SetConfig(types.NewChain33Config(types.GetDefaultCfgstring()))
+	store.SetQueueClient(q.Client())
+	defer store.Close()
+
+	kv := []*types.KeyValue{{Key: []byte("k1"), Value: []byte("v1")}, {Key: []byte("k3"), Value: []byte("v3")}}
+	hash, err := store.Set(&types.StoreSet{StateHash: drivers.EmptyRoot[:], KV: kv}, true)
+	assert.Nil(t, err)
+
+	client := q.Client()
+	msg := client.NewMessage("store", types.EventStoreGetProof, &types.StoreGet{StateHash: hash, Keys: [][]byte{[]byte("k1"), []byte("k2")}})
+	assert.Nil(t, client.Send(msg, true))
+	reply, err := client.Wait(msg)
+	assert.Nil(t, err)
+	proof := reply.GetData().(*types.StateProof)
+	assert.Equal(t, hash, proof.StateHash)
+	assert.Len(t, proof.Proofs, 2)
+	assert.True(t, proof.Proofs[0].Exists)
+	assert.Equal(t, []byte("v1"), proof.Proofs[0].Value)
+	assert.False(t, proof.Proofs[1].Exists)
+	assert.Equal(t, []byte("k1"), proof.Proofs[1].Left.Key)
+	assert.Equal(t, []byte("k3"), proof.Proofs[1].Right.Key)
+
+	msg = client.NewMessage("store", types.EventStoreGetProof, &types.StoreGet{StateHash: []byte("notexist"), Keys: [][]byte{[]byte("k1")}})
+	assert.Nil(t, client.Send(msg, true))
+	_, err = client.Wait(msg)
+	assert.NotNil(t, err)
+}
+
+func TestProcEventSnapshot(t *testing.T) {
+	dir, err := ioutil.TempDir("", "example")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir) // clean up
+	src := New(newStoreCfg(dir+"/src"), nil, nil).(*Store)
+	dst := New(newStoreCfg(dir+"/dst"), nil, nil).(*Store)
+	q := queue.New("channel")
+	q.SetConfig(types.NewChain33Config(types.GetDefaultCfgstring()))
+	src.SetQueueClient(q.Client())
+	defer src.Close()
+	defer dst.Close()
+
+	var kv []*types.KeyValue
+	for i := 0; i < 20; i++ {
+		kv = append(kv, &types.KeyValue{Key: []byte(fmt.Sprintf("k%d", i)), Value: []byte(fmt.Sprintf("v%d", i))})
+	}
+	hash, err := src.Set(&types.StoreSet{StateHash: drivers.EmptyRoot[:], KV: kv}, true)
+	assert.Nil(t, err)
+
+	client := q.Client()
+	msg := client.NewMessage("store", types.EventStoreGetSnapshot, &types.ReqSnapshotNodes{StateHash: hash, Count: 100})
+	assert.Nil(t, client.Send(msg, true))
+	reply, err := client.Wait(msg)
+	assert.Nil(t, err)
+	nodes := reply.GetData().(*types.SnapshotNodes)
+	assert.Equal(t, int64(39), nodes.Total)
+	assert.Len(t, nodes.Nodes, 39)
+
+	//导入需要按顺序进行
+	_, err = dst.importSnapshot(&types.SnapshotImport{StateHash: hash, Start: 10, Nodes: nodes.Nodes[10:]})
+	assert.Equal(t, types.ErrInvalidParam, err)
+	root, err := dst.importSnapshot(&types.SnapshotImport{StateHash: hash, Nodes: nodes.Nodes[:10]})
+	assert.Nil(t, err)
+	assert.Nil(t, root)
+	root, err = dst.importSnapshot(&types.SnapshotImport{StateHash: hash, Start: 10, Nodes: nodes.Nodes[10:], Last: true})
+	assert.Nil(t, err)
+	assert.Equal(t, hash, root)
+	values := dst.Get(&types.StoreGet{StateHash: hash, Keys: [][]byte{[]byte("k3")}})
+	assert.Equal(t, []byte("v3"), values[0])
+}
+
 func TestDel(t *testing.T) {
 	dir, err := ioutil.TempDir("", "example")
 	assert.Nil(t, err)
@@ -57,6 +57,7 @@ func (block *Block) GetHeader(cfg *Chain33Config) *Header {
 	head.Difficulty = block.Difficulty
 	head.StateHash = block.StateHash
 	head.TxCount = int64(len(block.Txs))
+	head.BaseFee = block.BaseFee
 	head.Hash = block.Hash(cfg)
 	return head
 }
@@ -71,6 +72,7 @@ func (block *Block) SetHeader(header *Header) {
 	block.Difficulty = header.Difficulty
 	block.StateHash = header.StateHash
 	block.Signature = header.Signature
+	block.BaseFee = header.BaseFee
 }
 
 func (block *Block) getHeaderHashOld() *Header {
@@ -93,6 +95,7 @@ func (block *Block) getHeaderHashNew() *Header {
 	head.Difficulty = block.Difficulty
 	head.StateHash = block.StateHash
 	head.TxCount = int64(len(block.Txs))
+	head.BaseFee = block.BaseFee
 	return head
 }
 
@@ -258,6 +261,71 @@ func (blockDetail *BlockDetail) Size() int {
 	return Size(blockDetail)
 }
 
+// CalcHash 根据区块头的字段重新计算区块hash，计算方式需要和Block.Hash保持一致
+func (header *Header) CalcHash(cfg *Chain33Config) []byte {
+	head := &Header{}
+	head.Version = header.Version
+	head.ParentHash = header.ParentHash
+	head.TxHash = header.TxHash
+	head.BlockTime = header.BlockTime
+	head.Height = header.Height
+	if cfg.IsFork(header.Height, "ForkBlockHash") {
+		head.Difficulty = header.Difficulty
+		head.StateHash = header.StateHash
+		head.TxCount = header.TxCount
+		head.BaseFee = header.BaseFee
+	}
+	return common.Sha256(Encode(head))
+}
+
+const (
+	//BaseFeeChangeDenominator 区块基础手续费每次最多调整1/8
+	BaseFeeChangeDenominator = 8
+	//BaseFeeElasticity 区块的目标交易数量为最大交易数量的1/2
+	BaseFeeElasticity = 2
+)
+
+//CalcBaseFee 根据父区块计算区块的基础手续费费率，单位和MinTxFeeRate一致：
+//父区块交易数量超过目标交易数量时提高基础手续费，低于目标时降低，最低为MinTxFeeRate
+func CalcBaseFee(cfg *Chain33Config, parent *Header) int64 {
+	height := parent.GetHeight() + 1
+	if cfg.IsPara() || !cfg.IsFork(height, "ForkBaseFee") {
+		return 0
+	}
+	minFee := cfg.GetMinTxFeeRate()
+	//fork之后的第一个区块基础手续费为最小交易费率
+	if !cfg.IsFork(parent.GetHeight(), "ForkBaseFee") || parent.GetBaseFee() < minFee {
+		return minFee
+	}
+	baseFee := parent.GetBaseFee()
+	target := cfg.GetP(height).MaxTxNumber / BaseFeeElasticity
+	if target <= 0 {
+		return baseFee
+	}
+	txCount := parent.GetTxCount()
+	if txCount > target {
+		delta := baseFee * (txCount - target) / target / BaseFeeChangeDenominator
+		if delta < 1 {
+			delta = 1
+		}
+		baseFee += delta
+	} else if txCount < target {
+		baseFee -= baseFee * (target - txCount) / target / BaseFeeChangeDenominator
+	}
+	if baseFee < minFee {
+		baseFee = minFee
+	}
+	if maxFee := cfg.GetMaxTxFeeRate(); maxFee > minFee && baseFee > maxFee {
+		baseFee = maxFee
+	}
+	return baseFee
+}
+
+//CalcBaseFeeByBlock 根据父区块计算区块的基础手续费费率
+func CalcBaseFeeByBlock(cfg *Chain33Config, parent *Block) int64 {
+	return CalcBaseFee(cfg, &Header{Height: parent.GetHeight(), BaseFee: parent.GetBaseFee(), TxCount: int64(len(parent.GetTxs()))})
+}
+
 // Size 获取header的Size
 func (header *Header) Size() int {
 	return Size(header)
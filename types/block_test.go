@@ -116,3 +116,25 @@ func TestFilterParaTxsByTitle(t *testing.T) {
 		}
 	}
 }
+
+func TestCalcBaseFee(t *testing.T) {
+	cfg := NewChain33Config(GetDefaultCfgstring())
+	minFee := cfg.GetMinTxFeeRate()
+	target := cfg.GetP(1).MaxTxNumber / BaseFeeElasticity
+	//fork之后第一个区块
+	require.Equal(t, minFee, CalcBaseFee(cfg, &Header{Height: 0}))
+	require.Equal(t, 8*minFee, CalcBaseFee(cfg, &Header{Height: 1, BaseFee: 8 * minFee, TxCount: target}))
+	require.Equal(t, 9*minFee, CalcBaseFee(cfg, &Header{Height: 1, BaseFee: 8 * minFee, TxCount: 2 * target}))
+	require.Equal(t, 7*minFee, CalcBaseFee(cfg, &Header{Height: 1, BaseFee: 8 * minFee}))
+	//最低为最小交易费率，最高为最大交易费率
+	require.Equal(t, minFee, CalcBaseFee(cfg, &Header{Height: 1, BaseFee: minFee}))
+	require.Equal(t, cfg.GetMaxTxFeeRate(), CalcBaseFee(cfg, &Header{Height: 1, BaseFee: cfg.GetMaxTxFeeRate(), TxCount: 2 * target}))
+
+	block := &Block{Height: 1, BaseFee: 8 * minFee, Txs: make([]*Transaction, 2*target)}
+	require.Equal(t, 9*minFee, CalcBaseFeeByBlock(cfg, block))
+	//基础手续费计入区块hash
+	hash := block.Hash(cfg)
+	block.BaseFee = 0
+	require.NotEqual(t, hash, block.Hash(cfg))
+	require.Equal(t, block.Hash(cfg), block.GetHeader(cfg).CalcHash(cfg))
+}
@@ -35,6 +35,8 @@ type Config struct {
 	DisableForkCheck bool            `json:"disableForkCheck,omitempty"`
 	EnableParaFork   bool            `json:"enableParaFork,omitempty"`
 	Metrics          *Metrics        `json:"metrics,omitempty"`
+	Trace            *Trace          `json:"trace,omitempty"`
+	Queue            *Queue          `json:"queue,omitempty"`
 	ChainID          int32           `json:"chainID,omitempty"`
 	AddrVer          byte            `json:"addrVer,omitempty"`
 	Crypto           *crypto.Config  `json:"crypto,omitempty"`
@@ -120,6 +122,16 @@ type Mempool struct {
 	DisableExecCheck bool `json:"disableExecCheck,omitempty"`
 	// Deprecated:default enable check
 	EnableEthCheck bool `json:"enableEthCheck,omitempty"`
+	// 开启交易日志，节点重启后恢复mempool中的交易和延时交易
+	EnableJournal bool `json:"enableJournal,omitempty"`
+	// 交易日志数据库类型，默认leveldb
+	JournalDriver string `json:"journalDriver,omitempty"`
+	// 交易日志数据库路径，默认datadir/mempool
+	JournalPath string `json:"journalPath,omitempty"`
+	// eth签名交易相同nonce替换时手续费至少提高的百分比，默认10
+	ReplaceFeeBump int64 `json:"replaceFeeBump,omitempty"`
+	// nonce不连续的eth签名交易缓存容量，默认1024
+	MaxFutureTxNum int64 `json:"maxFutureTxNum,omitempty"`
 }
 
 // Consensus 配置
@@ -222,6 +234,12 @@ type BlockChain struct {
 	EnableFetchP2pstore bool `json:"enableFetchP2pstore,omitempty"`
 	// 使能注册推送区块、区块头或交易回执
 	EnablePushSubscribe bool `json:"EnablePushSubscribe,omitempty"`
+	// 推送outbox的存储目录，为空时不支持outbox方式的推送
+	PushOutboxPath string `json:"pushOutboxPath,omitempty"`
+	// outbox中单个segment文件的大小，单位M，默认为64M
+	PushOutboxSegmentSize int64 `json:"pushOutboxSegmentSize,omitempty"`
+	// 每个订阅最多保留的segment文件个数，超过时删除最老的文件，为0时不删除
+	PushOutboxMaxSegments int `json:"pushOutboxMaxSegments,omitempty"`
 	// 当前活跃区块的缓存数量
 	MaxActiveBlockNum int `json:"maxActiveBlockNum,omitempty"`
 	// 当前活跃区块的缓存大小M为单位
@@ -238,6 +256,11 @@ type BlockChain struct {
 	DisableClockDriftCheck bool `json:"disableClockDriftCheck,omitempty"`
 	//保存每个区块的block　kvs
 	EnableSaveBlockKVs bool `json:"enableSaveBlockKVs,omitempty"`
+	//轻节点模式，只同步和校验区块头，交易通过peer获取并用本地区块头验证merkle证明
+	LightMode bool `json:"lightMode,omitempty"`
+	//快照同步的检查点高度和区块hash，配置之后新节点从peer下载检查点的状态快照，不再执行检查点之前的区块
+	SnapshotSyncHeight int64  `json:"snapshotSyncHeight,omitempty"`
+	SnapshotSyncHash   string `json:"snapshotSyncHash,omitempty"`
 }
 
 // P2P 配置
@@ -289,7 +312,7 @@ type RPC struct {
 	JrpcFuncBlacklist []string `json:"jrpcFuncBlacklist,omitempty"`
 	// grpc方法请求黑名单，禁止调用黑名单里配置的rpc方法，一般和白名单配合使用，默认是空
 	GrpcFuncBlacklist []string `json:"grpcFuncBlacklist,omitempty"`
-	//eth erpc法请求黑名单，禁止调用黑名单里配置的rpc方法，一般和白名单配合使用，默认是空
+	//eth erpc法请求黑名单，禁止调用黑名单里配置的rpc方法，"debug_*"形式禁止整个命名空间，默认是空
 	ErpcFuncBlacklist []string `json:"erpcFuncBlacklist,omitempty"`
 	// 是否开启https
 	EnableTLS   bool `json:"enableTLS,omitempty"`
@@ -303,6 +326,14 @@ type RPC struct {
 	//basic auth 用户密码
 	JrpcUserPasswd string        `json:"jrpcUserPasswd,omitempty"`
 	ParaChain      ParaRPCConfig `json:"parachain,omitempty"`
+	//jrpc批量请求最多包含的请求个数，默认100
+	JrpcMaxBatchSize int `json:"jrpcMaxBatchSize,omitempty"`
+	//websocket rpc绑定地址，为空时不开启
+	JrpcWsBindAddr string `json:"jrpcWsBindAddr,omitempty"`
+	//api key配置文件，为空时不开启api key认证，文件修改后自动重新加载
+	APIKeyFile string `json:"apiKeyFile,omitempty"`
+	//为true时非本地请求必须携带api key
+	APIKeyRequired bool `json:"apiKeyRequired,omitempty"`
 }
 
 // Exec 配置
@@ -334,6 +365,42 @@ type HealthCheck struct {
 	UnSyncMaxTimes uint32 `json:"unSyncMaxTimes,omitempty"`
 }
 
+// Trace 分布式追踪配置
+type Trace struct {
+	Enable bool `json:"enable,omitempty"`
+	//输出方式，file输出到文件，otlp输出到OpenTelemetry collector
+	Exporter string `json:"exporter,omitempty"`
+	File     string `json:"file,omitempty"`
+	//collector的OTLP/HTTP地址
+	Endpoint    string `json:"endpoint,omitempty"`
+	ServiceName string `json:"serviceName,omitempty"`
+	//新追踪的采样比例，(0, 1]，默认全部采样
+	SampleRate float64 `json:"sampleRate,omitempty"`
+}
+
+// Queue 消息队列配置
+type Queue struct {
+	//远程模块连接主进程队列的地址，unix:///path/to/file.sock 或者 tcp://host:port
+	ListenAddr string `json:"listenAddr,omitempty"`
+	//在独立进程中运行的模块，支持wallet和rpc，远程模块只能订阅这些模块的topic
+	Sidecars []string `json:"sidecars,omitempty"`
+	//tcp监听非回环地址时必须配置TLS证书，远程模块使用该证书校验主进程
+	CertFile string `json:"certFile,omitempty"`
+	KeyFile  string `json:"keyFile,omitempty"`
+	//远程模块握手使用的凭证，开启队列服务时必须配置
+	Credentials []*QueueCredential `json:"credentials,omitempty"`
+}
+
+// QueueCredential 远程模块的凭证，每个凭证只能订阅自己的topic
+type QueueCredential struct {
+	//远程模块的名称，独立进程运行的模块使用模块名对应的凭证
+	Name string `json:"name,omitempty"`
+	//握手使用的密钥
+	Secret string `json:"secret,omitempty"`
+	//允许订阅的topic，必须是sidecars中的模块
+	Topics []string `json:"topics,omitempty"`
+}
+
 // Metrics 相关测量配置信息
 type Metrics struct {
 	EnableMetrics bool   `json:"enableMetrics,omitempty"`
@@ -226,6 +226,214 @@ func (x *MAVLProof) GetRootHash() []byte {
 	return nil
 }
 
+//mavl叶子节点到根节点的证明路径
+type MAVLLeafProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key        []byte       `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value      []byte       `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	InnerNodes []*InnerNode `protobuf:"bytes,3,rep,name=innerNodes,proto3" json:"innerNodes,omitempty"`
+}
+
+func (x *MAVLLeafProof) Reset() {
+	*x = MAVLLeafProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_db_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MAVLLeafProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MAVLLeafProof) ProtoMessage() {}
+
+func (x *MAVLLeafProof) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MAVLLeafProof.ProtoReflect.Descriptor instead.
+func (*MAVLLeafProof) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MAVLLeafProof) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *MAVLLeafProof) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *MAVLLeafProof) GetInnerNodes() []*InnerNode {
+	if x != nil {
+		return x.InnerNodes
+	}
+	return nil
+}
+
+//单个key的状态证明，key存在时通过innerNodes证明key:value，
+//不存在时通过排序上相邻的前后两个叶子节点证明，key位于所有叶子之前或之后时只有一侧的相邻节点
+type StateKeyProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key        []byte         `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value      []byte         `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Exists     bool           `protobuf:"varint,3,opt,name=exists,proto3" json:"exists,omitempty"`
+	InnerNodes []*InnerNode   `protobuf:"bytes,4,rep,name=innerNodes,proto3" json:"innerNodes,omitempty"`
+	Left       *MAVLLeafProof `protobuf:"bytes,5,opt,name=left,proto3" json:"left,omitempty"`
+	Right      *MAVLLeafProof `protobuf:"bytes,6,opt,name=right,proto3" json:"right,omitempty"`
+}
+
+func (x *StateKeyProof) Reset() {
+	*x = StateKeyProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_db_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateKeyProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateKeyProof) ProtoMessage() {}
+
+func (x *StateKeyProof) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateKeyProof.ProtoReflect.Descriptor instead.
+func (*StateKeyProof) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StateKeyProof) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *StateKeyProof) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *StateKeyProof) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+func (x *StateKeyProof) GetInnerNodes() []*InnerNode {
+	if x != nil {
+		return x.InnerNodes
+	}
+	return nil
+}
+
+func (x *StateKeyProof) GetLeft() *MAVLLeafProof {
+	if x != nil {
+		return x.Left
+	}
+	return nil
+}
+
+func (x *StateKeyProof) GetRight() *MAVLLeafProof {
+	if x != nil {
+		return x.Right
+	}
+	return nil
+}
+
+type StateProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StateHash []byte           `protobuf:"bytes,1,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	Proofs    []*StateKeyProof `protobuf:"bytes,2,rep,name=proofs,proto3" json:"proofs,omitempty"`
+}
+
+func (x *StateProof) Reset() {
+	*x = StateProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_db_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateProof) ProtoMessage() {}
+
+func (x *StateProof) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateProof.ProtoReflect.Descriptor instead.
+func (*StateProof) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StateProof) GetStateHash() []byte {
+	if x != nil {
+		return x.StateHash
+	}
+	return nil
+}
+
+func (x *StateProof) GetProofs() []*StateKeyProof {
+	if x != nil {
+		return x.Proofs
+	}
+	return nil
+}
+
 type StoreNode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -242,7 +450,7 @@ type StoreNode struct {
 func (x *StoreNode) Reset() {
 	*x = StoreNode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_db_proto_msgTypes[3]
+		mi := &file_db_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -255,7 +463,7 @@ func (x *StoreNode) String() string {
 func (*StoreNode) ProtoMessage() {}
 
 func (x *StoreNode) ProtoReflect() protoreflect.Message {
-	mi := &file_db_proto_msgTypes[3]
+	mi := &file_db_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -268,7 +476,7 @@ func (x *StoreNode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StoreNode.ProtoReflect.Descriptor instead.
 func (*StoreNode) Descriptor() ([]byte, []int) {
-	return file_db_proto_rawDescGZIP(), []int{3}
+	return file_db_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *StoreNode) GetKey() []byte {
@@ -325,7 +533,7 @@ type LocalDBSet struct {
 func (x *LocalDBSet) Reset() {
 	*x = LocalDBSet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_db_proto_msgTypes[4]
+		mi := &file_db_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -338,7 +546,7 @@ func (x *LocalDBSet) String() string {
 func (*LocalDBSet) ProtoMessage() {}
 
 func (x *LocalDBSet) ProtoReflect() protoreflect.Message {
-	mi := &file_db_proto_msgTypes[4]
+	mi := &file_db_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -351,7 +559,7 @@ func (x *LocalDBSet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LocalDBSet.ProtoReflect.Descriptor instead.
 func (*LocalDBSet) Descriptor() ([]byte, []int) {
-	return file_db_proto_rawDescGZIP(), []int{4}
+	return file_db_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *LocalDBSet) GetKV() []*KeyValue {
@@ -383,7 +591,7 @@ type LocalDBList struct {
 func (x *LocalDBList) Reset() {
 	*x = LocalDBList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_db_proto_msgTypes[5]
+		mi := &file_db_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -396,7 +604,7 @@ func (x *LocalDBList) String() string {
 func (*LocalDBList) ProtoMessage() {}
 
 func (x *LocalDBList) ProtoReflect() protoreflect.Message {
-	mi := &file_db_proto_msgTypes[5]
+	mi := &file_db_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -409,7 +617,7 @@ func (x *LocalDBList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LocalDBList.ProtoReflect.Descriptor instead.
 func (*LocalDBList) Descriptor() ([]byte, []int) {
-	return file_db_proto_rawDescGZIP(), []int{5}
+	return file_db_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *LocalDBList) GetPrefix() []byte {
@@ -459,7 +667,7 @@ type LocalDBGet struct {
 func (x *LocalDBGet) Reset() {
 	*x = LocalDBGet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_db_proto_msgTypes[6]
+		mi := &file_db_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -472,7 +680,7 @@ func (x *LocalDBGet) String() string {
 func (*LocalDBGet) ProtoMessage() {}
 
 func (x *LocalDBGet) ProtoReflect() protoreflect.Message {
-	mi := &file_db_proto_msgTypes[6]
+	mi := &file_db_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -485,7 +693,7 @@ func (x *LocalDBGet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LocalDBGet.ProtoReflect.Descriptor instead.
 func (*LocalDBGet) Descriptor() ([]byte, []int) {
-	return file_db_proto_rawDescGZIP(), []int{6}
+	return file_db_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *LocalDBGet) GetKeys() [][]byte {
@@ -513,7 +721,7 @@ type LocalReplyValue struct {
 func (x *LocalReplyValue) Reset() {
 	*x = LocalReplyValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_db_proto_msgTypes[7]
+		mi := &file_db_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -526,7 +734,7 @@ func (x *LocalReplyValue) String() string {
 func (*LocalReplyValue) ProtoMessage() {}
 
 func (x *LocalReplyValue) ProtoReflect() protoreflect.Message {
-	mi := &file_db_proto_msgTypes[7]
+	mi := &file_db_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -539,7 +747,7 @@ func (x *LocalReplyValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LocalReplyValue.ProtoReflect.Descriptor instead.
 func (*LocalReplyValue) Descriptor() ([]byte, []int) {
-	return file_db_proto_rawDescGZIP(), []int{7}
+	return file_db_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *LocalReplyValue) GetValues() [][]byte {
@@ -562,7 +770,7 @@ type StoreSet struct {
 func (x *StoreSet) Reset() {
 	*x = StoreSet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_db_proto_msgTypes[8]
+		mi := &file_db_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -575,7 +783,7 @@ func (x *StoreSet) String() string {
 func (*StoreSet) ProtoMessage() {}
 
 func (x *StoreSet) ProtoReflect() protoreflect.Message {
-	mi := &file_db_proto_msgTypes[8]
+	mi := &file_db_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -588,7 +796,7 @@ func (x *StoreSet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StoreSet.ProtoReflect.Descriptor instead.
 func (*StoreSet) Descriptor() ([]byte, []int) {
-	return file_db_proto_rawDescGZIP(), []int{8}
+	return file_db_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *StoreSet) GetStateHash() []byte {
@@ -624,7 +832,7 @@ type StoreDel struct {
 func (x *StoreDel) Reset() {
 	*x = StoreDel{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_db_proto_msgTypes[9]
+		mi := &file_db_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -637,7 +845,7 @@ func (x *StoreDel) String() string {
 func (*StoreDel) ProtoMessage() {}
 
 func (x *StoreDel) ProtoReflect() protoreflect.Message {
-	mi := &file_db_proto_msgTypes[9]
+	mi := &file_db_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -650,7 +858,7 @@ func (x *StoreDel) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StoreDel.ProtoReflect.Descriptor instead.
 func (*StoreDel) Descriptor() ([]byte, []int) {
-	return file_db_proto_rawDescGZIP(), []int{9}
+	return file_db_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *StoreDel) GetStateHash() []byte {
@@ -680,7 +888,7 @@ type StoreSetWithSync struct {
 func (x *StoreSetWithSync) Reset() {
 	*x = StoreSetWithSync{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_db_proto_msgTypes[10]
+		mi := &file_db_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -693,7 +901,7 @@ func (x *StoreSetWithSync) String() string {
 func (*StoreSetWithSync) ProtoMessage() {}
 
 func (x *StoreSetWithSync) ProtoReflect() protoreflect.Message {
-	mi := &file_db_proto_msgTypes[10]
+	mi := &file_db_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -706,7 +914,7 @@ func (x *StoreSetWithSync) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StoreSetWithSync.ProtoReflect.Descriptor instead.
 func (*StoreSetWithSync) Descriptor() ([]byte, []int) {
-	return file_db_proto_rawDescGZIP(), []int{10}
+	return file_db_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *StoreSetWithSync) GetStoreset() *StoreSet {
@@ -742,7 +950,7 @@ type StoreGet struct {
 func (x *StoreGet) Reset() {
 	*x = StoreGet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_db_proto_msgTypes[11]
+		mi := &file_db_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -755,7 +963,7 @@ func (x *StoreGet) String() string {
 func (*StoreGet) ProtoMessage() {}
 
 func (x *StoreGet) ProtoReflect() protoreflect.Message {
-	mi := &file_db_proto_msgTypes[11]
+	mi := &file_db_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -768,7 +976,7 @@ func (x *StoreGet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StoreGet.ProtoReflect.Descriptor instead.
 func (*StoreGet) Descriptor() ([]byte, []int) {
-	return file_db_proto_rawDescGZIP(), []int{11}
+	return file_db_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *StoreGet) GetStateHash() []byte {
@@ -796,7 +1004,7 @@ type StoreReplyValue struct {
 func (x *StoreReplyValue) Reset() {
 	*x = StoreReplyValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_db_proto_msgTypes[12]
+		mi := &file_db_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -809,7 +1017,7 @@ func (x *StoreReplyValue) String() string {
 func (*StoreReplyValue) ProtoMessage() {}
 
 func (x *StoreReplyValue) ProtoReflect() protoreflect.Message {
-	mi := &file_db_proto_msgTypes[12]
+	mi := &file_db_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -822,7 +1030,7 @@ func (x *StoreReplyValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StoreReplyValue.ProtoReflect.Descriptor instead.
 func (*StoreReplyValue) Descriptor() ([]byte, []int) {
-	return file_db_proto_rawDescGZIP(), []int{12}
+	return file_db_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *StoreReplyValue) GetValues() [][]byte {
@@ -848,7 +1056,7 @@ type StoreList struct {
 func (x *StoreList) Reset() {
 	*x = StoreList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_db_proto_msgTypes[13]
+		mi := &file_db_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -861,7 +1069,7 @@ func (x *StoreList) String() string {
 func (*StoreList) ProtoMessage() {}
 
 func (x *StoreList) ProtoReflect() protoreflect.Message {
-	mi := &file_db_proto_msgTypes[13]
+	mi := &file_db_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -874,7 +1082,7 @@ func (x *StoreList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StoreList.ProtoReflect.Descriptor instead.
 func (*StoreList) Descriptor() ([]byte, []int) {
-	return file_db_proto_rawDescGZIP(), []int{13}
+	return file_db_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *StoreList) GetStateHash() []byte {
@@ -938,7 +1146,7 @@ type StoreListReply struct {
 func (x *StoreListReply) Reset() {
 	*x = StoreListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_db_proto_msgTypes[14]
+		mi := &file_db_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -951,7 +1159,7 @@ func (x *StoreListReply) String() string {
 func (*StoreListReply) ProtoMessage() {}
 
 func (x *StoreListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_db_proto_msgTypes[14]
+	mi := &file_db_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -964,7 +1172,7 @@ func (x *StoreListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StoreListReply.ProtoReflect.Descriptor instead.
 func (*StoreListReply) Descriptor() ([]byte, []int) {
-	return file_db_proto_rawDescGZIP(), []int{14}
+	return file_db_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *StoreListReply) GetStart() []byte {
@@ -1042,7 +1250,7 @@ type PruneData struct {
 func (x *PruneData) Reset() {
 	*x = PruneData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_db_proto_msgTypes[15]
+		mi := &file_db_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1055,7 +1263,7 @@ func (x *PruneData) String() string {
 func (*PruneData) ProtoMessage() {}
 
 func (x *PruneData) ProtoReflect() protoreflect.Message {
-	mi := &file_db_proto_msgTypes[15]
+	mi := &file_db_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1068,7 +1276,7 @@ func (x *PruneData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PruneData.ProtoReflect.Descriptor instead.
 func (*PruneData) Descriptor() ([]byte, []int) {
-	return file_db_proto_rawDescGZIP(), []int{15}
+	return file_db_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *PruneData) GetHashs() [][]byte {
@@ -1090,7 +1298,7 @@ type StoreValuePool struct {
 func (x *StoreValuePool) Reset() {
 	*x = StoreValuePool{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_db_proto_msgTypes[16]
+		mi := &file_db_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1103,7 +1311,7 @@ func (x *StoreValuePool) String() string {
 func (*StoreValuePool) ProtoMessage() {}
 
 func (x *StoreValuePool) ProtoReflect() protoreflect.Message {
-	mi := &file_db_proto_msgTypes[16]
+	mi := &file_db_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1116,7 +1324,7 @@ func (x *StoreValuePool) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StoreValuePool.ProtoReflect.Descriptor instead.
 func (*StoreValuePool) Descriptor() ([]byte, []int) {
-	return file_db_proto_rawDescGZIP(), []int{16}
+	return file_db_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *StoreValuePool) GetValues() [][]byte {
@@ -1126,114 +1334,429 @@ func (x *StoreValuePool) GetValues() [][]byte {
 	return nil
 }
 
-var File_db_proto protoreflect.FileDescriptor
+// mavl状态树快照中的节点，按后序遍历的顺序导出，height为0时是叶子节点
+type SnapshotNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_db_proto_rawDesc = []byte{
-	0x0a, 0x08, 0x64, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x5e, 0x0a, 0x08, 0x4c, 0x65, 0x61, 0x66, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73,
-	0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22,
-	0x71, 0x0a, 0x09, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x6c, 0x65, 0x66, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
-	0x6c, 0x65, 0x66, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x69, 0x67, 0x68,
-	0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x72, 0x69, 0x67,
-	0x68, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69,
-	0x7a, 0x65, 0x22, 0x75, 0x0a, 0x09, 0x4d, 0x41, 0x56, 0x4c, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12,
-	0x1a, 0x0a, 0x08, 0x6c, 0x65, 0x61, 0x66, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x08, 0x6c, 0x65, 0x61, 0x66, 0x48, 0x61, 0x73, 0x68, 0x12, 0x30, 0x0a, 0x0a, 0x69,
-	0x6e, 0x6e, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x4e, 0x6f, 0x64,
-	0x65, 0x52, 0x0a, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x1a, 0x0a,
+	Key    []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value  []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Height int32  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *SnapshotNode) Reset() {
+	*x = SnapshotNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_db_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotNode) ProtoMessage() {}
+
+func (x *SnapshotNode) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotNode.ProtoReflect.Descriptor instead.
+func (*SnapshotNode) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SnapshotNode) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *SnapshotNode) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *SnapshotNode) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// 按后序遍历的顺序获取状态树中从start开始的count个节点，count为0时只返回节点总数
+type ReqSnapshotNodes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StateHash []byte `protobuf:"bytes,1,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	Start     int64  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Count     int64  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ReqSnapshotNodes) Reset() {
+	*x = ReqSnapshotNodes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_db_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqSnapshotNodes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqSnapshotNodes) ProtoMessage() {}
+
+func (x *ReqSnapshotNodes) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqSnapshotNodes.ProtoReflect.Descriptor instead.
+func (*ReqSnapshotNodes) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReqSnapshotNodes) GetStateHash() []byte {
+	if x != nil {
+		return x.StateHash
+	}
+	return nil
+}
+
+func (x *ReqSnapshotNodes) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *ReqSnapshotNodes) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SnapshotNodes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total int64           `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Nodes []*SnapshotNode `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+}
+
+func (x *SnapshotNodes) Reset() {
+	*x = SnapshotNodes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_db_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotNodes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotNodes) ProtoMessage() {}
+
+func (x *SnapshotNodes) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotNodes.ProtoReflect.Descriptor instead.
+func (*SnapshotNodes) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SnapshotNodes) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SnapshotNodes) GetNodes() []*SnapshotNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+// 按顺序导入状态树的快照节点，start为这一批节点的序号，last为true时校验roothash并完成导入
+type SnapshotImport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StateHash []byte          `protobuf:"bytes,1,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	Height    int64           `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Start     int64           `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	Nodes     []*SnapshotNode `protobuf:"bytes,4,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Last      bool            `protobuf:"varint,5,opt,name=last,proto3" json:"last,omitempty"`
+}
+
+func (x *SnapshotImport) Reset() {
+	*x = SnapshotImport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_db_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotImport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotImport) ProtoMessage() {}
+
+func (x *SnapshotImport) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotImport.ProtoReflect.Descriptor instead.
+func (*SnapshotImport) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SnapshotImport) GetStateHash() []byte {
+	if x != nil {
+		return x.StateHash
+	}
+	return nil
+}
+
+func (x *SnapshotImport) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *SnapshotImport) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *SnapshotImport) GetNodes() []*SnapshotNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *SnapshotImport) GetLast() bool {
+	if x != nil {
+		return x.Last
+	}
+	return false
+}
+
+var File_db_proto protoreflect.FileDescriptor
+
+var file_db_proto_rawDesc = []byte{
+	0x0a, 0x08, 0x64, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x5e, 0x0a, 0x08, 0x4c, 0x65, 0x61, 0x66, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22,
+	0x71, 0x0a, 0x09, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x6c, 0x65, 0x66, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x6c, 0x65, 0x66, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x69, 0x67, 0x68,
+	0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x72, 0x69, 0x67,
+	0x68, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x22, 0x75, 0x0a, 0x09, 0x4d, 0x41, 0x56, 0x4c, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x65, 0x61, 0x66, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x6c, 0x65, 0x61, 0x66, 0x48, 0x61, 0x73, 0x68, 0x12, 0x30, 0x0a, 0x0a, 0x69,
+	0x6e, 0x6e, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x0a, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x1a, 0x0a,
 	0x08, 0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x08, 0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x22, 0x99, 0x01, 0x0a, 0x09, 0x53, 0x74,
-	0x6f, 0x72, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x6c, 0x65, 0x66, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x08, 0x6c, 0x65, 0x66, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x72,
-	0x69, 0x67, 0x68, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
-	0x72, 0x69, 0x67, 0x68, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x41, 0x0a, 0x0a, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x44, 0x42,
-	0x53, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x02, 0x4b, 0x56, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x08, 0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x22, 0x69, 0x0a, 0x0d, 0x4d, 0x41, 0x56,
+	0x4c, 0x4c, 0x65, 0x61, 0x66, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49,
+	0x6e, 0x6e, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x4e,
+	0x6f, 0x64, 0x65, 0x73, 0x22, 0xd7, 0x01, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4b, 0x65,
+	0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x0a, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x4e,
+	0x6f, 0x64, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x69, 0x6e,
+	0x6e, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x6c, 0x65, 0x66, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d,
+	0x41, 0x56, 0x4c, 0x4c, 0x65, 0x61, 0x66, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x04, 0x6c, 0x65,
+	0x66, 0x74, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x41, 0x56, 0x4c, 0x4c, 0x65,
+	0x61, 0x66, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x05, 0x72, 0x69, 0x67, 0x68, 0x74, 0x22, 0x58,
+	0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2c, 0x0a, 0x06, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x52, 0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x09, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x6c, 0x65, 0x66, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x08, 0x6c, 0x65, 0x66, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x69,
+	0x67, 0x68, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x72,
+	0x69, 0x67, 0x68, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x22, 0x41, 0x0a, 0x0a, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x44, 0x42, 0x53,
+	0x65, 0x74, 0x12, 0x1f, 0x0a, 0x02, 0x4b, 0x56, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x02, 0x4b, 0x56, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x22, 0x7f, 0x0a, 0x0b, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
+	0x44, 0x42, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x22, 0x34, 0x0a, 0x0a, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x44, 0x42, 0x47, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x22, 0x29,
+	0x0a, 0x0f, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x61, 0x0a, 0x08, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x53, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x48, 0x61,
+	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x02, 0x4b, 0x56, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x02, 0x4b, 0x56, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x22, 0x7f, 0x0a, 0x0b, 0x4c, 0x6f, 0x63, 0x61,
-	0x6c, 0x44, 0x42, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69,
-	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x22, 0x34, 0x0a, 0x0a, 0x4c, 0x6f, 0x63,
-	0x61, 0x6c, 0x44, 0x42, 0x47, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x78, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x22,
-	0x29, 0x0a, 0x0f, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0c, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x61, 0x0a, 0x08, 0x53, 0x74,
-	0x6f, 0x72, 0x65, 0x53, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x48,
-	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x48, 0x61, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x02, 0x4b, 0x56, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x02, 0x4b, 0x56, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x40, 0x0a,
-	0x08, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x65, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61,
+	0x52, 0x02, 0x4b, 0x56, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x40, 0x0a, 0x08,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x65, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x6d,
+	0x0a, 0x10, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x53, 0x79,
+	0x6e, 0x63, 0x12, 0x2b, 0x0a, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x53, 0x65, 0x74, 0x52, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73,
+	0x79, 0x6e, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x22, 0x3c, 0x0a,
+	0x08, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x47, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61,
 	0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
-	0x6d, 0x0a, 0x10, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x53,
-	0x79, 0x6e, 0x63, 0x12, 0x2b, 0x0a, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74,
-	0x6f, 0x72, 0x65, 0x53, 0x65, 0x74, 0x52, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x65, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
-	0x73, 0x79, 0x6e, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x22, 0x3c,
-	0x0a, 0x08, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x47, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x29, 0x0a, 0x0f,
-	0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52,
-	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x09, 0x53, 0x74, 0x6f, 0x72,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x48, 0x61,
-	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x48,
-	0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x75, 0x66, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x75, 0x66,
-	0x66, 0x69, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0xd2, 0x01,
-	0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x66, 0x66,
-	0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78,
-	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x6e, 0x65, 0x78, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6e,
-	0x65, 0x78, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x08,
-	0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x73, 0x22, 0x21, 0x0a, 0x09, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12,
-	0x14, 0x0a, 0x05, 0x68, 0x61, 0x73, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05,
-	0x68, 0x61, 0x73, 0x68, 0x73, 0x22, 0x28, 0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x42,
-	0x1f, 0x5a, 0x1d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x33, 0x33,
-	0x63, 0x6e, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x33, 0x33, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x29, 0x0a, 0x0f, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x09, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x48, 0x61, 0x73,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75,
+	0x66, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x75, 0x66, 0x66,
+	0x69, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0xd2, 0x01, 0x0a,
+	0x0e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69,
+	0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6e,
+	0x65, 0x78, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6e, 0x65,
+	0x78, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x22, 0x21, 0x0a, 0x09, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14,
+	0x0a, 0x05, 0x68, 0x61, 0x73, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x68,
+	0x61, 0x73, 0x68, 0x73, 0x22, 0x28, 0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x4e,
+	0x0a, 0x0c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x5c,
+	0x0a, 0x10, 0x52, 0x65, 0x71, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4e, 0x6f, 0x64,
+	0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x50, 0x0a, 0x0d,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x29, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x9b,
+	0x01, 0x0a, 0x0e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x29, 0x0a,
+	0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x73, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x42, 0x1f, 0x5a, 0x1d,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x33, 0x33, 0x63, 0x6e, 0x2f,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x33, 0x33, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1248,37 +1771,51 @@ func file_db_proto_rawDescGZIP() []byte {
 	return file_db_proto_rawDescData
 }
 
-var file_db_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_db_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_db_proto_goTypes = []interface{}{
 	(*LeafNode)(nil),         // 0: types.LeafNode
 	(*InnerNode)(nil),        // 1: types.InnerNode
 	(*MAVLProof)(nil),        // 2: types.MAVLProof
-	(*StoreNode)(nil),        // 3: types.StoreNode
-	(*LocalDBSet)(nil),       // 4: types.LocalDBSet
-	(*LocalDBList)(nil),      // 5: types.LocalDBList
-	(*LocalDBGet)(nil),       // 6: types.LocalDBGet
-	(*LocalReplyValue)(nil),  // 7: types.LocalReplyValue
-	(*StoreSet)(nil),         // 8: types.StoreSet
-	(*StoreDel)(nil),         // 9: types.StoreDel
-	(*StoreSetWithSync)(nil), // 10: types.StoreSetWithSync
-	(*StoreGet)(nil),         // 11: types.StoreGet
-	(*StoreReplyValue)(nil),  // 12: types.StoreReplyValue
-	(*StoreList)(nil),        // 13: types.StoreList
-	(*StoreListReply)(nil),   // 14: types.StoreListReply
-	(*PruneData)(nil),        // 15: types.PruneData
-	(*StoreValuePool)(nil),   // 16: types.StoreValuePool
-	(*KeyValue)(nil),         // 17: types.KeyValue
+	(*MAVLLeafProof)(nil),    // 3: types.MAVLLeafProof
+	(*StateKeyProof)(nil),    // 4: types.StateKeyProof
+	(*StateProof)(nil),       // 5: types.StateProof
+	(*StoreNode)(nil),        // 6: types.StoreNode
+	(*LocalDBSet)(nil),       // 7: types.LocalDBSet
+	(*LocalDBList)(nil),      // 8: types.LocalDBList
+	(*LocalDBGet)(nil),       // 9: types.LocalDBGet
+	(*LocalReplyValue)(nil),  // 10: types.LocalReplyValue
+	(*StoreSet)(nil),         // 11: types.StoreSet
+	(*StoreDel)(nil),         // 12: types.StoreDel
+	(*StoreSetWithSync)(nil), // 13: types.StoreSetWithSync
+	(*StoreGet)(nil),         // 14: types.StoreGet
+	(*StoreReplyValue)(nil),  // 15: types.StoreReplyValue
+	(*StoreList)(nil),        // 16: types.StoreList
+	(*StoreListReply)(nil),   // 17: types.StoreListReply
+	(*PruneData)(nil),        // 18: types.PruneData
+	(*StoreValuePool)(nil),   // 19: types.StoreValuePool
+	(*SnapshotNode)(nil),     // 20: types.SnapshotNode
+	(*ReqSnapshotNodes)(nil), // 21: types.ReqSnapshotNodes
+	(*SnapshotNodes)(nil),    // 22: types.SnapshotNodes
+	(*SnapshotImport)(nil),   // 23: types.SnapshotImport
+	(*KeyValue)(nil),         // 24: types.KeyValue
 }
 var file_db_proto_depIdxs = []int32{
 	1,  // 0: types.MAVLProof.innerNodes:type_name -> types.InnerNode
-	17, // 1: types.LocalDBSet.KV:type_name -> types.KeyValue
-	17, // 2: types.StoreSet.KV:type_name -> types.KeyValue
-	8,  // 3: types.StoreSetWithSync.storeset:type_name -> types.StoreSet
-	4,  // [4:4] is the sub-list for method output_type
-	4,  // [4:4] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	1,  // 1: types.MAVLLeafProof.innerNodes:type_name -> types.InnerNode
+	1,  // 2: types.StateKeyProof.innerNodes:type_name -> types.InnerNode
+	3,  // 3: types.StateKeyProof.left:type_name -> types.MAVLLeafProof
+	3,  // 4: types.StateKeyProof.right:type_name -> types.MAVLLeafProof
+	4,  // 5: types.StateProof.proofs:type_name -> types.StateKeyProof
+	24, // 6: types.LocalDBSet.KV:type_name -> types.KeyValue
+	24, // 7: types.StoreSet.KV:type_name -> types.KeyValue
+	11, // 8: types.StoreSetWithSync.storeset:type_name -> types.StoreSet
+	20, // 9: types.SnapshotNodes.nodes:type_name -> types.SnapshotNode
+	20, // 10: types.SnapshotImport.nodes:type_name -> types.SnapshotNode
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_db_proto_init() }
@@ -1325,7 +1862,7 @@ func file_db_proto_init() {
 			}
 		}
 		file_db_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StoreNode); i {
+			switch v := v.(*MAVLLeafProof); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1337,7 +1874,7 @@ func file_db_proto_init() {
 			}
 		}
 		file_db_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LocalDBSet); i {
+			switch v := v.(*StateKeyProof); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1349,7 +1886,7 @@ func file_db_proto_init() {
 			}
 		}
 		file_db_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LocalDBList); i {
+			switch v := v.(*StateProof); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1361,7 +1898,7 @@ func file_db_proto_init() {
 			}
 		}
 		file_db_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LocalDBGet); i {
+			switch v := v.(*StoreNode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1373,7 +1910,7 @@ func file_db_proto_init() {
 			}
 		}
 		file_db_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LocalReplyValue); i {
+			switch v := v.(*LocalDBSet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1385,7 +1922,7 @@ func file_db_proto_init() {
 			}
 		}
 		file_db_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StoreSet); i {
+			switch v := v.(*LocalDBList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1397,7 +1934,7 @@ func file_db_proto_init() {
 			}
 		}
 		file_db_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StoreDel); i {
+			switch v := v.(*LocalDBGet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1409,7 +1946,7 @@ func file_db_proto_init() {
 			}
 		}
 		file_db_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StoreSetWithSync); i {
+			switch v := v.(*LocalReplyValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1421,7 +1958,7 @@ func file_db_proto_init() {
 			}
 		}
 		file_db_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StoreGet); i {
+			switch v := v.(*StoreSet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1433,7 +1970,7 @@ func file_db_proto_init() {
 			}
 		}
 		file_db_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StoreReplyValue); i {
+			switch v := v.(*StoreDel); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1445,7 +1982,7 @@ func file_db_proto_init() {
 			}
 		}
 		file_db_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StoreList); i {
+			switch v := v.(*StoreSetWithSync); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1457,7 +1994,7 @@ func file_db_proto_init() {
 			}
 		}
 		file_db_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StoreListReply); i {
+			switch v := v.(*StoreGet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1469,7 +2006,7 @@ func file_db_proto_init() {
 			}
 		}
 		file_db_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PruneData); i {
+			switch v := v.(*StoreReplyValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1481,6 +2018,42 @@ func file_db_proto_init() {
 			}
 		}
 		file_db_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoreList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_db_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoreListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_db_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PruneData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_db_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StoreValuePool); i {
 			case 0:
 				return &v.state
@@ -1492,6 +2065,54 @@ func file_db_proto_init() {
 				return nil
 			}
 		}
+		file_db_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_db_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqSnapshotNodes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_db_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotNodes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_db_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotImport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1499,7 +2120,7 @@ func file_db_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_db_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -195,16 +195,29 @@ var (
 	ErrDisableWrite = errors.New("ErrDisableWrite")
 	ErrDisableRead  = errors.New("ErrDisableRead")
 
-	ErrConsensusHashErr   = errors.New("ErrConsensusHashErr")
-	ErrMaxCountPerTime    = errors.New("ErrMaxCountPerTime")
-	ErrInValidFileHeader  = errors.New("ErrInValidFileHeader")
-	ErrFileExists         = errors.New("ErrFileExists")
-	ErrSubscriberExist    = errors.New("ErrSubscriberExist")
-	ErrTooManySubscriber  = errors.New("ErrTooManySubscriber")
-	ErrPushNotSupport     = errors.New("ErrPushNotSupport")
-	ErrNotAllowModifyPush = errors.New("ErrNotAllowModifyPush")
-	ErrTxReceiptReduced   = errors.New("ErrTxReceiptReduced")
-	ErrPushNotSubscribed  = errors.New("ErrPushNotSubscribed")
-	ErrTxChainID          = errors.New("ErrTxChainID")
-	ErrTimeout            = errors.New("ErrTimeout")
+	ErrConsensusHashErr     = errors.New("ErrConsensusHashErr")
+	ErrMaxCountPerTime      = errors.New("ErrMaxCountPerTime")
+	ErrInValidFileHeader    = errors.New("ErrInValidFileHeader")
+	ErrFileExists           = errors.New("ErrFileExists")
+	ErrSubscriberExist      = errors.New("ErrSubscriberExist")
+	ErrTooManySubscriber    = errors.New("ErrTooManySubscriber")
+	ErrPushNotSupport       = errors.New("ErrPushNotSupport")
+	ErrNotAllowModifyPush   = errors.New("ErrNotAllowModifyPush")
+	ErrTxReceiptReduced     = errors.New("ErrTxReceiptReduced")
+	ErrPushNotSubscribed    = errors.New("ErrPushNotSubscribed")
+	ErrPushAuthFailed       = errors.New("ErrPushAuthFailed")
+	ErrPushOutboxNotSupport = errors.New("ErrPushOutboxNotSupport")
+	ErrStateHashMismatch    = errors.New("ErrStateHashMismatch")
+	ErrInvalidStateProof    = errors.New("ErrInvalidStateProof")
+	ErrTxChainID            = errors.New("ErrTxChainID")
+	ErrTimeout              = errors.New("ErrTimeout")
+	ErrInvalidTxProof       = errors.New("ErrInvalidTxProof")
+	ErrReplaceFeeTooLow     = errors.New("ErrReplaceFeeTooLow")
+	ErrBaseFee              = errors.New("ErrBaseFee")
+	ErrSnapshotNode         = errors.New("ErrSnapshotNode")
+	ErrAPIKeyRequired       = errors.New("ErrAPIKeyRequired")
+	ErrAPIKeyInvalid        = errors.New("ErrAPIKeyInvalid")
+	ErrAPIKeyMethodDenied   = errors.New("ErrAPIKeyMethodDenied")
+	ErrRateLimited          = errors.New("ErrRateLimited")
+	ErrNoLightHeaderChecker = errors.New("ErrNoLightHeaderChecker")
 )
@@ -209,6 +209,34 @@ const (
 	//返回节点中最高的区块高度
 	EventHighestBlock = 370
 	EventGetEvmNonce  = 371
+	//删除推送订阅
+	EventRemovePush = 372
+	//暂停推送订阅
+	EventPausePush = 373
+	//获取推送服务的运行统计
+	EventGetPushStats = 374
+	//读取推送outbox
+	EventReadPushOutbox = 375
+	//获取状态数据的mavl证明
+	EventStoreGetProof = 376
+	//轻节点从peer获取交易及其merkle证明
+	EventFetchTxProof = 377
+	//按后序遍历获取mavl状态树的快照节点
+	EventStoreGetSnapshot = 378
+	//导入mavl状态树的快照节点
+	EventStoreImportSnapshot = 379
+	//快照同步从peer获取检查点区块以及快照信息
+	EventFetchSnapshotInfo = 380
+	//快照同步从peer获取状态树的快照节点
+	EventFetchSnapshotChunk = 381
+	//rpc开启或者关闭mempool新加入交易的推送
+	EventSubMempoolTx = 382
+	//mempool推送新加入的交易给rpc
+	EventPushMempoolTx = 383
+	//获取p2p节点评分列表
+	EventPeerScores = 384
+	//获取mempool中nonce不连续的future队列中的交易
+	EventGetFutureMempool = 385
 )
 
 var eventName = map[int]string{
@@ -379,4 +407,18 @@ var eventName = map[int]string{
 	EventPushTxResult:               "EventPushTxResult",
 	EventHighestBlock:               "EventHighestBlock",
 	EventGetEvmNonce:                "EventGetEvmNonce",
+	EventRemovePush:                 "EventRemovePush",
+	EventPausePush:                  "EventPausePush",
+	EventGetPushStats:               "EventGetPushStats",
+	EventReadPushOutbox:             "EventReadPushOutbox",
+	EventStoreGetProof:              "EventStoreGetProof",
+	EventFetchTxProof:               "EventFetchTxProof",
+	EventStoreGetSnapshot:           "EventStoreGetSnapshot",
+	EventStoreImportSnapshot:        "EventStoreImportSnapshot",
+	EventFetchSnapshotInfo:          "EventFetchSnapshotInfo",
+	EventFetchSnapshotChunk:         "EventFetchSnapshotChunk",
+	EventSubMempoolTx:               "EventSubMempoolTx",
+	EventPushMempoolTx:              "EventPushMempoolTx",
+	EventPeerScores:                 "EventPeerScores",
+	EventGetFutureMempool:           "EventGetFutureMempool",
 }
@@ -143,6 +143,10 @@ func (f *Forks) SetTestNetFork() {
 	f.SetFork("ForkRootHash", 4500000)
 	f.SetFork(address.ForkFormatAddressKey, 0)
 	f.setFork("ForkCheckEthTxSort", 0)
+	//交易乐观并行执行，执行结果和串行执行一致，默认不开启
+	f.SetFork("ForkParallelExec", MaxHeight)
+	//区块头中记录基础手续费，根据父区块的交易数量动态调整，基础手续费部分燃烧
+	f.SetFork("ForkBaseFee", MaxHeight)
 }
 
 func (f *Forks) setLocalFork() {
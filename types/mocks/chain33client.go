@@ -77,6 +77,36 @@ func (_m *Chain33Client) CloseQueue(ctx context.Context, in *types.ReqNil, opts
 	return r0, r1
 }
 
+// ConsumePushOutbox provides a mock function with given fields: ctx, in, opts
+func (_m *Chain33Client) ConsumePushOutbox(ctx context.Context, in *types.ReqPushOutbox, opts ...grpc.CallOption) (types.Chain33_ConsumePushOutboxClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 types.Chain33_ConsumePushOutboxClient
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ReqPushOutbox, ...grpc.CallOption) types.Chain33_ConsumePushOutboxClient); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Chain33_ConsumePushOutboxClient)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.ReqPushOutbox, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ConvertExectoAddr provides a mock function with given fields: ctx, in, opts
 func (_m *Chain33Client) ConvertExectoAddr(ctx context.Context, in *types.ReqString, opts ...grpc.CallOption) (*types.ReplyString, error) {
 	_va := make([]interface{}, len(opts))
@@ -1217,6 +1247,36 @@ func (_m *Chain33Client) GetPushSeqLastNum(ctx context.Context, in *types.ReqStr
 	return r0, r1
 }
 
+// GetPushStats provides a mock function with given fields: ctx, in, opts
+func (_m *Chain33Client) GetPushStats(ctx context.Context, in *types.ReqString, opts ...grpc.CallOption) (*types.PushStats, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.PushStats
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ReqString, ...grpc.CallOption) *types.PushStats); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.PushStats)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.ReqString, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSeed provides a mock function with given fields: ctx, in, opts
 func (_m *Chain33Client) GetSeed(ctx context.Context, in *types.GetSeedByPw, opts ...grpc.CallOption) (*types.ReplySeed, error) {
 	_va := make([]interface{}, len(opts))
@@ -1307,6 +1367,36 @@ func (_m *Chain33Client) GetServerTime(ctx context.Context, in *types.ReqNil, op
 	return r0, r1
 }
 
+// GetStateProof provides a mock function with given fields: ctx, in, opts
+func (_m *Chain33Client) GetStateProof(ctx context.Context, in *types.ReqStateProof, opts ...grpc.CallOption) (*types.ReplyStateProof, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.ReplyStateProof
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ReqStateProof, ...grpc.CallOption) *types.ReplyStateProof); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReplyStateProof)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.ReqStateProof, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTransactionByAddr provides a mock function with given fields: ctx, in, opts
 func (_m *Chain33Client) GetTransactionByAddr(ctx context.Context, in *types.ReqAddr, opts ...grpc.CallOption) (*types.ReplyTxInfos, error) {
 	_va := make([]interface{}, len(opts))
@@ -1727,6 +1817,36 @@ func (_m *Chain33Client) NewAccount(ctx context.Context, in *types.ReqNewAccount
 	return r0, r1
 }
 
+// PausePushSubscribe provides a mock function with given fields: ctx, in, opts
+func (_m *Chain33Client) PausePushSubscribe(ctx context.Context, in *types.ReqPushSubscribeAuth, opts ...grpc.CallOption) (*types.ReplySubscribePush, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.ReplySubscribePush
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ReqPushSubscribeAuth, ...grpc.CallOption) *types.ReplySubscribePush); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReplySubscribePush)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.ReqPushSubscribeAuth, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // QueryChain provides a mock function with given fields: ctx, in, opts
 func (_m *Chain33Client) QueryChain(ctx context.Context, in *types.ChainExecutor, opts ...grpc.CallOption) (*types.Reply, error) {
 	_va := make([]interface{}, len(opts))
@@ -1877,6 +1997,66 @@ func (_m *Chain33Client) ReWriteTx(ctx context.Context, in *types.ReWriteRawTx,
 	return r0, r1
 }
 
+// ReadPushOutbox provides a mock function with given fields: ctx, in, opts
+func (_m *Chain33Client) ReadPushOutbox(ctx context.Context, in *types.ReqPushOutbox, opts ...grpc.CallOption) (*types.PushOutboxRecords, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.PushOutboxRecords
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ReqPushOutbox, ...grpc.CallOption) *types.PushOutboxRecords); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.PushOutboxRecords)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.ReqPushOutbox, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemovePushSubscribe provides a mock function with given fields: ctx, in, opts
+func (_m *Chain33Client) RemovePushSubscribe(ctx context.Context, in *types.ReqPushSubscribeAuth, opts ...grpc.CallOption) (*types.ReplySubscribePush, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.ReplySubscribePush
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ReqPushSubscribeAuth, ...grpc.CallOption) *types.ReplySubscribePush); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReplySubscribePush)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.ReqPushSubscribeAuth, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SaveSeed provides a mock function with given fields: ctx, in, opts
 func (_m *Chain33Client) SaveSeed(ctx context.Context, in *types.SaveSeedByPw, opts ...grpc.CallOption) (*types.Reply, error) {
 	_va := make([]interface{}, len(opts))
@@ -2207,6 +2387,36 @@ func (_m *Chain33Client) SignWalletRecoverTx(ctx context.Context, in *types.ReqS
 	return r0, r1
 }
 
+// SimulateTransaction provides a mock function with given fields: ctx, in, opts
+func (_m *Chain33Client) SimulateTransaction(ctx context.Context, in *types.ReqSimulateTx, opts ...grpc.CallOption) (*types.ReplySimulateTx, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.ReplySimulateTx
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ReqSimulateTx, ...grpc.CallOption) *types.ReplySimulateTx); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReplySimulateTx)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.ReqSimulateTx, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SubEvent provides a mock function with given fields: ctx, in, opts
 func (_m *Chain33Client) SubEvent(ctx context.Context, in *types.ReqSubscribe, opts ...grpc.CallOption) (types.Chain33_SubEventClient, error) {
 	_va := make([]interface{}, len(opts))
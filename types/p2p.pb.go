@@ -2113,6 +2113,7 @@ type Peer struct {
 	RunningTime    string  `protobuf:"bytes,10,opt,name=runningTime,proto3" json:"runningTime,omitempty"`
 	FullNode       bool    `protobuf:"varint,11,opt,name=fullNode,proto3" json:"fullNode,omitempty"`
 	Blocked        bool    `protobuf:"varint,12,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	Score          int32   `protobuf:"varint,13,opt,name=score,proto3" json:"score,omitempty"`
 }
 
 func (x *Peer) Reset() {
@@ -2231,6 +2232,13 @@ func (x *Peer) GetBlocked() bool {
 	return false
 }
 
+func (x *Peer) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
 //*
 // peer 列表
 type PeerList struct {
@@ -2395,6 +2403,10 @@ type NodeNetInfo struct {
 	Ratein       string `protobuf:"bytes,8,opt,name=ratein,proto3" json:"ratein,omitempty"`
 	Rateout      string `protobuf:"bytes,9,opt,name=rateout,proto3" json:"rateout,omitempty"`
 	Ratetotal    string `protobuf:"bytes,10,opt,name=ratetotal,proto3" json:"ratetotal,omitempty"`
+	//全局带宽使用情况
+	Bandwidth *BandwidthUsage `protobuf:"bytes,11,opt,name=bandwidth,proto3" json:"bandwidth,omitempty"`
+	//各协议带宽使用情况
+	ProtocolBandwidth []*BandwidthUsage `protobuf:"bytes,12,rep,name=protocolBandwidth,proto3" json:"protocolBandwidth,omitempty"`
 }
 
 func (x *NodeNetInfo) Reset() {
@@ -2499,6 +2511,126 @@ func (x *NodeNetInfo) GetRatetotal() string {
 	return ""
 }
 
+func (x *NodeNetInfo) GetBandwidth() *BandwidthUsage {
+	if x != nil {
+		return x.Bandwidth
+	}
+	return nil
+}
+
+func (x *NodeNetInfo) GetProtocolBandwidth() []*BandwidthUsage {
+	if x != nil {
+		return x.ProtocolBandwidth
+	}
+	return nil
+}
+
+//带宽使用情况, 速率和限制单位为字节每秒, 限制为0表示不限制
+type BandwidthUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Protocol string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	//优先级, 数值越小优先级越高
+	Priority int32 `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+	RateIn   int64 `protobuf:"varint,3,opt,name=rateIn,proto3" json:"rateIn,omitempty"`
+	RateOut  int64 `protobuf:"varint,4,opt,name=rateOut,proto3" json:"rateOut,omitempty"`
+	LimitIn  int64 `protobuf:"varint,5,opt,name=limitIn,proto3" json:"limitIn,omitempty"`
+	LimitOut int64 `protobuf:"varint,6,opt,name=limitOut,proto3" json:"limitOut,omitempty"`
+	//因带宽限制累计等待的时长, 单位毫秒
+	ThrottleIn  int64 `protobuf:"varint,7,opt,name=throttleIn,proto3" json:"throttleIn,omitempty"`
+	ThrottleOut int64 `protobuf:"varint,8,opt,name=throttleOut,proto3" json:"throttleOut,omitempty"`
+}
+
+func (x *BandwidthUsage) Reset() {
+	*x = BandwidthUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2p_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BandwidthUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BandwidthUsage) ProtoMessage() {}
+
+func (x *BandwidthUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_p2p_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BandwidthUsage.ProtoReflect.Descriptor instead.
+func (*BandwidthUsage) Descriptor() ([]byte, []int) {
+	return file_p2p_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *BandwidthUsage) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *BandwidthUsage) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *BandwidthUsage) GetRateIn() int64 {
+	if x != nil {
+		return x.RateIn
+	}
+	return 0
+}
+
+func (x *BandwidthUsage) GetRateOut() int64 {
+	if x != nil {
+		return x.RateOut
+	}
+	return 0
+}
+
+func (x *BandwidthUsage) GetLimitIn() int64 {
+	if x != nil {
+		return x.LimitIn
+	}
+	return 0
+}
+
+func (x *BandwidthUsage) GetLimitOut() int64 {
+	if x != nil {
+		return x.LimitOut
+	}
+	return 0
+}
+
+func (x *BandwidthUsage) GetThrottleIn() int64 {
+	if x != nil {
+		return x.ThrottleIn
+	}
+	return 0
+}
+
+func (x *BandwidthUsage) GetThrottleOut() int64 {
+	if x != nil {
+		return x.ThrottleOut
+	}
+	return 0
+}
+
 type PeersReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2510,7 +2642,7 @@ type PeersReply struct {
 func (x *PeersReply) Reset() {
 	*x = PeersReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[36]
+		mi := &file_p2p_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2523,7 +2655,7 @@ func (x *PeersReply) String() string {
 func (*PeersReply) ProtoMessage() {}
 
 func (x *PeersReply) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[36]
+	mi := &file_p2p_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2536,7 +2668,7 @@ func (x *PeersReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PeersReply.ProtoReflect.Descriptor instead.
 func (*PeersReply) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{36}
+	return file_p2p_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *PeersReply) GetPeers() []*PeersInfo {
@@ -2561,7 +2693,7 @@ type PeersInfo struct {
 func (x *PeersInfo) Reset() {
 	*x = PeersInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_p2p_proto_msgTypes[37]
+		mi := &file_p2p_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2574,7 +2706,7 @@ func (x *PeersInfo) String() string {
 func (*PeersInfo) ProtoMessage() {}
 
 func (x *PeersInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_proto_msgTypes[37]
+	mi := &file_p2p_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2587,7 +2719,7 @@ func (x *PeersInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PeersInfo.ProtoReflect.Descriptor instead.
 func (*PeersInfo) Descriptor() ([]byte, []int) {
-	return file_p2p_proto_rawDescGZIP(), []int{37}
+	return file_p2p_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *PeersInfo) GetName() string {
@@ -2823,7 +2955,7 @@ var file_p2p_proto_rawDesc = []byte{
 	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x30, 0x0a, 0x08, 0x49, 0x6e, 0x76, 0x44, 0x61, 0x74, 0x61,
 	0x73, 0x12, 0x24, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
 	0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x76, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xe1, 0x02, 0x0a, 0x04, 0x50, 0x65, 0x65, 0x72,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xf7, 0x02, 0x0a, 0x04, 0x50, 0x65, 0x65, 0x72,
 	0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
 	0x61, 0x64, 0x64, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
@@ -2845,107 +2977,131 @@ var file_p2p_proto_rawDesc = []byte{
 	0x67, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x6f, 0x64,
 	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x6f, 0x64,
 	0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x22, 0x2d, 0x0a, 0x08, 0x50,
-	0x65, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50,
-	0x65, 0x65, 0x72, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x22, 0x29, 0x0a, 0x0d, 0x50, 0x32,
-	0x50, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x70,
-	0x32, 0x70, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x32,
-	0x70, 0x54, 0x79, 0x70, 0x65, 0x22, 0x2c, 0x0a, 0x10, 0x50, 0x32, 0x50, 0x47, 0x65, 0x74, 0x4e,
-	0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x32, 0x70,
-	0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x32, 0x70, 0x54,
-	0x79, 0x70, 0x65, 0x22, 0xb5, 0x02, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x4e, 0x65, 0x74, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x61,
-	0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x61, 0x64, 0x64, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
-	0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61,
-	0x6c, 0x61, 0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x09, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x1a, 0x0a,
-	0x08, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x6f, 0x75,
-	0x74, 0x69, 0x6e, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0c, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x70, 0x65, 0x65, 0x72, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x09, 0x70, 0x65, 0x65, 0x72, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72,
-	0x61, 0x74, 0x65, 0x69, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x74,
-	0x65, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x61, 0x74, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x61, 0x74, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1c, 0x0a,
-	0x09, 0x72, 0x61, 0x74, 0x65, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x34, 0x0a, 0x0a, 0x50,
-	0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x26, 0x0a, 0x05, 0x70, 0x65, 0x65,
-	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x50, 0x65, 0x65, 0x72, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72,
-	0x73, 0x22, 0x85, 0x01, 0x0a, 0x09, 0x50, 0x65, 0x65, 0x72, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x6f, 0x66, 0x74, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6f,
-	0x66, 0x74, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x32, 0x70,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70,
-	0x32, 0x70, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x32, 0x8c, 0x07, 0x0a, 0x0b, 0x70, 0x32,
-	0x70, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x0b, 0x42, 0x72, 0x6f,
-	0x61, 0x64, 0x43, 0x61, 0x73, 0x74, 0x54, 0x78, 0x12, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x50, 0x32, 0x50, 0x54, 0x78, 0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x43,
-	0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x50, 0x32, 0x50, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x28, 0x0a, 0x04, 0x50, 0x69, 0x6e,
-	0x67, 0x12, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x32, 0x50, 0x50, 0x69, 0x6e,
-	0x67, 0x1a, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x32, 0x50, 0x50, 0x6f, 0x6e,
-	0x67, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x11,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x32, 0x50, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64,
-	0x72, 0x1a, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x32, 0x50, 0x41, 0x64, 0x64,
-	0x72, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x32, 0x50, 0x47, 0x65,
-	0x74, 0x41, 0x64, 0x64, 0x72, 0x1a, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x32,
-	0x50, 0x41, 0x64, 0x64, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x07, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50,
-	0x32, 0x50, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x50, 0x32, 0x50, 0x56, 0x65, 0x72, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x32, 0x0a,
-	0x08, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x32, 0x12, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x50, 0x32, 0x50, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x11, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x32, 0x50, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
-	0x00, 0x12, 0x2d, 0x0a, 0x0b, 0x53, 0x6f, 0x66, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x32, 0x50, 0x50, 0x69, 0x6e, 0x67,
-	0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
-	0x12, 0x31, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x13, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x32, 0x50, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x73, 0x1a, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x32, 0x50, 0x49, 0x6e,
-	0x76, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x50, 0x6f, 0x6f,
-	0x6c, 0x12, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x32, 0x50, 0x47, 0x65, 0x74,
-	0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x1a, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x50, 0x32, 0x50, 0x49, 0x6e, 0x76, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x44,
-	0x61, 0x74, 0x61, 0x12, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x32, 0x50, 0x47,
-	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49,
-	0x6e, 0x76, 0x44, 0x61, 0x74, 0x61, 0x73, 0x22, 0x00, 0x30, 0x01, 0x12, 0x37, 0x0a, 0x0a, 0x47,
-	0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x50, 0x32, 0x50, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x1a,
-	0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x32, 0x50, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x73, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x32, 0x50, 0x47,
-	0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x12, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x50, 0x32, 0x50, 0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00,
-	0x12, 0x3b, 0x0a, 0x10, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x52, 0x65, 0x61, 0x64, 0x12, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x72, 0x6f,
-	0x61, 0x64, 0x43, 0x61, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0d, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x4e, 0x69, 0x6c, 0x22, 0x00, 0x28, 0x01, 0x12, 0x3c, 0x0a,
-	0x10, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x6e,
-	0x64, 0x12, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x32, 0x50, 0x50, 0x69, 0x6e,
-	0x67, 0x1a, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x43,
-	0x61, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x22, 0x00, 0x30, 0x01, 0x12, 0x33, 0x0a, 0x0e, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x0e, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x32, 0x50, 0x50, 0x69, 0x6e, 0x67, 0x1a, 0x0f, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00,
-	0x12, 0x36, 0x0a, 0x0f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x50, 0x65, 0x65,
-	0x72, 0x73, 0x32, 0x12, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x32, 0x50, 0x50,
-	0x69, 0x6e, 0x67, 0x1a, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x65, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x1f, 0x5a, 0x1d, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x33, 0x33, 0x63, 0x6e, 0x2f, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x33, 0x33, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x28, 0x08, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x22, 0x2d, 0x0a, 0x08, 0x50, 0x65, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73,
+	0x22, 0x29, 0x0a, 0x0d, 0x50, 0x32, 0x50, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x32, 0x70, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x32, 0x70, 0x54, 0x79, 0x70, 0x65, 0x22, 0x2c, 0x0a, 0x10, 0x50,
+	0x32, 0x50, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x32, 0x70, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x32, 0x70, 0x54, 0x79, 0x70, 0x65, 0x22, 0xaf, 0x03, 0x0a, 0x0b, 0x4e, 0x6f,
+	0x64, 0x65, 0x4e, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x61, 0x64, 0x64, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x61, 0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e,
+	0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12,
+	0x22, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x65, 0x65, 0x72, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x65, 0x69, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x61, 0x74, 0x65, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x61, 0x74,
+	0x65, 0x6f, 0x75, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x61, 0x74, 0x65,
+	0x6f, 0x75, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x61, 0x74, 0x65, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x33, 0x0a, 0x09, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x61, 0x6e,
+	0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x09, 0x62, 0x61, 0x6e,
+	0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x43, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x0c, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69,
+	0x64, 0x74, 0x68, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x22, 0xf2, 0x01, 0x0a, 0x0e,
+	0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x65, 0x49, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x61, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x72, 0x61, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x49, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x49, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x4f, 0x75, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x49, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x49, 0x6e, 0x12, 0x20,
+	0x0a, 0x0b, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x4f, 0x75, 0x74,
+	0x22, 0x34, 0x0a, 0x0a, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x26,
+	0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x09, 0x50, 0x65, 0x65, 0x72, 0x73,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x73, 0x6f, 0x66, 0x74, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x73, 0x6f, 0x66, 0x74, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e,
+	0x0a, 0x0a, 0x70, 0x32, 0x70, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x70, 0x32, 0x70, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x32, 0x8c,
+	0x07, 0x0a, 0x0b, 0x70, 0x32, 0x70, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2b,
+	0x0a, 0x0b, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x43, 0x61, 0x73, 0x74, 0x54, 0x78, 0x12, 0x0c, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x32, 0x50, 0x54, 0x78, 0x1a, 0x0c, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0e, 0x42,
+	0x72, 0x6f, 0x61, 0x64, 0x43, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x0f, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x32, 0x50, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x1a, 0x0c,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x28,
+	0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50,
+	0x32, 0x50, 0x50, 0x69, 0x6e, 0x67, 0x1a, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50,
+	0x32, 0x50, 0x50, 0x6f, 0x6e, 0x67, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x12, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x32, 0x50, 0x47,
+	0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x1a, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50,
+	0x32, 0x50, 0x41, 0x64, 0x64, 0x72, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x50, 0x32, 0x50, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x1a, 0x12, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x50, 0x32, 0x50, 0x41, 0x64, 0x64, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00,
+	0x12, 0x30, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x11, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x50, 0x32, 0x50, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x10,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x32, 0x50, 0x56, 0x65, 0x72, 0x41, 0x63, 0x6b,
+	0x22, 0x00, 0x12, 0x32, 0x0a, 0x08, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x32, 0x12, 0x11,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x32, 0x50, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x1a, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x32, 0x50, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x0b, 0x53, 0x6f, 0x66, 0x74, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x32,
+	0x50, 0x50, 0x69, 0x6e, 0x67, 0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x12, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x32, 0x50, 0x47, 0x65,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x1a, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x50, 0x32, 0x50, 0x49, 0x6e, 0x76, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d,
+	0x65, 0x6d, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50,
+	0x32, 0x50, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x1a, 0x0d, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x32, 0x50, 0x49, 0x6e, 0x76, 0x22, 0x00, 0x12, 0x31, 0x0a,
+	0x07, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x50, 0x32, 0x50, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0f, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x76, 0x44, 0x61, 0x74, 0x61, 0x73, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x37, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x14,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x32, 0x50, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x1a, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x32, 0x50,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x50, 0x32, 0x50, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x1a,
+	0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x32, 0x50, 0x50, 0x65, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x10, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x61, 0x64, 0x12, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x43, 0x61, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x1a,
+	0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x4e, 0x69, 0x6c, 0x22, 0x00,
+	0x28, 0x01, 0x12, 0x3c, 0x0a, 0x10, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x53, 0x65, 0x6e, 0x64, 0x12, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50,
+	0x32, 0x50, 0x50, 0x69, 0x6e, 0x67, 0x1a, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42,
+	0x72, 0x6f, 0x61, 0x64, 0x43, 0x61, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x33, 0x0a, 0x0e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x50, 0x65, 0x65,
+	0x72, 0x73, 0x12, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x32, 0x50, 0x50, 0x69,
+	0x6e, 0x67, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x49, 0x6e, 0x50, 0x65, 0x65, 0x72, 0x73, 0x32, 0x12, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x50, 0x32, 0x50, 0x50, 0x69, 0x6e, 0x67, 0x1a, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x1f, 0x5a,
+	0x1d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x33, 0x33, 0x63, 0x6e,
+	0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x33, 0x33, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2960,7 +3116,7 @@ func file_p2p_proto_rawDescGZIP() []byte {
 	return file_p2p_proto_rawDescData
 }
 
-var file_p2p_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
+var file_p2p_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_p2p_proto_goTypes = []interface{}{
 	(*P2PGetPeerInfo)(nil),   // 0: types.P2PGetPeerInfo
 	(*P2PPeerInfo)(nil),      // 1: types.P2PPeerInfo
@@ -2998,28 +3154,29 @@ var file_p2p_proto_goTypes = []interface{}{
 	(*P2PGetPeerReq)(nil),    // 33: types.P2PGetPeerReq
 	(*P2PGetNetInfoReq)(nil), // 34: types.P2PGetNetInfoReq
 	(*NodeNetInfo)(nil),      // 35: types.NodeNetInfo
-	(*PeersReply)(nil),       // 36: types.PeersReply
-	(*PeersInfo)(nil),        // 37: types.PeersInfo
-	(*Header)(nil),           // 38: types.Header
-	(*Signature)(nil),        // 39: types.Signature
-	(*Transaction)(nil),      // 40: types.Transaction
-	(*Block)(nil),            // 41: types.Block
-	(*Reply)(nil),            // 42: types.Reply
-	(*ReqNil)(nil),           // 43: types.ReqNil
+	(*BandwidthUsage)(nil),   // 36: types.BandwidthUsage
+	(*PeersReply)(nil),       // 37: types.PeersReply
+	(*PeersInfo)(nil),        // 38: types.PeersInfo
+	(*Header)(nil),           // 39: types.Header
+	(*Signature)(nil),        // 40: types.Signature
+	(*Transaction)(nil),      // 41: types.Transaction
+	(*Block)(nil),            // 42: types.Block
+	(*Reply)(nil),            // 43: types.Reply
+	(*ReqNil)(nil),           // 44: types.ReqNil
 }
 var file_p2p_proto_depIdxs = []int32{
-	38, // 0: types.P2PPeerInfo.header:type_name -> types.Header
-	39, // 1: types.P2PPing.sign:type_name -> types.Signature
+	39, // 0: types.P2PPeerInfo.header:type_name -> types.Header
+	40, // 1: types.P2PPing.sign:type_name -> types.Signature
 	1,  // 2: types.P2PAddrList.peerinfo:type_name -> types.P2PPeerInfo
 	13, // 3: types.P2PInv.invs:type_name -> types.Inventory
 	13, // 4: types.P2PGetData.invs:type_name -> types.Inventory
-	40, // 5: types.P2PTx.tx:type_name -> types.Transaction
+	41, // 5: types.P2PTx.tx:type_name -> types.Transaction
 	15, // 6: types.P2PTx.route:type_name -> types.P2PRoute
-	41, // 7: types.P2PBlock.block:type_name -> types.Block
-	38, // 8: types.LightBlock.header:type_name -> types.Header
-	40, // 9: types.LightBlock.minerTx:type_name -> types.Transaction
+	42, // 7: types.P2PBlock.block:type_name -> types.Block
+	39, // 8: types.LightBlock.header:type_name -> types.Header
+	41, // 9: types.LightBlock.minerTx:type_name -> types.Transaction
 	15, // 10: types.LightTx.route:type_name -> types.P2PRoute
-	40, // 11: types.P2PBlockTxReply.txs:type_name -> types.Transaction
+	41, // 11: types.P2PBlockTxReply.txs:type_name -> types.Transaction
 	21, // 12: types.P2PQueryData.txReq:type_name -> types.P2PTxReq
 	22, // 13: types.P2PQueryData.blockTxReq:type_name -> types.P2PBlockTxReq
 	16, // 14: types.BroadCastData.tx:type_name -> types.P2PTx
@@ -3030,52 +3187,54 @@ var file_p2p_proto_depIdxs = []int32{
 	18, // 19: types.BroadCastData.ltBlock:type_name -> types.LightBlock
 	24, // 20: types.BroadCastData.query:type_name -> types.P2PQueryData
 	23, // 21: types.BroadCastData.blockRep:type_name -> types.P2PBlockTxReply
-	38, // 22: types.P2PHeaders.headers:type_name -> types.Header
-	40, // 23: types.InvData.tx:type_name -> types.Transaction
-	41, // 24: types.InvData.block:type_name -> types.Block
+	39, // 22: types.P2PHeaders.headers:type_name -> types.Header
+	41, // 23: types.InvData.tx:type_name -> types.Transaction
+	42, // 24: types.InvData.block:type_name -> types.Block
 	29, // 25: types.InvDatas.items:type_name -> types.InvData
-	38, // 26: types.Peer.header:type_name -> types.Header
+	39, // 26: types.Peer.header:type_name -> types.Header
 	31, // 27: types.PeerList.peers:type_name -> types.Peer
-	37, // 28: types.PeersReply.peers:type_name -> types.PeersInfo
-	16, // 29: types.p2pgservice.BroadCastTx:input_type -> types.P2PTx
-	17, // 30: types.p2pgservice.BroadCastBlock:input_type -> types.P2PBlock
-	4,  // 31: types.p2pgservice.Ping:input_type -> types.P2PPing
-	6,  // 32: types.p2pgservice.GetAddr:input_type -> types.P2PGetAddr
-	6,  // 33: types.p2pgservice.GetAddrList:input_type -> types.P2PGetAddr
-	2,  // 34: types.p2pgservice.Version:input_type -> types.P2PVersion
-	2,  // 35: types.p2pgservice.Version2:input_type -> types.P2PVersion
-	4,  // 36: types.p2pgservice.SoftVersion:input_type -> types.P2PPing
-	10, // 37: types.p2pgservice.GetBlocks:input_type -> types.P2PGetBlocks
-	11, // 38: types.p2pgservice.GetMemPool:input_type -> types.P2PGetMempool
-	14, // 39: types.p2pgservice.GetData:input_type -> types.P2PGetData
-	27, // 40: types.p2pgservice.GetHeaders:input_type -> types.P2PGetHeaders
-	0,  // 41: types.p2pgservice.GetPeerInfo:input_type -> types.P2PGetPeerInfo
-	26, // 42: types.p2pgservice.ServerStreamRead:input_type -> types.BroadCastData
-	4,  // 43: types.p2pgservice.ServerStreamSend:input_type -> types.P2PPing
-	4,  // 44: types.p2pgservice.CollectInPeers:input_type -> types.P2PPing
-	4,  // 45: types.p2pgservice.CollectInPeers2:input_type -> types.P2PPing
-	42, // 46: types.p2pgservice.BroadCastTx:output_type -> types.Reply
-	42, // 47: types.p2pgservice.BroadCastBlock:output_type -> types.Reply
-	5,  // 48: types.p2pgservice.Ping:output_type -> types.P2PPong
-	7,  // 49: types.p2pgservice.GetAddr:output_type -> types.P2PAddr
-	8,  // 50: types.p2pgservice.GetAddrList:output_type -> types.P2PAddrList
-	3,  // 51: types.p2pgservice.Version:output_type -> types.P2PVerAck
-	2,  // 52: types.p2pgservice.Version2:output_type -> types.P2PVersion
-	42, // 53: types.p2pgservice.SoftVersion:output_type -> types.Reply
-	12, // 54: types.p2pgservice.GetBlocks:output_type -> types.P2PInv
-	12, // 55: types.p2pgservice.GetMemPool:output_type -> types.P2PInv
-	30, // 56: types.p2pgservice.GetData:output_type -> types.InvDatas
-	28, // 57: types.p2pgservice.GetHeaders:output_type -> types.P2PHeaders
-	1,  // 58: types.p2pgservice.GetPeerInfo:output_type -> types.P2PPeerInfo
-	43, // 59: types.p2pgservice.ServerStreamRead:output_type -> types.ReqNil
-	26, // 60: types.p2pgservice.ServerStreamSend:output_type -> types.BroadCastData
-	32, // 61: types.p2pgservice.CollectInPeers:output_type -> types.PeerList
-	36, // 62: types.p2pgservice.CollectInPeers2:output_type -> types.PeersReply
-	46, // [46:63] is the sub-list for method output_type
-	29, // [29:46] is the sub-list for method input_type
-	29, // [29:29] is the sub-list for extension type_name
-	29, // [29:29] is the sub-list for extension extendee
-	0,  // [0:29] is the sub-list for field type_name
+	36, // 28: types.NodeNetInfo.bandwidth:type_name -> types.BandwidthUsage
+	36, // 29: types.NodeNetInfo.protocolBandwidth:type_name -> types.BandwidthUsage
+	38, // 30: types.PeersReply.peers:type_name -> types.PeersInfo
+	16, // 31: types.p2pgservice.BroadCastTx:input_type -> types.P2PTx
+	17, // 32: types.p2pgservice.BroadCastBlock:input_type -> types.P2PBlock
+	4,  // 33: types.p2pgservice.Ping:input_type -> types.P2PPing
+	6,  // 34: types.p2pgservice.GetAddr:input_type -> types.P2PGetAddr
+	6,  // 35: types.p2pgservice.GetAddrList:input_type -> types.P2PGetAddr
+	2,  // 36: types.p2pgservice.Version:input_type -> types.P2PVersion
+	2,  // 37: types.p2pgservice.Version2:input_type -> types.P2PVersion
+	4,  // 38: types.p2pgservice.SoftVersion:input_type -> types.P2PPing
+	10, // 39: types.p2pgservice.GetBlocks:input_type -> types.P2PGetBlocks
+	11, // 40: types.p2pgservice.GetMemPool:input_type -> types.P2PGetMempool
+	14, // 41: types.p2pgservice.GetData:input_type -> types.P2PGetData
+	27, // 42: types.p2pgservice.GetHeaders:input_type -> types.P2PGetHeaders
+	0,  // 43: types.p2pgservice.GetPeerInfo:input_type -> types.P2PGetPeerInfo
+	26, // 44: types.p2pgservice.ServerStreamRead:input_type -> types.BroadCastData
+	4,  // 45: types.p2pgservice.ServerStreamSend:input_type -> types.P2PPing
+	4,  // 46: types.p2pgservice.CollectInPeers:input_type -> types.P2PPing
+	4,  // 47: types.p2pgservice.CollectInPeers2:input_type -> types.P2PPing
+	43, // 48: types.p2pgservice.BroadCastTx:output_type -> types.Reply
+	43, // 49: types.p2pgservice.BroadCastBlock:output_type -> types.Reply
+	5,  // 50: types.p2pgservice.Ping:output_type -> types.P2PPong
+	7,  // 51: types.p2pgservice.GetAddr:output_type -> types.P2PAddr
+	8,  // 52: types.p2pgservice.GetAddrList:output_type -> types.P2PAddrList
+	3,  // 53: types.p2pgservice.Version:output_type -> types.P2PVerAck
+	2,  // 54: types.p2pgservice.Version2:output_type -> types.P2PVersion
+	43, // 55: types.p2pgservice.SoftVersion:output_type -> types.Reply
+	12, // 56: types.p2pgservice.GetBlocks:output_type -> types.P2PInv
+	12, // 57: types.p2pgservice.GetMemPool:output_type -> types.P2PInv
+	30, // 58: types.p2pgservice.GetData:output_type -> types.InvDatas
+	28, // 59: types.p2pgservice.GetHeaders:output_type -> types.P2PHeaders
+	1,  // 60: types.p2pgservice.GetPeerInfo:output_type -> types.P2PPeerInfo
+	44, // 61: types.p2pgservice.ServerStreamRead:output_type -> types.ReqNil
+	26, // 62: types.p2pgservice.ServerStreamSend:output_type -> types.BroadCastData
+	32, // 63: types.p2pgservice.CollectInPeers:output_type -> types.PeerList
+	37, // 64: types.p2pgservice.CollectInPeers2:output_type -> types.PeersReply
+	48, // [48:65] is the sub-list for method output_type
+	31, // [31:48] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
 }
 
 func init() { file_p2p_proto_init() }
@@ -3520,7 +3679,7 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PeersReply); i {
+			switch v := v.(*BandwidthUsage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3532,6 +3691,18 @@ func file_p2p_proto_init() {
 			}
 		}
 		file_p2p_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeersReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2p_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PeersInfo); i {
 			case 0:
 				return &v.state
@@ -3568,7 +3739,7 @@ func file_p2p_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_p2p_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   38,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -372,6 +372,7 @@ type PushData struct {
 	//	*PushData_TxReceipts
 	//	*PushData_TxResult
 	//	*PushData_EvmLogs
+	//	*PushData_MempoolTxs
 	Value isPushData_Value `protobuf_oneof:"value"`
 }
 
@@ -456,6 +457,13 @@ func (x *PushData) GetEvmLogs() *EVMTxLogsInBlks {
 	return nil
 }
 
+func (x *PushData) GetMempoolTxs() *Transactions {
+	if x, ok := x.GetValue().(*PushData_MempoolTxs); ok {
+		return x.MempoolTxs
+	}
+	return nil
+}
+
 type isPushData_Value interface {
 	isPushData_Value()
 }
@@ -480,6 +488,11 @@ type PushData_EvmLogs struct {
 	EvmLogs *EVMTxLogsInBlks `protobuf:"bytes,6,opt,name=evmLogs,proto3,oneof"`
 }
 
+type PushData_MempoolTxs struct {
+	//mempool新加入的交易，只由rpc模块推送
+	MempoolTxs *Transactions `protobuf:"bytes,7,opt,name=mempoolTxs,proto3,oneof"`
+}
+
 func (*PushData_BlockSeqs) isPushData_Value() {}
 
 func (*PushData_HeaderSeqs) isPushData_Value() {}
@@ -490,6 +503,8 @@ func (*PushData_TxResult) isPushData_Value() {}
 
 func (*PushData_EvmLogs) isPushData_Value() {}
 
+func (*PushData_MempoolTxs) isPushData_Value() {}
+
 var File_push_tx_receipt_proto protoreflect.FileDescriptor
 
 var file_push_tx_receipt_proto_rawDesc = []byte{
@@ -543,7 +558,7 @@ var file_push_tx_receipt_proto_rawDesc = []byte{
 	0x0a, 0x0c, 0x54, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x65, 0x71, 0x73, 0x12, 0x2d,
 	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
 	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x50, 0x65,
-	0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xb4, 0x02,
+	0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xeb, 0x02,
 	0x0a, 0x08, 0x50, 0x75, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
 	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30,
 	0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x71, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
@@ -562,10 +577,14 @@ var file_push_tx_receipt_proto_rawDesc = []byte{
 	0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x65, 0x76, 0x6d, 0x4c, 0x6f,
 	0x67, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
 	0x2e, 0x45, 0x56, 0x4d, 0x54, 0x78, 0x4c, 0x6f, 0x67, 0x73, 0x49, 0x6e, 0x42, 0x6c, 0x6b, 0x73,
-	0x48, 0x00, 0x52, 0x07, 0x65, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x42, 0x1f, 0x5a, 0x1d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x33, 0x33, 0x63, 0x6e, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x33, 0x33, 0x2f,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x48, 0x00, 0x52, 0x07, 0x65, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x35, 0x0a, 0x0a, 0x6d,
+	0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x54, 0x78, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x54,
+	0x78, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x1f, 0x5a, 0x1d, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x33, 0x33, 0x63, 0x6e, 0x2f, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x33, 0x33, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -593,6 +612,7 @@ var file_push_tx_receipt_proto_goTypes = []interface{}{
 	(*BlockSeqs)(nil),                  // 8: types.BlockSeqs
 	(*HeaderSeqs)(nil),                 // 9: types.HeaderSeqs
 	(*EVMTxLogsInBlks)(nil),            // 10: types.EVMTxLogsInBlks
+	(*Transactions)(nil),               // 11: types.Transactions
 }
 var file_push_tx_receipt_proto_depIdxs = []int32{
 	6,  // 0: types.TxReceipts4SubscribePerBlk.tx:type_name -> types.Transaction
@@ -605,11 +625,12 @@ var file_push_tx_receipt_proto_depIdxs = []int32{
 	1,  // 7: types.PushData.txReceipts:type_name -> types.TxReceipts4Subscribe
 	4,  // 8: types.PushData.txResult:type_name -> types.TxResultSeqs
 	10, // 9: types.PushData.evmLogs:type_name -> types.EVMTxLogsInBlks
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	11, // 10: types.PushData.mempoolTxs:type_name -> types.Transactions
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_push_tx_receipt_proto_init() }
@@ -700,6 +721,7 @@ func file_push_tx_receipt_proto_init() {
 		(*PushData_TxReceipts)(nil),
 		(*PushData_TxResult)(nil),
 		(*PushData_EvmLogs)(nil),
+		(*PushData_MempoolTxs)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -726,7 +726,7 @@ var file_rpc_proto_rawDesc = []byte{
 	0x70, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x4c, 0x69,
 	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
 	0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x4c, 0x69, 0x73,
-	0x74, 0x32, 0xbe, 0x23, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x33, 0x33, 0x12, 0x2d, 0x0a,
+	0x74, 0x32, 0xa9, 0x27, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x33, 0x33, 0x12, 0x2d, 0x0a,
 	0x09, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x10, 0x2e, 0x74, 0x79, 0x70,
 	0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x1a, 0x0c, 0x2e, 0x74,
 	0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0d,
@@ -1003,16 +1003,47 @@ var file_rpc_proto_rawDesc = []byte{
 	0x62, 0x65, 0x73, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x75, 0x73, 0x68,
 	0x53, 0x65, 0x71, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x75, 0x6d, 0x12, 0x10, 0x2e, 0x74, 0x79, 0x70,
 	0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x1a, 0x0c, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x08,
-	0x53, 0x75, 0x62, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x52, 0x65, 0x71, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x1a, 0x0f, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x22, 0x00,
-	0x30, 0x01, 0x12, 0x2e, 0x0a, 0x0a, 0x55, 0x6e, 0x53, 0x75, 0x62, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x12, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x00, 0x42, 0x1f, 0x5a, 0x1d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x33, 0x33, 0x63, 0x6e, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x33, 0x33, 0x2f, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x13,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x75, 0x73, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x12, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x50,
+	0x75, 0x73, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x41, 0x75, 0x74, 0x68,
+	0x1a, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x75, 0x73, 0x68, 0x22, 0x00, 0x12, 0x4e, 0x0a,
+	0x12, 0x50, 0x61, 0x75, 0x73, 0x65, 0x50, 0x75, 0x73, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x12, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x50,
+	0x75, 0x73, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x41, 0x75, 0x74, 0x68,
+	0x1a, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x75, 0x73, 0x68, 0x22, 0x00, 0x12, 0x34, 0x0a,
+	0x0c, 0x47, 0x65, 0x74, 0x50, 0x75, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x10, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x1a,
+	0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0e, 0x52, 0x65, 0x61, 0x64, 0x50, 0x75, 0x73, 0x68, 0x4f,
+	0x75, 0x74, 0x62, 0x6f, 0x78, 0x12, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65,
+	0x71, 0x50, 0x75, 0x73, 0x68, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x1a, 0x18, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x65, 0x50, 0x75, 0x73, 0x68, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x12, 0x14, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x50, 0x75, 0x73, 0x68, 0x4f, 0x75, 0x74, 0x62,
+	0x6f, 0x78, 0x1a, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x4f,
+	0x75, 0x74, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x3f, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x12, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x1a, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x00,
+	0x12, 0x45, 0x0a, 0x13, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x71, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x78, 0x1a, 0x16, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x65, 0x54, 0x78, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x08, 0x53, 0x75, 0x62, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x50, 0x75, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x22, 0x00, 0x30, 0x01, 0x12, 0x2e, 0x0a,
+	0x0a, 0x55, 0x6e, 0x53, 0x75, 0x62, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x1a, 0x0c, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x1f, 0x5a,
+	0x1d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x33, 0x33, 0x63, 0x6e,
+	0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x33, 0x33, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1084,41 +1115,50 @@ var file_rpc_proto_goTypes = []interface{}{
 	(*ReqParaTxByHeight)(nil),        // 52: types.ReqParaTxByHeight
 	(*ReWriteRawTx)(nil),             // 53: types.ReWriteRawTx
 	(*PushSubscribeReq)(nil),         // 54: types.PushSubscribeReq
-	(*ReqSubscribe)(nil),             // 55: types.ReqSubscribe
-	(*Header)(nil),                   // 56: types.Header
-	(*UnsignTx)(nil),                 // 57: types.UnsignTx
-	(*TransactionDetail)(nil),        // 58: types.TransactionDetail
-	(*ReplyTxInfos)(nil),             // 59: types.ReplyTxInfos
-	(*TransactionDetails)(nil),       // 60: types.TransactionDetails
-	(*ReplyTxList)(nil),              // 61: types.ReplyTxList
-	(*WalletAccounts)(nil),           // 62: types.WalletAccounts
-	(*WalletAccount)(nil),            // 63: types.WalletAccount
-	(*WalletTxDetails)(nil),          // 64: types.WalletTxDetails
-	(*ReplyHash)(nil),                // 65: types.ReplyHash
-	(*ReplyHashes)(nil),              // 66: types.ReplyHashes
-	(*ReplyProperFee)(nil),           // 67: types.ReplyProperFee
-	(*WalletStatus)(nil),             // 68: types.WalletStatus
-	(*BlockOverview)(nil),            // 69: types.BlockOverview
-	(*AddrOverview)(nil),             // 70: types.AddrOverview
-	(*ReplySeed)(nil),                // 71: types.ReplySeed
-	(*Accounts)(nil),                 // 72: types.Accounts
-	(*HexTx)(nil),                    // 73: types.HexTx
-	(*ReplyString)(nil),              // 74: types.ReplyString
-	(*VersionInfo)(nil),              // 75: types.VersionInfo
-	(*PeerList)(nil),                 // 76: types.PeerList
-	(*NodeNetInfo)(nil),              // 77: types.NodeNetInfo
-	(*Int32)(nil),                    // 78: types.Int32
-	(*BlockDetails)(nil),             // 79: types.BlockDetails
-	(*BlockSeq)(nil),                 // 80: types.BlockSeq
-	(*AllExecBalance)(nil),           // 81: types.AllExecBalance
-	(*ReplySignRawTx)(nil),           // 82: types.ReplySignRawTx
-	(*ParaTxDetails)(nil),            // 83: types.ParaTxDetails
-	(*ReplyHeightByTitle)(nil),       // 84: types.ReplyHeightByTitle
-	(*Headers)(nil),                  // 85: types.Headers
-	(*BlockSequences)(nil),           // 86: types.BlockSequences
-	(*ReplySubscribePush)(nil),       // 87: types.ReplySubscribePush
-	(*PushSubscribes)(nil),           // 88: types.PushSubscribes
-	(*PushData)(nil),                 // 89: types.PushData
+	(*ReqPushSubscribeAuth)(nil),     // 55: types.ReqPushSubscribeAuth
+	(*ReqPushOutbox)(nil),            // 56: types.ReqPushOutbox
+	(*ReqStateProof)(nil),            // 57: types.ReqStateProof
+	(*ReqSimulateTx)(nil),            // 58: types.ReqSimulateTx
+	(*ReqSubscribe)(nil),             // 59: types.ReqSubscribe
+	(*Header)(nil),                   // 60: types.Header
+	(*UnsignTx)(nil),                 // 61: types.UnsignTx
+	(*TransactionDetail)(nil),        // 62: types.TransactionDetail
+	(*ReplyTxInfos)(nil),             // 63: types.ReplyTxInfos
+	(*TransactionDetails)(nil),       // 64: types.TransactionDetails
+	(*ReplyTxList)(nil),              // 65: types.ReplyTxList
+	(*WalletAccounts)(nil),           // 66: types.WalletAccounts
+	(*WalletAccount)(nil),            // 67: types.WalletAccount
+	(*WalletTxDetails)(nil),          // 68: types.WalletTxDetails
+	(*ReplyHash)(nil),                // 69: types.ReplyHash
+	(*ReplyHashes)(nil),              // 70: types.ReplyHashes
+	(*ReplyProperFee)(nil),           // 71: types.ReplyProperFee
+	(*WalletStatus)(nil),             // 72: types.WalletStatus
+	(*BlockOverview)(nil),            // 73: types.BlockOverview
+	(*AddrOverview)(nil),             // 74: types.AddrOverview
+	(*ReplySeed)(nil),                // 75: types.ReplySeed
+	(*Accounts)(nil),                 // 76: types.Accounts
+	(*HexTx)(nil),                    // 77: types.HexTx
+	(*ReplyString)(nil),              // 78: types.ReplyString
+	(*VersionInfo)(nil),              // 79: types.VersionInfo
+	(*PeerList)(nil),                 // 80: types.PeerList
+	(*NodeNetInfo)(nil),              // 81: types.NodeNetInfo
+	(*Int32)(nil),                    // 82: types.Int32
+	(*BlockDetails)(nil),             // 83: types.BlockDetails
+	(*BlockSeq)(nil),                 // 84: types.BlockSeq
+	(*AllExecBalance)(nil),           // 85: types.AllExecBalance
+	(*ReplySignRawTx)(nil),           // 86: types.ReplySignRawTx
+	(*ParaTxDetails)(nil),            // 87: types.ParaTxDetails
+	(*ReplyHeightByTitle)(nil),       // 88: types.ReplyHeightByTitle
+	(*Headers)(nil),                  // 89: types.Headers
+	(*BlockSequences)(nil),           // 90: types.BlockSequences
+	(*ReplySubscribePush)(nil),       // 91: types.ReplySubscribePush
+	(*PushSubscribes)(nil),           // 92: types.PushSubscribes
+	(*PushStats)(nil),                // 93: types.PushStats
+	(*PushOutboxRecords)(nil),        // 94: types.PushOutboxRecords
+	(*PushOutboxRecord)(nil),         // 95: types.PushOutboxRecord
+	(*ReplyStateProof)(nil),          // 96: types.ReplyStateProof
+	(*ReplySimulateTx)(nil),          // 97: types.ReplySimulateTx
+	(*PushData)(nil),                 // 98: types.PushData
 }
 var file_rpc_proto_depIdxs = []int32{
 	1,  // 0: types.cryptoList.cryptos:type_name -> types.crypto
@@ -1202,88 +1242,102 @@ var file_rpc_proto_depIdxs = []int32{
 	54, // 78: types.chain33.AddPushSubscribe:input_type -> types.PushSubscribeReq
 	13, // 79: types.chain33.ListPushes:input_type -> types.ReqNil
 	39, // 80: types.chain33.GetPushSeqLastNum:input_type -> types.ReqString
-	55, // 81: types.chain33.SubEvent:input_type -> types.ReqSubscribe
-	39, // 82: types.chain33.UnSubEvent:input_type -> types.ReqString
-	11, // 83: types.chain33.GetBlocks:output_type -> types.Reply
-	56, // 84: types.chain33.GetLastHeader:output_type -> types.Header
-	57, // 85: types.chain33.CreateRawTransaction:output_type -> types.UnsignTx
-	57, // 86: types.chain33.CreateRawTxGroup:output_type -> types.UnsignTx
-	58, // 87: types.chain33.QueryTransaction:output_type -> types.TransactionDetail
-	11, // 88: types.chain33.SendTransactionSync:output_type -> types.Reply
-	11, // 89: types.chain33.SendTransaction:output_type -> types.Reply
-	9,  // 90: types.chain33.SendTransactions:output_type -> types.Replies
-	59, // 91: types.chain33.GetTransactionByAddr:output_type -> types.ReplyTxInfos
-	60, // 92: types.chain33.GetTransactionByHashes:output_type -> types.TransactionDetails
-	61, // 93: types.chain33.GetMemPool:output_type -> types.ReplyTxList
-	62, // 94: types.chain33.GetAccounts:output_type -> types.WalletAccounts
-	63, // 95: types.chain33.GetAccount:output_type -> types.WalletAccount
-	63, // 96: types.chain33.NewAccount:output_type -> types.WalletAccount
-	64, // 97: types.chain33.WalletTransactionList:output_type -> types.WalletTxDetails
-	63, // 98: types.chain33.ImportPrivkey:output_type -> types.WalletAccount
-	65, // 99: types.chain33.SendToAddress:output_type -> types.ReplyHash
-	11, // 100: types.chain33.SetTxFee:output_type -> types.Reply
-	63, // 101: types.chain33.SetLabl:output_type -> types.WalletAccount
-	66, // 102: types.chain33.MergeBalance:output_type -> types.ReplyHashes
-	11, // 103: types.chain33.SetPasswd:output_type -> types.Reply
-	11, // 104: types.chain33.Lock:output_type -> types.Reply
-	11, // 105: types.chain33.UnLock:output_type -> types.Reply
-	61, // 106: types.chain33.GetLastMemPool:output_type -> types.ReplyTxList
-	67, // 107: types.chain33.GetProperFee:output_type -> types.ReplyProperFee
-	68, // 108: types.chain33.GetWalletStatus:output_type -> types.WalletStatus
-	69, // 109: types.chain33.GetBlockOverview:output_type -> types.BlockOverview
-	70, // 110: types.chain33.GetAddrOverview:output_type -> types.AddrOverview
-	65, // 111: types.chain33.GetBlockHash:output_type -> types.ReplyHash
-	71, // 112: types.chain33.GenSeed:output_type -> types.ReplySeed
-	71, // 113: types.chain33.GetSeed:output_type -> types.ReplySeed
-	11, // 114: types.chain33.SaveSeed:output_type -> types.Reply
-	72, // 115: types.chain33.GetBalance:output_type -> types.Accounts
-	11, // 116: types.chain33.QueryChain:output_type -> types.Reply
-	11, // 117: types.chain33.ExecWallet:output_type -> types.Reply
-	11, // 118: types.chain33.QueryConsensus:output_type -> types.Reply
-	57, // 119: types.chain33.CreateTransaction:output_type -> types.UnsignTx
-	73, // 120: types.chain33.GetHexTxByHash:output_type -> types.HexTx
-	74, // 121: types.chain33.DumpPrivkey:output_type -> types.ReplyString
-	11, // 122: types.chain33.DumpPrivkeysFile:output_type -> types.Reply
-	11, // 123: types.chain33.ImportPrivkeysFile:output_type -> types.Reply
-	75, // 124: types.chain33.Version:output_type -> types.VersionInfo
-	11, // 125: types.chain33.IsSync:output_type -> types.Reply
-	76, // 126: types.chain33.GetPeerInfo:output_type -> types.PeerList
-	77, // 127: types.chain33.NetInfo:output_type -> types.NodeNetInfo
-	11, // 128: types.chain33.IsNtpClockSync:output_type -> types.Reply
-	78, // 129: types.chain33.GetFatalFailure:output_type -> types.Int32
-	43, // 130: types.chain33.GetLastBlockSequence:output_type -> types.Int64
-	43, // 131: types.chain33.GetSequenceByHash:output_type -> types.Int64
-	79, // 132: types.chain33.GetBlockByHashes:output_type -> types.BlockDetails
-	80, // 133: types.chain33.GetBlockBySeq:output_type -> types.BlockSeq
-	11, // 134: types.chain33.CloseQueue:output_type -> types.Reply
-	81, // 135: types.chain33.GetAllExecBalance:output_type -> types.AllExecBalance
-	82, // 136: types.chain33.SignRawTx:output_type -> types.ReplySignRawTx
-	82, // 137: types.chain33.CreateNoBalanceTransaction:output_type -> types.ReplySignRawTx
-	65, // 138: types.chain33.QueryRandNum:output_type -> types.ReplyHash
-	43, // 139: types.chain33.GetFork:output_type -> types.Int64
-	82, // 140: types.chain33.CreateNoBalanceTxs:output_type -> types.ReplySignRawTx
-	83, // 141: types.chain33.GetParaTxByTitle:output_type -> types.ParaTxDetails
-	84, // 142: types.chain33.LoadParaTxByTitle:output_type -> types.ReplyHeightByTitle
-	83, // 143: types.chain33.GetParaTxByHeight:output_type -> types.ParaTxDetails
-	85, // 144: types.chain33.GetHeaders:output_type -> types.Headers
-	0,  // 145: types.chain33.GetServerTime:output_type -> types.serverTime
-	2,  // 146: types.chain33.GetCryptoList:output_type -> types.cryptoList
-	4,  // 147: types.chain33.GetAddressDrivers:output_type -> types.addressDrivers
-	11, // 148: types.chain33.SendDelayTransaction:output_type -> types.Reply
-	74, // 149: types.chain33.GetWalletRecoverAddress:output_type -> types.ReplyString
-	82, // 150: types.chain33.SignWalletRecoverTx:output_type -> types.ReplySignRawTx
-	8,  // 151: types.chain33.GetChainConfig:output_type -> types.ChainConfigInfo
-	74, // 152: types.chain33.ConvertExectoAddr:output_type -> types.ReplyString
-	74, // 153: types.chain33.GetCoinSymbol:output_type -> types.ReplyString
-	57, // 154: types.chain33.ReWriteTx:output_type -> types.UnsignTx
-	86, // 155: types.chain33.GetBlockSequences:output_type -> types.BlockSequences
-	87, // 156: types.chain33.AddPushSubscribe:output_type -> types.ReplySubscribePush
-	88, // 157: types.chain33.ListPushes:output_type -> types.PushSubscribes
-	43, // 158: types.chain33.GetPushSeqLastNum:output_type -> types.Int64
-	89, // 159: types.chain33.SubEvent:output_type -> types.PushData
-	11, // 160: types.chain33.UnSubEvent:output_type -> types.Reply
-	83, // [83:161] is the sub-list for method output_type
-	5,  // [5:83] is the sub-list for method input_type
+	55, // 81: types.chain33.RemovePushSubscribe:input_type -> types.ReqPushSubscribeAuth
+	55, // 82: types.chain33.PausePushSubscribe:input_type -> types.ReqPushSubscribeAuth
+	39, // 83: types.chain33.GetPushStats:input_type -> types.ReqString
+	56, // 84: types.chain33.ReadPushOutbox:input_type -> types.ReqPushOutbox
+	56, // 85: types.chain33.ConsumePushOutbox:input_type -> types.ReqPushOutbox
+	57, // 86: types.chain33.GetStateProof:input_type -> types.ReqStateProof
+	58, // 87: types.chain33.SimulateTransaction:input_type -> types.ReqSimulateTx
+	59, // 88: types.chain33.SubEvent:input_type -> types.ReqSubscribe
+	39, // 89: types.chain33.UnSubEvent:input_type -> types.ReqString
+	11, // 90: types.chain33.GetBlocks:output_type -> types.Reply
+	60, // 91: types.chain33.GetLastHeader:output_type -> types.Header
+	61, // 92: types.chain33.CreateRawTransaction:output_type -> types.UnsignTx
+	61, // 93: types.chain33.CreateRawTxGroup:output_type -> types.UnsignTx
+	62, // 94: types.chain33.QueryTransaction:output_type -> types.TransactionDetail
+	11, // 95: types.chain33.SendTransactionSync:output_type -> types.Reply
+	11, // 96: types.chain33.SendTransaction:output_type -> types.Reply
+	9,  // 97: types.chain33.SendTransactions:output_type -> types.Replies
+	63, // 98: types.chain33.GetTransactionByAddr:output_type -> types.ReplyTxInfos
+	64, // 99: types.chain33.GetTransactionByHashes:output_type -> types.TransactionDetails
+	65, // 100: types.chain33.GetMemPool:output_type -> types.ReplyTxList
+	66, // 101: types.chain33.GetAccounts:output_type -> types.WalletAccounts
+	67, // 102: types.chain33.GetAccount:output_type -> types.WalletAccount
+	67, // 103: types.chain33.NewAccount:output_type -> types.WalletAccount
+	68, // 104: types.chain33.WalletTransactionList:output_type -> types.WalletTxDetails
+	67, // 105: types.chain33.ImportPrivkey:output_type -> types.WalletAccount
+	69, // 106: types.chain33.SendToAddress:output_type -> types.ReplyHash
+	11, // 107: types.chain33.SetTxFee:output_type -> types.Reply
+	67, // 108: types.chain33.SetLabl:output_type -> types.WalletAccount
+	70, // 109: types.chain33.MergeBalance:output_type -> types.ReplyHashes
+	11, // 110: types.chain33.SetPasswd:output_type -> types.Reply
+	11, // 111: types.chain33.Lock:output_type -> types.Reply
+	11, // 112: types.chain33.UnLock:output_type -> types.Reply
+	65, // 113: types.chain33.GetLastMemPool:output_type -> types.ReplyTxList
+	71, // 114: types.chain33.GetProperFee:output_type -> types.ReplyProperFee
+	72, // 115: types.chain33.GetWalletStatus:output_type -> types.WalletStatus
+	73, // 116: types.chain33.GetBlockOverview:output_type -> types.BlockOverview
+	74, // 117: types.chain33.GetAddrOverview:output_type -> types.AddrOverview
+	69, // 118: types.chain33.GetBlockHash:output_type -> types.ReplyHash
+	75, // 119: types.chain33.GenSeed:output_type -> types.ReplySeed
+	75, // 120: types.chain33.GetSeed:output_type -> types.ReplySeed
+	11, // 121: types.chain33.SaveSeed:output_type -> types.Reply
+	76, // 122: types.chain33.GetBalance:output_type -> types.Accounts
+	11, // 123: types.chain33.QueryChain:output_type -> types.Reply
+	11, // 124: types.chain33.ExecWallet:output_type -> types.Reply
+	11, // 125: types.chain33.QueryConsensus:output_type -> types.Reply
+	61, // 126: types.chain33.CreateTransaction:output_type -> types.UnsignTx
+	77, // 127: types.chain33.GetHexTxByHash:output_type -> types.HexTx
+	78, // 128: types.chain33.DumpPrivkey:output_type -> types.ReplyString
+	11, // 129: types.chain33.DumpPrivkeysFile:output_type -> types.Reply
+	11, // 130: types.chain33.ImportPrivkeysFile:output_type -> types.Reply
+	79, // 131: types.chain33.Version:output_type -> types.VersionInfo
+	11, // 132: types.chain33.IsSync:output_type -> types.Reply
+	80, // 133: types.chain33.GetPeerInfo:output_type -> types.PeerList
+	81, // 134: types.chain33.NetInfo:output_type -> types.NodeNetInfo
+	11, // 135: types.chain33.IsNtpClockSync:output_type -> types.Reply
+	82, // 136: types.chain33.GetFatalFailure:output_type -> types.Int32
+	43, // 137: types.chain33.GetLastBlockSequence:output_type -> types.Int64
+	43, // 138: types.chain33.GetSequenceByHash:output_type -> types.Int64
+	83, // 139: types.chain33.GetBlockByHashes:output_type -> types.BlockDetails
+	84, // 140: types.chain33.GetBlockBySeq:output_type -> types.BlockSeq
+	11, // 141: types.chain33.CloseQueue:output_type -> types.Reply
+	85, // 142: types.chain33.GetAllExecBalance:output_type -> types.AllExecBalance
+	86, // 143: types.chain33.SignRawTx:output_type -> types.ReplySignRawTx
+	86, // 144: types.chain33.CreateNoBalanceTransaction:output_type -> types.ReplySignRawTx
+	69, // 145: types.chain33.QueryRandNum:output_type -> types.ReplyHash
+	43, // 146: types.chain33.GetFork:output_type -> types.Int64
+	86, // 147: types.chain33.CreateNoBalanceTxs:output_type -> types.ReplySignRawTx
+	87, // 148: types.chain33.GetParaTxByTitle:output_type -> types.ParaTxDetails
+	88, // 149: types.chain33.LoadParaTxByTitle:output_type -> types.ReplyHeightByTitle
+	87, // 150: types.chain33.GetParaTxByHeight:output_type -> types.ParaTxDetails
+	89, // 151: types.chain33.GetHeaders:output_type -> types.Headers
+	0,  // 152: types.chain33.GetServerTime:output_type -> types.serverTime
+	2,  // 153: types.chain33.GetCryptoList:output_type -> types.cryptoList
+	4,  // 154: types.chain33.GetAddressDrivers:output_type -> types.addressDrivers
+	11, // 155: types.chain33.SendDelayTransaction:output_type -> types.Reply
+	78, // 156: types.chain33.GetWalletRecoverAddress:output_type -> types.ReplyString
+	86, // 157: types.chain33.SignWalletRecoverTx:output_type -> types.ReplySignRawTx
+	8,  // 158: types.chain33.GetChainConfig:output_type -> types.ChainConfigInfo
+	78, // 159: types.chain33.ConvertExectoAddr:output_type -> types.ReplyString
+	78, // 160: types.chain33.GetCoinSymbol:output_type -> types.ReplyString
+	61, // 161: types.chain33.ReWriteTx:output_type -> types.UnsignTx
+	90, // 162: types.chain33.GetBlockSequences:output_type -> types.BlockSequences
+	91, // 163: types.chain33.AddPushSubscribe:output_type -> types.ReplySubscribePush
+	92, // 164: types.chain33.ListPushes:output_type -> types.PushSubscribes
+	43, // 165: types.chain33.GetPushSeqLastNum:output_type -> types.Int64
+	91, // 166: types.chain33.RemovePushSubscribe:output_type -> types.ReplySubscribePush
+	91, // 167: types.chain33.PausePushSubscribe:output_type -> types.ReplySubscribePush
+	93, // 168: types.chain33.GetPushStats:output_type -> types.PushStats
+	94, // 169: types.chain33.ReadPushOutbox:output_type -> types.PushOutboxRecords
+	95, // 170: types.chain33.ConsumePushOutbox:output_type -> types.PushOutboxRecord
+	96, // 171: types.chain33.GetStateProof:output_type -> types.ReplyStateProof
+	97, // 172: types.chain33.SimulateTransaction:output_type -> types.ReplySimulateTx
+	98, // 173: types.chain33.SubEvent:output_type -> types.PushData
+	11, // 174: types.chain33.UnSubEvent:output_type -> types.Reply
+	90, // [90:175] is the sub-list for method output_type
+	5,  // [5:90] is the sub-list for method input_type
 	5,  // [5:5] is the sub-list for extension type_name
 	5,  // [5:5] is the sub-list for extension extendee
 	0,  // [0:5] is the sub-list for field type_name
@@ -1601,6 +1655,20 @@ type Chain33Client interface {
 	AddPushSubscribe(ctx context.Context, in *PushSubscribeReq, opts ...grpc.CallOption) (*ReplySubscribePush, error)
 	ListPushes(ctx context.Context, in *ReqNil, opts ...grpc.CallOption) (*PushSubscribes, error)
 	GetPushSeqLastNum(ctx context.Context, in *ReqString, opts ...grpc.CallOption) (*Int64, error)
+	//删除推送订阅
+	RemovePushSubscribe(ctx context.Context, in *ReqPushSubscribeAuth, opts ...grpc.CallOption) (*ReplySubscribePush, error)
+	//暂停推送订阅，重新调用AddPushSubscribe恢复推送
+	PausePushSubscribe(ctx context.Context, in *ReqPushSubscribeAuth, opts ...grpc.CallOption) (*ReplySubscribePush, error)
+	//获取推送服务的运行统计，name为空时返回所有订阅者的统计
+	GetPushStats(ctx context.Context, in *ReqString, opts ...grpc.CallOption) (*PushStats, error)
+	//从outbox读取推送记录
+	ReadPushOutbox(ctx context.Context, in *ReqPushOutbox, opts ...grpc.CallOption) (*PushOutboxRecords, error)
+	//从指定偏移开始持续消费outbox中的推送记录
+	ConsumePushOutbox(ctx context.Context, in *ReqPushOutbox, opts ...grpc.CallOption) (Chain33_ConsumePushOutboxClient, error)
+	//获取状态数据及其mavl证明，不存在的key返回不存在证明
+	GetStateProof(ctx context.Context, in *ReqStateProof, opts ...grpc.CallOption) (*ReplyStateProof, error)
+	//模拟执行交易，返回回执、状态数据修改前后的值以及手续费，不写入状态
+	SimulateTransaction(ctx context.Context, in *ReqSimulateTx, opts ...grpc.CallOption) (*ReplySimulateTx, error)
 	//发送订阅的数据到客户端
 	SubEvent(ctx context.Context, in *ReqSubscribe, opts ...grpc.CallOption) (Chain33_SubEventClient, error)
 	//取消订阅
@@ -2299,8 +2367,94 @@ func (c *chain33Client) GetPushSeqLastNum(ctx context.Context, in *ReqString, op
 	return out, nil
 }
 
+func (c *chain33Client) RemovePushSubscribe(ctx context.Context, in *ReqPushSubscribeAuth, opts ...grpc.CallOption) (*ReplySubscribePush, error) {
+	out := new(ReplySubscribePush)
+	err := c.cc.Invoke(ctx, "/types.chain33/RemovePushSubscribe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) PausePushSubscribe(ctx context.Context, in *ReqPushSubscribeAuth, opts ...grpc.CallOption) (*ReplySubscribePush, error) {
+	out := new(ReplySubscribePush)
+	err := c.cc.Invoke(ctx, "/types.chain33/PausePushSubscribe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) GetPushStats(ctx context.Context, in *ReqString, opts ...grpc.CallOption) (*PushStats, error) {
+	out := new(PushStats)
+	err := c.cc.Invoke(ctx, "/types.chain33/GetPushStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) ReadPushOutbox(ctx context.Context, in *ReqPushOutbox, opts ...grpc.CallOption) (*PushOutboxRecords, error) {
+	out := new(PushOutboxRecords)
+	err := c.cc.Invoke(ctx, "/types.chain33/ReadPushOutbox", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) ConsumePushOutbox(ctx context.Context, in *ReqPushOutbox, opts ...grpc.CallOption) (Chain33_ConsumePushOutboxClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Chain33_serviceDesc.Streams[0], "/types.chain33/ConsumePushOutbox", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chain33ConsumePushOutboxClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Chain33_ConsumePushOutboxClient interface {
+	Recv() (*PushOutboxRecord, error)
+	grpc.ClientStream
+}
+
+type chain33ConsumePushOutboxClient struct {
+	grpc.ClientStream
+}
+
+func (x *chain33ConsumePushOutboxClient) Recv() (*PushOutboxRecord, error) {
+	m := new(PushOutboxRecord)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *chain33Client) GetStateProof(ctx context.Context, in *ReqStateProof, opts ...grpc.CallOption) (*ReplyStateProof, error) {
+	out := new(ReplyStateProof)
+	err := c.cc.Invoke(ctx, "/types.chain33/GetStateProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) SimulateTransaction(ctx context.Context, in *ReqSimulateTx, opts ...grpc.CallOption) (*ReplySimulateTx, error) {
+	out := new(ReplySimulateTx)
+	err := c.cc.Invoke(ctx, "/types.chain33/SimulateTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chain33Client) SubEvent(ctx context.Context, in *ReqSubscribe, opts ...grpc.CallOption) (Chain33_SubEventClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Chain33_serviceDesc.Streams[0], "/types.chain33/SubEvent", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Chain33_serviceDesc.Streams[1], "/types.chain33/SubEvent", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2487,6 +2641,20 @@ type Chain33Server interface {
 	AddPushSubscribe(context.Context, *PushSubscribeReq) (*ReplySubscribePush, error)
 	ListPushes(context.Context, *ReqNil) (*PushSubscribes, error)
 	GetPushSeqLastNum(context.Context, *ReqString) (*Int64, error)
+	//删除推送订阅
+	RemovePushSubscribe(context.Context, *ReqPushSubscribeAuth) (*ReplySubscribePush, error)
+	//暂停推送订阅，重新调用AddPushSubscribe恢复推送
+	PausePushSubscribe(context.Context, *ReqPushSubscribeAuth) (*ReplySubscribePush, error)
+	//获取推送服务的运行统计，name为空时返回所有订阅者的统计
+	GetPushStats(context.Context, *ReqString) (*PushStats, error)
+	//从outbox读取推送记录
+	ReadPushOutbox(context.Context, *ReqPushOutbox) (*PushOutboxRecords, error)
+	//从指定偏移开始持续消费outbox中的推送记录
+	ConsumePushOutbox(*ReqPushOutbox, Chain33_ConsumePushOutboxServer) error
+	//获取状态数据及其mavl证明，不存在的key返回不存在证明
+	GetStateProof(context.Context, *ReqStateProof) (*ReplyStateProof, error)
+	//模拟执行交易，返回回执、状态数据修改前后的值以及手续费，不写入状态
+	SimulateTransaction(context.Context, *ReqSimulateTx) (*ReplySimulateTx, error)
 	//发送订阅的数据到客户端
 	SubEvent(*ReqSubscribe, Chain33_SubEventServer) error
 	//取消订阅
@@ -2725,6 +2893,27 @@ func (*UnimplementedChain33Server) ListPushes(context.Context, *ReqNil) (*PushSu
 func (*UnimplementedChain33Server) GetPushSeqLastNum(context.Context, *ReqString) (*Int64, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPushSeqLastNum not implemented")
 }
+func (*UnimplementedChain33Server) RemovePushSubscribe(context.Context, *ReqPushSubscribeAuth) (*ReplySubscribePush, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePushSubscribe not implemented")
+}
+func (*UnimplementedChain33Server) PausePushSubscribe(context.Context, *ReqPushSubscribeAuth) (*ReplySubscribePush, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PausePushSubscribe not implemented")
+}
+func (*UnimplementedChain33Server) GetPushStats(context.Context, *ReqString) (*PushStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPushStats not implemented")
+}
+func (*UnimplementedChain33Server) ReadPushOutbox(context.Context, *ReqPushOutbox) (*PushOutboxRecords, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadPushOutbox not implemented")
+}
+func (*UnimplementedChain33Server) ConsumePushOutbox(*ReqPushOutbox, Chain33_ConsumePushOutboxServer) error {
+	return status.Errorf(codes.Unimplemented, "method ConsumePushOutbox not implemented")
+}
+func (*UnimplementedChain33Server) GetStateProof(context.Context, *ReqStateProof) (*ReplyStateProof, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStateProof not implemented")
+}
+func (*UnimplementedChain33Server) SimulateTransaction(context.Context, *ReqSimulateTx) (*ReplySimulateTx, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateTransaction not implemented")
+}
 func (*UnimplementedChain33Server) SubEvent(*ReqSubscribe, Chain33_SubEventServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubEvent not implemented")
 }
@@ -4104,6 +4293,135 @@ func _Chain33_GetPushSeqLastNum_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Chain33_RemovePushSubscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqPushSubscribeAuth)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).RemovePushSubscribe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/RemovePushSubscribe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).RemovePushSubscribe(ctx, req.(*ReqPushSubscribeAuth))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_PausePushSubscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqPushSubscribeAuth)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).PausePushSubscribe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/PausePushSubscribe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).PausePushSubscribe(ctx, req.(*ReqPushSubscribeAuth))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_GetPushStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqString)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).GetPushStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/GetPushStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).GetPushStats(ctx, req.(*ReqString))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_ReadPushOutbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqPushOutbox)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).ReadPushOutbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/ReadPushOutbox",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).ReadPushOutbox(ctx, req.(*ReqPushOutbox))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_ConsumePushOutbox_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReqPushOutbox)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(Chain33Server).ConsumePushOutbox(m, &chain33ConsumePushOutboxServer{stream})
+}
+
+type Chain33_ConsumePushOutboxServer interface {
+	Send(*PushOutboxRecord) error
+	grpc.ServerStream
+}
+
+type chain33ConsumePushOutboxServer struct {
+	grpc.ServerStream
+}
+
+func (x *chain33ConsumePushOutboxServer) Send(m *PushOutboxRecord) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Chain33_GetStateProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqStateProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).GetStateProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/GetStateProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).GetStateProof(ctx, req.(*ReqStateProof))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_SimulateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqSimulateTx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).SimulateTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/SimulateTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).SimulateTransaction(ctx, req.(*ReqSimulateTx))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Chain33_SubEvent_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(ReqSubscribe)
 	if err := stream.RecvMsg(m); err != nil {
@@ -4451,12 +4769,41 @@ var _Chain33_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPushSeqLastNum",
 			Handler:    _Chain33_GetPushSeqLastNum_Handler,
 		},
+		{
+			MethodName: "RemovePushSubscribe",
+			Handler:    _Chain33_RemovePushSubscribe_Handler,
+		},
+		{
+			MethodName: "PausePushSubscribe",
+			Handler:    _Chain33_PausePushSubscribe_Handler,
+		},
+		{
+			MethodName: "GetPushStats",
+			Handler:    _Chain33_GetPushStats_Handler,
+		},
+		{
+			MethodName: "ReadPushOutbox",
+			Handler:    _Chain33_ReadPushOutbox_Handler,
+		},
+		{
+			MethodName: "GetStateProof",
+			Handler:    _Chain33_GetStateProof_Handler,
+		},
+		{
+			MethodName: "SimulateTransaction",
+			Handler:    _Chain33_SimulateTransaction_Handler,
+		},
 		{
 			MethodName: "UnSubEvent",
 			Handler:    _Chain33_UnSubEvent_Handler,
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ConsumePushOutbox",
+			Handler:       _Chain33_ConsumePushOutbox_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "SubEvent",
 			Handler:       _Chain33_SubEvent_Handler,
@@ -2815,6 +2815,287 @@ func (x *ReplyCheckTxsExist) GetExistCount() uint32 {
 	return 0
 }
 
+//模拟执行交易或交易组，不写入状态；stateHash为空时使用最新区块的状态，
+//未签名的交易使用pubKey和signType作为签名公钥，skipSign为true时不检查签名
+type ReqSimulateTx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tx        *Transaction `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	StateHash []byte       `protobuf:"bytes,2,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	SkipSign  bool         `protobuf:"varint,3,opt,name=skipSign,proto3" json:"skipSign,omitempty"`
+	PubKey    []byte       `protobuf:"bytes,4,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	SignType  int32        `protobuf:"varint,5,opt,name=signType,proto3" json:"signType,omitempty"`
+}
+
+func (x *ReqSimulateTx) Reset() {
+	*x = ReqSimulateTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transaction_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqSimulateTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqSimulateTx) ProtoMessage() {}
+
+func (x *ReqSimulateTx) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqSimulateTx.ProtoReflect.Descriptor instead.
+func (*ReqSimulateTx) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ReqSimulateTx) GetTx() *Transaction {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+func (x *ReqSimulateTx) GetStateHash() []byte {
+	if x != nil {
+		return x.StateHash
+	}
+	return nil
+}
+
+func (x *ReqSimulateTx) GetSkipSign() bool {
+	if x != nil {
+		return x.SkipSign
+	}
+	return false
+}
+
+func (x *ReqSimulateTx) GetPubKey() []byte {
+	if x != nil {
+		return x.PubKey
+	}
+	return nil
+}
+
+func (x *ReqSimulateTx) GetSignType() int32 {
+	if x != nil {
+		return x.SignType
+	}
+	return 0
+}
+
+//状态数据修改前后的值，before为空表示新增的key
+type StateChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Before []byte `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After  []byte `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (x *StateChange) Reset() {
+	*x = StateChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transaction_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateChange) ProtoMessage() {}
+
+func (x *StateChange) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateChange.ProtoReflect.Descriptor instead.
+func (*StateChange) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *StateChange) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *StateChange) GetBefore() []byte {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *StateChange) GetAfter() []byte {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+type SimulateTxResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash    []byte         `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Receipt *ReceiptData   `protobuf:"bytes,2,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	Changes []*StateChange `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
+	//实际扣除的手续费
+	Fee int64 `protobuf:"varint,4,opt,name=fee,proto3" json:"fee,omitempty"`
+}
+
+func (x *SimulateTxResult) Reset() {
+	*x = SimulateTxResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transaction_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulateTxResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateTxResult) ProtoMessage() {}
+
+func (x *SimulateTxResult) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateTxResult.ProtoReflect.Descriptor instead.
+func (*SimulateTxResult) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SimulateTxResult) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *SimulateTxResult) GetReceipt() *ReceiptData {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+func (x *SimulateTxResult) GetChanges() []*StateChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *SimulateTxResult) GetFee() int64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+//交易按最新区块的下一个区块执行，height为执行时的区块高度
+type ReplySimulateTx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StateHash []byte              `protobuf:"bytes,1,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	Height    int64               `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Results   []*SimulateTxResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *ReplySimulateTx) Reset() {
+	*x = ReplySimulateTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transaction_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplySimulateTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplySimulateTx) ProtoMessage() {}
+
+func (x *ReplySimulateTx) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplySimulateTx.ProtoReflect.Descriptor instead.
+func (*ReplySimulateTx) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ReplySimulateTx) GetStateHash() []byte {
+	if x != nil {
+		return x.StateHash
+	}
+	return nil
+}
+
+func (x *ReplySimulateTx) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ReplySimulateTx) GetResults() []*SimulateTxResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
 var File_transaction_proto protoreflect.FileDescriptor
 
 var file_transaction_proto_rawDesc = []byte{
@@ -3087,9 +3368,42 @@ var file_transaction_proto_rawDesc = []byte{
 	0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x08, 0x52, 0x0a, 0x65, 0x78, 0x69, 0x73, 0x74,
 	0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x69, 0x73, 0x74, 0x43, 0x6f,
 	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x65, 0x78, 0x69, 0x73, 0x74,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x1f, 0x5a, 0x1d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x33, 0x33, 0x63, 0x6e, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x33, 0x33,
-	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa1, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x53, 0x69, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x78, 0x12, 0x22, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x74, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6b, 0x69,
+	0x70, 0x53, 0x69, 0x67, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x6b, 0x69,
+	0x70, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x69, 0x67, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x73, 0x69, 0x67, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x22, 0x4d, 0x0a, 0x0b, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0x94, 0x01, 0x0a, 0x10, 0x53, 0x69, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12,
+	0x2c, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x10, 0x0a,
+	0x03, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x66, 0x65, 0x65, 0x22,
+	0x7a, 0x0a, 0x0f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65,
+	0x54, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x78, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x42, 0x1f, 0x5a, 0x1d, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x33, 0x33, 0x63, 0x6e, 0x2f, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x33, 0x33, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3104,7 +3418,7 @@ func file_transaction_proto_rawDescGZIP() []byte {
 	return file_transaction_proto_rawDescData
 }
 
-var file_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
+var file_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
 var file_transaction_proto_goTypes = []interface{}{
 	(*AssetsGenesis)(nil),           // 0: types.AssetsGenesis
 	(*AssetsTransferToExec)(nil),    // 1: types.AssetsTransferToExec
@@ -3149,7 +3463,11 @@ var file_transaction_proto_goTypes = []interface{}{
 	(*TxProof)(nil),                 // 40: types.TxProof
 	(*ReqCheckTxsExist)(nil),        // 41: types.ReqCheckTxsExist
 	(*ReplyCheckTxsExist)(nil),      // 42: types.ReplyCheckTxsExist
-	(*KeyValue)(nil),                // 43: types.KeyValue
+	(*ReqSimulateTx)(nil),           // 43: types.ReqSimulateTx
+	(*StateChange)(nil),             // 44: types.StateChange
+	(*SimulateTxResult)(nil),        // 45: types.SimulateTxResult
+	(*ReplySimulateTx)(nil),         // 46: types.ReplySimulateTx
+	(*KeyValue)(nil),                // 47: types.KeyValue
 }
 var file_transaction_proto_depIdxs = []int32{
 	15, // 0: types.Transaction.signature:type_name -> types.Signature
@@ -3159,7 +3477,7 @@ var file_transaction_proto_depIdxs = []int32{
 	11, // 4: types.ReplyTxList.txs:type_name -> types.Transaction
 	19, // 5: types.ReplyTxInfos.txInfos:type_name -> types.ReplyTxInfo
 	27, // 6: types.AddrTxFeeInfos.txInfos:type_name -> types.AddrTxFeeInfo
-	43, // 7: types.Receipt.KV:type_name -> types.KeyValue
+	47, // 7: types.Receipt.KV:type_name -> types.KeyValue
 	29, // 8: types.Receipt.logs:type_name -> types.ReceiptLog
 	29, // 9: types.ReceiptData.logs:type_name -> types.ReceiptLog
 	11, // 10: types.TxResult.tx:type_name -> types.Transaction
@@ -3169,11 +3487,15 @@ var file_transaction_proto_depIdxs = []int32{
 	4,  // 14: types.TransactionDetail.assets:type_name -> types.Asset
 	40, // 15: types.TransactionDetail.txProofs:type_name -> types.TxProof
 	33, // 16: types.TransactionDetails.txs:type_name -> types.TransactionDetail
-	17, // [17:17] is the sub-list for method output_type
-	17, // [17:17] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	11, // 17: types.ReqSimulateTx.tx:type_name -> types.Transaction
+	31, // 18: types.SimulateTxResult.receipt:type_name -> types.ReceiptData
+	44, // 19: types.SimulateTxResult.changes:type_name -> types.StateChange
+	45, // 20: types.ReplySimulateTx.results:type_name -> types.SimulateTxResult
+	21, // [21:21] is the sub-list for method output_type
+	21, // [21:21] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_transaction_proto_init() }
@@ -3699,6 +4021,54 @@ func file_transaction_proto_init() {
 				return nil
 			}
 		}
+		file_transaction_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqSimulateTx); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transaction_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transaction_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulateTxResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transaction_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplySimulateTx); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -3706,7 +4076,7 @@ func file_transaction_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_transaction_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   43,
+			NumMessages:   47,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
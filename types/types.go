@@ -563,6 +563,7 @@ func (b *Block) Clone() *Block {
 		MainHeight: b.MainHeight,
 		Signature:  b.Signature.Clone(),
 		Txs:        cloneTxs(b.Txs),
+		BaseFee:    b.BaseFee,
 	}
 }
 
@@ -34,6 +34,7 @@ import (
 
 	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/limits"
+	"github.com/33cn/chain33/common/trace"
 	clog "github.com/33cn/chain33/common/log"
 	log "github.com/33cn/chain33/common/log/log15"
 	"github.com/33cn/chain33/common/version"
@@ -61,6 +62,7 @@ var (
 	exportTitle = flag.String("export", "", "export block title name")
 	fileDir     = flag.String("filedir", "", "import/export block file dir,defalut current path")
 	startHeight = flag.Int64("startheight", 0, "export block start height")
+	moduleName  = flag.String("module", "", "run module(wallet or rpc) in sidecar process, connect to queue.listenAddr")
 )
 
 //RunChain33 : run Chain33
@@ -116,6 +118,9 @@ func RunChain33(name, defCfg string) {
 	}
 	//compare minFee in wallet, mempool, exec
 	//set file log
+	if *moduleName != "" && cfg.Log != nil {
+		cfg.Log.LogFile = sidecarLogFile(cfg.Log.LogFile, *moduleName)
+	}
 	clog.SetFileLog(cfg.Log)
 	//set grpc log
 	f, err := createFile(cfg.P2P.GrpcLogFile)
@@ -126,6 +131,15 @@ func RunChain33(name, defCfg string) {
 		glogv2 := grpclog.NewLoggerV2WithVerbosity(f, f, f, 10)
 		grpclog.SetLoggerV2(glogv2)
 	}
+	if *moduleName != "" {
+		address.Init(cfg.Address)
+		if err := trace.Init(cfg.Trace); err != nil {
+			panic(err)
+		}
+		defer trace.Stop()
+		runSidecar(chain33Cfg, *moduleName)
+		return
+	}
 	//set watching
 	t := time.Tick(10 * time.Second)
 	go func() {
@@ -158,6 +172,13 @@ func RunChain33(name, defCfg string) {
 	log.Info("loading queue")
 	q := queue.New("channel")
 	q.SetConfig(chain33Cfg)
+	var server *queue.Server
+	if cfg.Queue != nil && cfg.Queue.ListenAddr != "" {
+		server, err = queue.Listen(q, cfg.Queue)
+		if err != nil {
+			panic(err)
+		}
+	}
 
 	address.Init(cfg.Address)
 	crypto := cryptocli.New()
@@ -187,11 +208,21 @@ func RunChain33(name, defCfg string) {
 	cs.SetQueueClient(q.Client())
 
 	//jsonrpc, grpc, channel 三种模式
-	rpcapi := rpc.New(chain33Cfg)
+	var rpcapi module
+	if isSidecar(cfg, "rpc") {
+		rpcapi = startSidecar("rpc")
+	} else {
+		rpcapi = rpc.New(chain33Cfg)
+	}
 	rpcapi.SetQueueClient(q.Client())
 
 	log.Info("loading wallet module")
-	walletm := wallet.New(chain33Cfg)
+	var walletm module
+	if isSidecar(cfg, "wallet") {
+		walletm = startSidecar("wallet")
+	} else {
+		walletm = wallet.New(chain33Cfg)
+	}
 	walletm.SetQueueClient(q.Client())
 
 	chain.Rollbackblock()
@@ -214,6 +245,9 @@ func RunChain33(name, defCfg string) {
 	health := util.NewHealthCheckServer(q.Client())
 	health.Start(cfg.Health)
 	metrics.StartMetrics(chain33Cfg)
+	if err := trace.Init(cfg.Trace); err != nil {
+		panic(err)
+	}
 	defer func() {
 		//close all module,clean some resource
 		log.Info("begin close health module")
@@ -236,8 +270,13 @@ func RunChain33(name, defCfg string) {
 		rpcapi.Close()
 		log.Info("begin close wallet module")
 		walletm.Close()
+		if server != nil {
+			log.Info("begin close queue server")
+			server.Close()
+		}
 		log.Info("begin close queue module")
 		q.Close()
+		trace.Stop()
 
 	}()
 	q.Start()
@@ -101,6 +101,7 @@ func Run(RPCAddr, ParaName, name string) {
 		commands.AssetCmd(),
 		commands.NoneCmd(),
 		commands.BtcScriptCmd(),
+		commands.TraceCmd(),
 	)
 
 	//test tls is enable
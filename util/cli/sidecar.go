@@ -0,0 +1,183 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cli
+
+import (
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/rpc"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/wallet"
+)
+
+const sidecarRestartDelay = 3 * time.Second
+
+//module 可以在独立进程中运行的模块，rpc模块没有实现queue.Module的Wait
+type module interface {
+	SetQueueClient(client queue.Client)
+	Close()
+}
+
+//isSidecar 模块是否配置为在独立进程中运行
+func isSidecar(cfg *types.Config, name string) bool {
+	if cfg.Queue == nil || cfg.Queue.ListenAddr == "" {
+		return false
+	}
+	for _, m := range cfg.Queue.Sidecars {
+		if m == name {
+			return true
+		}
+	}
+	return false
+}
+
+//newSidecarModule 创建在独立进程中运行的模块
+func newSidecarModule(cfg *types.Chain33Config, name string) module {
+	switch name {
+	case "wallet":
+		return wallet.New(cfg)
+	case "rpc":
+		return rpc.New(cfg)
+	}
+	panic("module " + name + " can not run in sidecar process")
+}
+
+//sidecar 主进程拉起的模块进程，异常退出后重新拉起
+type sidecar struct {
+	name string
+	args []string
+	mu   sync.Mutex
+	cmd  *exec.Cmd
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+func startSidecar(name string) *sidecar {
+	args := []string{"-f", *configPath, "-module", name}
+	if *datadir != "" {
+		args = append(args, "-datadir", *datadir)
+	}
+	s := &sidecar{name: name, args: args, done: make(chan struct{})}
+	s.wg.Add(1)
+	go s.run()
+	return s
+}
+
+func (s *sidecar) run() {
+	defer s.wg.Done()
+	for {
+		exe, err := os.Executable()
+		if err != nil {
+			panic(err)
+		}
+		cmd := exec.Command(exe, s.args...)
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		s.mu.Lock()
+		select {
+		case <-s.done:
+			s.mu.Unlock()
+			return
+		default:
+		}
+		err = cmd.Start()
+		if err == nil {
+			s.cmd = cmd
+		}
+		s.mu.Unlock()
+		if err == nil {
+			log.Info("sidecar started", "module", s.name, "pid", cmd.Process.Pid)
+			err = cmd.Wait()
+		}
+		select {
+		case <-s.done:
+			return
+		case <-time.After(sidecarRestartDelay):
+			log.Error("sidecar exited, restart", "module", s.name, "err", err)
+		}
+	}
+}
+
+//SetQueueClient 模块在独立进程中通过远程client连接队列
+func (s *sidecar) SetQueueClient(client queue.Client) {
+}
+
+func (s *sidecar) Wait() {
+}
+
+//Close 通知模块进程退出，超时后强制结束
+func (s *sidecar) Close() {
+	s.mu.Lock()
+	close(s.done)
+	cmd := s.cmd
+	s.mu.Unlock()
+	if cmd != nil {
+		_ = cmd.Process.Signal(syscall.SIGTERM)
+		exited := make(chan struct{})
+		go func() {
+			s.wg.Wait()
+			close(exited)
+		}()
+		select {
+		case <-exited:
+		case <-time.After(10 * time.Second):
+			log.Error("sidecar close timeout, kill", "module", s.name)
+			_ = cmd.Process.Kill()
+		}
+	}
+	s.wg.Wait()
+}
+
+//runSidecar 在独立进程中运行模块，连接主进程的队列服务，连接断开或者收到退出信号时退出
+func runSidecar(cfg *types.Chain33Config, name string) {
+	mcfg := cfg.GetModuleConfig()
+	if mcfg.Queue == nil || mcfg.Queue.ListenAddr == "" {
+		panic("queue.listenAddr must be configured to run module in sidecar process")
+	}
+	var client *queue.RemoteClient
+	var err error
+	//主进程可能还未开始监听
+	for i := 0; i < 30; i++ {
+		client, err = queue.Dial(mcfg.Queue, name, cfg)
+		if err == nil {
+			break
+		}
+		time.Sleep(time.Second)
+	}
+	if err != nil {
+		panic(err)
+	}
+	log.Info("loading sidecar module", "module", name, "queue", mcfg.Queue.ListenAddr)
+	m := newSidecarModule(cfg, name)
+	m.SetQueueClient(client)
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+	select {
+	case <-interrupt:
+	case <-client.Done():
+		log.Error("queue connection closed", "module", name)
+	}
+	log.Info("begin close sidecar module", "module", name)
+	m.Close()
+	client.Close()
+}
+
+//sidecarLogFile 模块进程的日志输出到单独的文件，如 logs/chain33.wallet.log
+func sidecarLogFile(file, name string) string {
+	if file == "" {
+		return file
+	}
+	ext := filepath.Ext(file)
+	return strings.TrimSuffix(file, ext) + "." + name + ext
+}
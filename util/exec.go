@@ -45,6 +45,7 @@ func ExecTx(client queue.Client, prevStateRoot []byte, block *types.Block) (*typ
 		Height:     block.Height,
 		Difficulty: uint64(block.Difficulty),
 		IsMempool:  false,
+		BaseFee:    block.BaseFee,
 	}
 	msg := client.NewMessage("execs", types.EventExecTxList, list)
 	err := client.Send(msg, true)
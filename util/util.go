@@ -24,6 +24,7 @@ import (
 	"github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/common/log/log15"
 	"github.com/33cn/chain33/common/merkle"
+	"github.com/33cn/chain33/common/trace"
 	"github.com/33cn/chain33/queue"
 	_ "github.com/33cn/chain33/system/address" //init address driver
 	"github.com/33cn/chain33/types"
@@ -269,14 +270,21 @@ func ExecBlock(client queue.Client, prevStateRoot []byte, block *types.Block, er
 	defer func() {
 		ulog.Info("ExecBlock", "height", block.Height, "ntx", len(block.Txs), "writebatchsync", sync, "cost", types.Since(beg))
 	}()
+	//执行和提交区块的消息都在exec block的span下
+	span := trace.StartSpan("exec block", trace.SpanContext{})
+	span.SetAttribute(trace.AttrBlockHeight, fmt.Sprint(block.Height))
+	defer span.End()
+	client = queue.WithTrace(client, span.Context())
 
 	detail, deltx, err := PreExecBlock(client, prevStateRoot, block, errReturn, sync, checkblock)
 	if err != nil {
+		span.SetError(err)
 		return nil, nil, err
 	}
 	// 写数据库失败时需要及时返回错误，防止错误数据被写入localdb中CHAIN33-567
 	err = ExecKVSetCommit(client, block.StateHash, false)
 	if err != nil {
+		span.SetError(err)
 		return nil, nil, err
 	}
 	return detail, deltx, nil
@@ -505,6 +513,7 @@ func CreateNewBlock(cfg *types.Chain33Config, parent *types.Block, txs []*types.
 	newblock.Height = parent.Height + 1
 	newblock.BlockTime = parent.BlockTime + 1
 	newblock.ParentHash = parent.Hash(cfg)
+	newblock.BaseFee = types.CalcBaseFeeByBlock(cfg, parent)
 	newblock.Txs = append(newblock.Txs, txs...)
 
 	//需要首先对交易进行排序然后再计算TxHash